@@ -0,0 +1,493 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+)
+
+const (
+	authMethodAuto       = ""
+	authMethodCLI        = "cli"
+	authMethodMSI        = "msi"
+	authMethodSPSecret   = "sp-secret"
+	authMethodSPCert     = "sp-cert"
+	authMethodOIDC       = "oidc"
+	authMethodDeviceCode = "device-code"
+	authMethodDefault    = "default"
+)
+
+var authMethods = []string{
+	authMethodCLI,
+	authMethodMSI,
+	authMethodSPSecret,
+	authMethodSPCert,
+	authMethodOIDC,
+	authMethodDeviceCode,
+	authMethodDefault,
+}
+
+// authConfig is the authentication configuration, which mirrors the one of the Terraform AzureRM provider.
+type authConfig struct {
+	TenantId           string
+	AuxiliaryTenantIds []string
+	ClientId           string
+
+	ClientSecret string
+
+	ClientCertificate         []byte
+	ClientCertificatePassword string
+
+	UseOIDC                          bool
+	OIDCToken                        string
+	OIDCTokenFilePath                string
+	OIDCRequestToken                 string
+	OIDCRequestURL                   string
+	ADOPipelineServiceConnectionId   string
+	UseAKSWorkloadIdentity           bool
+	AKSWorkloadIdentityTokenFilePath string
+
+	UseMSI bool
+	// MSIEndpoint and MSIAPIVersion are the custom endpoint to get the managed identity token from, instead of the well-known ones, together with its API version.
+	MSIEndpoint   string
+	MSIAPIVersion string
+
+	UseCLI bool
+}
+
+// msiDefaultAPIVersion is the API version of the custom MSI endpoint, if not specified by ARM_MSI_API_VERSION.
+const msiDefaultAPIVersion = "2018-02-01"
+
+// authConfigFromEnv reads the authentication configuration from the same environment variables as the Terraform AzureRM provider.
+func authConfigFromEnv() (*authConfig, error) {
+	var (
+		cfg authConfig
+		err error
+	)
+
+	cfg.TenantId = os.Getenv("ARM_TENANT_ID")
+	if v := os.Getenv("ARM_AUXILIARY_TENANT_IDS"); v != "" {
+		cfg.AuxiliaryTenantIds = strings.Split(v, ";")
+	}
+
+	if cfg.ClientId, err = envOrFile("ARM_CLIENT_ID", "ARM_CLIENT_ID_FILE_PATH"); err != nil {
+		return nil, err
+	}
+	if cfg.ClientSecret, err = envOrFile("ARM_CLIENT_SECRET", "ARM_CLIENT_SECRET_FILE_PATH"); err != nil {
+		return nil, err
+	}
+
+	if v := os.Getenv("ARM_CLIENT_CERTIFICATE"); v != "" {
+		if cfg.ClientCertificate, err = base64.StdEncoding.DecodeString(v); err != nil {
+			return nil, fmt.Errorf("base64 decoding ARM_CLIENT_CERTIFICATE: %v", err)
+		}
+	} else if v := os.Getenv("ARM_CLIENT_CERTIFICATE_PATH"); v != "" {
+		if cfg.ClientCertificate, err = os.ReadFile(v); err != nil {
+			return nil, fmt.Errorf("reading the client certificate from %s: %v", v, err)
+		}
+	}
+	cfg.ClientCertificatePassword = os.Getenv("ARM_CLIENT_CERTIFICATE_PASSWORD")
+
+	if cfg.UseOIDC, err = envBool("ARM_USE_OIDC", false); err != nil {
+		return nil, err
+	}
+	cfg.OIDCToken = os.Getenv("ARM_OIDC_TOKEN")
+	cfg.OIDCTokenFilePath = os.Getenv("ARM_OIDC_TOKEN_FILE_PATH")
+	cfg.OIDCRequestToken = firstEnv("ARM_OIDC_REQUEST_TOKEN", "ACTIONS_ID_TOKEN_REQUEST_TOKEN", "SYSTEM_ACCESSTOKEN")
+	cfg.OIDCRequestURL = firstEnv("ARM_OIDC_REQUEST_URL", "ACTIONS_ID_TOKEN_REQUEST_URL")
+	cfg.ADOPipelineServiceConnectionId = firstEnv("ARM_ADO_PIPELINE_SERVICE_CONNECTION_ID", "ARM_OIDC_AZURE_SERVICE_CONNECTION_ID")
+	if cfg.UseAKSWorkloadIdentity, err = envBool("ARM_USE_AKS_WORKLOAD_IDENTITY", false); err != nil {
+		return nil, err
+	}
+	if cfg.UseAKSWorkloadIdentity {
+		cfg.AKSWorkloadIdentityTokenFilePath = os.Getenv("AZURE_FEDERATED_TOKEN_FILE")
+		if cfg.TenantId == "" {
+			cfg.TenantId = os.Getenv("AZURE_TENANT_ID")
+		}
+		if cfg.ClientId == "" {
+			cfg.ClientId = os.Getenv("AZURE_CLIENT_ID")
+		}
+	}
+
+	if cfg.UseMSI, err = envBool("ARM_USE_MSI", false); err != nil {
+		return nil, err
+	}
+	cfg.MSIEndpoint = os.Getenv("ARM_MSI_ENDPOINT")
+	cfg.MSIAPIVersion = os.Getenv("ARM_MSI_API_VERSION")
+	if cfg.UseCLI, err = envBool("ARM_USE_CLI", true); err != nil {
+		return nil, err
+	}
+
+	return &cfg, nil
+}
+
+// autoMethod picks the authentication method in the same order as the Terraform AzureRM provider does.
+// If none of the methods is explicitly configured, it falls back to the Azure CLI, unless it is disabled by ARM_USE_CLI.
+func (cfg authConfig) autoMethod() (string, error) {
+	switch {
+	case len(cfg.ClientCertificate) != 0:
+		return authMethodSPCert, nil
+	case cfg.ClientSecret != "":
+		return authMethodSPSecret, nil
+	case cfg.UseOIDC || cfg.UseAKSWorkloadIdentity:
+		return authMethodOIDC, nil
+	case cfg.UseMSI:
+		return authMethodMSI, nil
+	case cfg.UseCLI:
+		return authMethodCLI, nil
+	default:
+		return "", fmt.Errorf("no authentication method is configured, and the Azure CLI authentication is disabled by ARM_USE_CLI")
+	}
+}
+
+func buildCredential(method string, cfg authConfig, clientOpt policy.ClientOptions) (azcore.TokenCredential, error) {
+	if method == authMethodAuto {
+		var err error
+		if method, err = cfg.autoMethod(); err != nil {
+			return nil, err
+		}
+	}
+
+	switch method {
+	case authMethodCLI:
+		if !cfg.UseCLI {
+			return nil, fmt.Errorf("the Azure CLI authentication is disabled by ARM_USE_CLI")
+		}
+		return azidentity.NewAzureCLICredential(&azidentity.AzureCLICredentialOptions{
+			TenantID:                   cfg.TenantId,
+			AdditionallyAllowedTenants: cfg.AuxiliaryTenantIds,
+		})
+
+	case authMethodMSI:
+		if cfg.MSIEndpoint != "" {
+			return newMSIEndpointCredential(cfg, clientOpt)
+		}
+		opt := &azidentity.ManagedIdentityCredentialOptions{
+			ClientOptions: clientOpt,
+		}
+		if cfg.ClientId != "" {
+			opt.ID = azidentity.ClientID(cfg.ClientId)
+		}
+		return azidentity.NewManagedIdentityCredential(opt)
+
+	case authMethodSPSecret:
+		if err := cfg.requireServicePrincipal(); err != nil {
+			return nil, err
+		}
+		if cfg.ClientSecret == "" {
+			return nil, fmt.Errorf("ARM_CLIENT_SECRET or ARM_CLIENT_SECRET_FILE_PATH is required")
+		}
+		return azidentity.NewClientSecretCredential(cfg.TenantId, cfg.ClientId, cfg.ClientSecret, &azidentity.ClientSecretCredentialOptions{
+			ClientOptions:              clientOpt,
+			AdditionallyAllowedTenants: cfg.AuxiliaryTenantIds,
+		})
+
+	case authMethodSPCert:
+		if err := cfg.requireServicePrincipal(); err != nil {
+			return nil, err
+		}
+		if len(cfg.ClientCertificate) == 0 {
+			return nil, fmt.Errorf("ARM_CLIENT_CERTIFICATE or ARM_CLIENT_CERTIFICATE_PATH is required")
+		}
+		certs, key, err := azidentity.ParseCertificates(cfg.ClientCertificate, []byte(cfg.ClientCertificatePassword))
+		if err != nil {
+			return nil, fmt.Errorf("parsing the client certificate: %v", err)
+		}
+		return azidentity.NewClientCertificateCredential(cfg.TenantId, cfg.ClientId, certs, key, &azidentity.ClientCertificateCredentialOptions{
+			ClientOptions:              clientOpt,
+			AdditionallyAllowedTenants: cfg.AuxiliaryTenantIds,
+		})
+
+	case authMethodOIDC:
+		if err := cfg.requireServicePrincipal(); err != nil {
+			return nil, err
+		}
+		if cfg.ADOPipelineServiceConnectionId != "" {
+			return azidentity.NewAzurePipelinesCredential(cfg.TenantId, cfg.ClientId, cfg.ADOPipelineServiceConnectionId, cfg.OIDCRequestToken, &azidentity.AzurePipelinesCredentialOptions{
+				ClientOptions:              clientOpt,
+				AdditionallyAllowedTenants: cfg.AuxiliaryTenantIds,
+			})
+		}
+		getAssertion, err := cfg.oidcAssertionFunc()
+		if err != nil {
+			return nil, err
+		}
+		return azidentity.NewClientAssertionCredential(cfg.TenantId, cfg.ClientId, getAssertion, &azidentity.ClientAssertionCredentialOptions{
+			ClientOptions:              clientOpt,
+			AdditionallyAllowedTenants: cfg.AuxiliaryTenantIds,
+		})
+
+	case authMethodDeviceCode:
+		return azidentity.NewDeviceCodeCredential(&azidentity.DeviceCodeCredentialOptions{
+			ClientOptions:              clientOpt,
+			TenantID:                   cfg.TenantId,
+			ClientID:                   cfg.ClientId,
+			AdditionallyAllowedTenants: cfg.AuxiliaryTenantIds,
+			UserPrompt: func(_ context.Context, msg azidentity.DeviceCodeMessage) error {
+				// Print to stderr to not pollute the output
+				fmt.Fprintln(os.Stderr, msg.Message)
+				return nil
+			},
+		})
+
+	case authMethodDefault:
+		return cfg.defaultCredential(clientOpt)
+
+	default:
+		return nil, fmt.Errorf("unknown authentication method %q, must be one of %v", method, authMethods)
+	}
+}
+
+// defaultCredential chains the service principal credential (if configured by the ARM_* environment variables) before the default Azure credential,
+// as the latter only reads the service principal from the AZURE_* environment variables.
+func (cfg authConfig) defaultCredential(clientOpt policy.ClientOptions) (azcore.TokenCredential, error) {
+	var sources []azcore.TokenCredential
+	if cfg.TenantId != "" && cfg.ClientId != "" {
+		switch {
+		case len(cfg.ClientCertificate) != 0:
+			certs, key, err := azidentity.ParseCertificates(cfg.ClientCertificate, []byte(cfg.ClientCertificatePassword))
+			if err != nil {
+				return nil, fmt.Errorf("parsing the client certificate: %v", err)
+			}
+			cred, err := azidentity.NewClientCertificateCredential(cfg.TenantId, cfg.ClientId, certs, key, &azidentity.ClientCertificateCredentialOptions{
+				ClientOptions:              clientOpt,
+				AdditionallyAllowedTenants: cfg.AuxiliaryTenantIds,
+			})
+			if err != nil {
+				return nil, err
+			}
+			sources = append(sources, cred)
+		case cfg.ClientSecret != "":
+			cred, err := azidentity.NewClientSecretCredential(cfg.TenantId, cfg.ClientId, cfg.ClientSecret, &azidentity.ClientSecretCredentialOptions{
+				ClientOptions:              clientOpt,
+				AdditionallyAllowedTenants: cfg.AuxiliaryTenantIds,
+			})
+			if err != nil {
+				return nil, err
+			}
+			sources = append(sources, cred)
+		}
+	}
+	cred, err := azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{
+		ClientOptions:              clientOpt,
+		TenantID:                   cfg.TenantId,
+		AdditionallyAllowedTenants: cfg.AuxiliaryTenantIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(sources) == 0 {
+		return cred, nil
+	}
+	return azidentity.NewChainedTokenCredential(append(sources, cred), nil)
+}
+
+func (cfg authConfig) requireServicePrincipal() error {
+	if cfg.TenantId == "" {
+		return fmt.Errorf("ARM_TENANT_ID is required")
+	}
+	if cfg.ClientId == "" {
+		return fmt.Errorf("ARM_CLIENT_ID or ARM_CLIENT_ID_FILE_PATH is required")
+	}
+	return nil
+}
+
+// oidcAssertionFunc returns the function to get the OIDC token, which is either:
+// - specified directly, or
+// - read from a file, or
+// - requested from the OIDC token endpoint (e.g. GitHub Actions)
+func (cfg authConfig) oidcAssertionFunc() (func(context.Context) (string, error), error) {
+	if cfg.OIDCToken != "" {
+		return func(context.Context) (string, error) {
+			return cfg.OIDCToken, nil
+		}, nil
+	}
+
+	tokenFilePath := cfg.OIDCTokenFilePath
+	if tokenFilePath == "" && cfg.UseAKSWorkloadIdentity {
+		tokenFilePath = cfg.AKSWorkloadIdentityTokenFilePath
+	}
+	if tokenFilePath != "" {
+		return func(context.Context) (string, error) {
+			// Read the file on each call, as the token file can be rotated.
+			b, err := os.ReadFile(tokenFilePath)
+			if err != nil {
+				return "", fmt.Errorf("reading the OIDC token from %s: %v", tokenFilePath, err)
+			}
+			return strings.TrimSpace(string(b)), nil
+		}, nil
+	}
+
+	if cfg.OIDCRequestURL != "" && cfg.OIDCRequestToken != "" {
+		return func(ctx context.Context) (string, error) {
+			return requestOIDCToken(ctx, cfg.OIDCRequestURL, cfg.OIDCRequestToken)
+		}, nil
+	}
+
+	return nil, fmt.Errorf("one of ARM_OIDC_TOKEN, ARM_OIDC_TOKEN_FILE_PATH, or ARM_OIDC_REQUEST_URL together with ARM_OIDC_REQUEST_TOKEN is required")
+}
+
+func requestOIDCToken(ctx context.Context, requestURL, requestToken string) (string, error) {
+	u, err := url.Parse(requestURL)
+	if err != nil {
+		return "", fmt.Errorf("parsing the OIDC request URL: %v", err)
+	}
+	q := u.Query()
+	q.Set("audience", "api://AzureADTokenExchange")
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+requestToken)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("requesting the OIDC token: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("requesting the OIDC token: unexpected status code %d", resp.StatusCode)
+	}
+	var body struct {
+		Value string `json:"value"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("decoding the OIDC token response: %v", err)
+	}
+	if body.Value == "" {
+		return "", fmt.Errorf("empty OIDC token in the response")
+	}
+	return body.Value, nil
+}
+
+// msiEndpointCredential gets the managed identity token from the custom MSI endpoint, in the same protocol as the Azure Instance Metadata Service.
+// The ManagedIdentityCredential only supports the well-known endpoints, which are detected from the environment.
+type msiEndpointCredential struct {
+	endpoint   *url.URL
+	apiVersion string
+	clientId   string
+	client     policy.Transporter
+}
+
+func newMSIEndpointCredential(cfg authConfig, clientOpt policy.ClientOptions) (*msiEndpointCredential, error) {
+	u, err := url.Parse(cfg.MSIEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing ARM_MSI_ENDPOINT: %v", err)
+	}
+	apiVersion := cfg.MSIAPIVersion
+	if apiVersion == "" {
+		apiVersion = msiDefaultAPIVersion
+	}
+	var client policy.Transporter = http.DefaultClient
+	if clientOpt.Transport != nil {
+		client = clientOpt.Transport
+	}
+	return &msiEndpointCredential{endpoint: u, apiVersion: apiVersion, clientId: cfg.ClientId, client: client}, nil
+}
+
+func (c *msiEndpointCredential) GetToken(ctx context.Context, opts policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	if len(opts.Scopes) != 1 {
+		return azcore.AccessToken{}, fmt.Errorf("the managed identity token can only be requested for one scope, got %d", len(opts.Scopes))
+	}
+	u := *c.endpoint
+	q := u.Query()
+	q.Set("api-version", c.apiVersion)
+	q.Set("resource", strings.TrimSuffix(opts.Scopes[0], "/.default"))
+	if c.clientId != "" {
+		q.Set("client_id", c.clientId)
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return azcore.AccessToken{}, err
+	}
+	req.Header.Set("Metadata", "true")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return azcore.AccessToken{}, fmt.Errorf("requesting the managed identity token from %s: %v", c.endpoint, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return azcore.AccessToken{}, fmt.Errorf("requesting the managed identity token from %s: unexpected status code %d", c.endpoint, resp.StatusCode)
+	}
+	// The expiry can be either a number or a string of the number.
+	var body struct {
+		AccessToken string      `json:"access_token"`
+		ExpiresOn   json.Number `json:"expires_on"`
+		ExpiresIn   json.Number `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return azcore.AccessToken{}, fmt.Errorf("decoding the managed identity token response: %v", err)
+	}
+	if body.AccessToken == "" {
+		return azcore.AccessToken{}, fmt.Errorf("empty managed identity token in the response")
+	}
+	token := azcore.AccessToken{Token: body.AccessToken}
+	switch {
+	case body.ExpiresOn != "":
+		v, err := body.ExpiresOn.Int64()
+		if err != nil {
+			return azcore.AccessToken{}, fmt.Errorf("parsing expires_on of the managed identity token: %v", err)
+		}
+		token.ExpiresOn = time.Unix(v, 0)
+	case body.ExpiresIn != "":
+		v, err := body.ExpiresIn.Int64()
+		if err != nil {
+			return azcore.AccessToken{}, fmt.Errorf("parsing expires_in of the managed identity token: %v", err)
+		}
+		token.ExpiresOn = time.Now().Add(time.Duration(v) * time.Second)
+	default:
+		return azcore.AccessToken{}, fmt.Errorf("no expiry of the managed identity token in the response")
+	}
+	return token, nil
+}
+
+func envOrFile(envName, fileEnvName string) (string, error) {
+	if v := os.Getenv(envName); v != "" {
+		return v, nil
+	}
+	p := os.Getenv(fileEnvName)
+	if p == "" {
+		return "", nil
+	}
+	b, err := os.ReadFile(p)
+	if err != nil {
+		return "", fmt.Errorf("reading %s from %s: %v", envName, p, err)
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+func envBool(name string, defaultValue bool) (bool, error) {
+	v := os.Getenv(name)
+	if v == "" {
+		return defaultValue, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("parsing %s: %v", name, err)
+	}
+	return b, nil
+}
+
+func firstEnv(names ...string) string {
+	for _, name := range names {
+		if v := os.Getenv(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
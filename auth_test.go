@@ -0,0 +1,483 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/stretchr/testify/require"
+)
+
+// authEnvs are the environment variables read by authConfigFromEnv, which are cleared for each test.
+var authEnvs = []string{
+	"ARM_TENANT_ID",
+	"ARM_AUXILIARY_TENANT_IDS",
+	"ARM_CLIENT_ID",
+	"ARM_CLIENT_ID_FILE_PATH",
+	"ARM_CLIENT_SECRET",
+	"ARM_CLIENT_SECRET_FILE_PATH",
+	"ARM_CLIENT_CERTIFICATE",
+	"ARM_CLIENT_CERTIFICATE_PATH",
+	"ARM_CLIENT_CERTIFICATE_PASSWORD",
+	"ARM_USE_OIDC",
+	"ARM_OIDC_TOKEN",
+	"ARM_OIDC_TOKEN_FILE_PATH",
+	"ARM_OIDC_REQUEST_TOKEN",
+	"ACTIONS_ID_TOKEN_REQUEST_TOKEN",
+	"SYSTEM_ACCESSTOKEN",
+	"ARM_OIDC_REQUEST_URL",
+	"ACTIONS_ID_TOKEN_REQUEST_URL",
+	"ARM_ADO_PIPELINE_SERVICE_CONNECTION_ID",
+	"ARM_OIDC_AZURE_SERVICE_CONNECTION_ID",
+	"ARM_USE_AKS_WORKLOAD_IDENTITY",
+	"AZURE_FEDERATED_TOKEN_FILE",
+	"AZURE_TENANT_ID",
+	"AZURE_CLIENT_ID",
+	"ARM_USE_MSI",
+	"ARM_MSI_ENDPOINT",
+	"ARM_MSI_API_VERSION",
+	"ARM_USE_CLI",
+}
+
+func setAuthEnvs(t *testing.T, envs map[string]string) {
+	for _, k := range authEnvs {
+		t.Setenv(k, "")
+	}
+	for k, v := range envs {
+		t.Setenv(k, v)
+	}
+}
+
+// testCertificate returns a self-signed certificate together with its private key in PEM.
+func testCertificate(t *testing.T) []byte {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "aztft"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	b := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	return append(b, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer})...)
+}
+
+func TestAuthConfigFromEnv(t *testing.T) {
+	dir := t.TempDir()
+	secretFile := filepath.Join(dir, "secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("secret-from-file\n"), 0600))
+	cert := testCertificate(t)
+	certFile := filepath.Join(dir, "cert.pem")
+	require.NoError(t, os.WriteFile(certFile, cert, 0600))
+
+	cases := []struct {
+		name   string
+		envs   map[string]string
+		expect authConfig
+		err    bool
+	}{
+		{
+			name:   "empty",
+			expect: authConfig{UseCLI: true},
+		},
+		{
+			name: "service principal secret",
+			envs: map[string]string{
+				"ARM_TENANT_ID":            "tenant1",
+				"ARM_AUXILIARY_TENANT_IDS": "tenant2;tenant3",
+				"ARM_CLIENT_ID":            "client1",
+				"ARM_CLIENT_SECRET":        "secret1",
+			},
+			expect: authConfig{
+				TenantId:           "tenant1",
+				AuxiliaryTenantIds: []string{"tenant2", "tenant3"},
+				ClientId:           "client1",
+				ClientSecret:       "secret1",
+				UseCLI:             true,
+			},
+		},
+		{
+			name: "service principal secret from file",
+			envs: map[string]string{
+				"ARM_CLIENT_SECRET_FILE_PATH": secretFile,
+			},
+			expect: authConfig{
+				ClientSecret: "secret-from-file",
+				UseCLI:       true,
+			},
+		},
+		{
+			name: "service principal certificate",
+			envs: map[string]string{
+				"ARM_CLIENT_CERTIFICATE":          base64.StdEncoding.EncodeToString(cert),
+				"ARM_CLIENT_CERTIFICATE_PASSWORD": "password",
+			},
+			expect: authConfig{
+				ClientCertificate:         cert,
+				ClientCertificatePassword: "password",
+				UseCLI:                    true,
+			},
+		},
+		{
+			name: "service principal certificate from file",
+			envs: map[string]string{
+				"ARM_CLIENT_CERTIFICATE_PATH": certFile,
+			},
+			expect: authConfig{
+				ClientCertificate: cert,
+				UseCLI:            true,
+			},
+		},
+		{
+			name: "invalid certificate encoding",
+			envs: map[string]string{
+				"ARM_CLIENT_CERTIFICATE": "not base64",
+			},
+			err: true,
+		},
+		{
+			name: "aks workload identity",
+			envs: map[string]string{
+				"ARM_USE_AKS_WORKLOAD_IDENTITY": "true",
+				"AZURE_FEDERATED_TOKEN_FILE":    "/var/token",
+				"AZURE_TENANT_ID":               "tenant1",
+				"AZURE_CLIENT_ID":               "client1",
+			},
+			expect: authConfig{
+				TenantId:                         "tenant1",
+				ClientId:                         "client1",
+				UseAKSWorkloadIdentity:           true,
+				AKSWorkloadIdentityTokenFilePath: "/var/token",
+				UseCLI:                           true,
+			},
+		},
+		{
+			name: "oidc request from github actions",
+			envs: map[string]string{
+				"ARM_USE_OIDC":                   "true",
+				"ACTIONS_ID_TOKEN_REQUEST_TOKEN": "token",
+				"ACTIONS_ID_TOKEN_REQUEST_URL":   "https://example.com",
+			},
+			expect: authConfig{
+				UseOIDC:          true,
+				OIDCRequestToken: "token",
+				OIDCRequestURL:   "https://example.com",
+				UseCLI:           true,
+			},
+		},
+		{
+			name: "msi without cli",
+			envs: map[string]string{
+				"ARM_USE_MSI": "true",
+				"ARM_USE_CLI": "false",
+			},
+			expect: authConfig{
+				UseMSI: true,
+			},
+		},
+		{
+			name: "msi with custom endpoint",
+			envs: map[string]string{
+				"ARM_USE_MSI":         "true",
+				"ARM_MSI_ENDPOINT":    "http://169.254.169.254/metadata/identity/oauth2/token",
+				"ARM_MSI_API_VERSION": "2019-08-01",
+			},
+			expect: authConfig{
+				UseMSI:        true,
+				MSIEndpoint:   "http://169.254.169.254/metadata/identity/oauth2/token",
+				MSIAPIVersion: "2019-08-01",
+				UseCLI:        true,
+			},
+		},
+		{
+			name: "invalid bool",
+			envs: map[string]string{
+				"ARM_USE_MSI": "yes",
+			},
+			err: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			setAuthEnvs(t, tt.envs)
+			actual, err := authConfigFromEnv()
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expect, *actual)
+		})
+	}
+}
+
+func TestAutoMethod(t *testing.T) {
+	cases := []struct {
+		name   string
+		cfg    authConfig
+		expect string
+		err    bool
+	}{
+		{
+			name:   "certificate takes precedence over secret",
+			cfg:    authConfig{ClientCertificate: []byte("cert"), ClientSecret: "secret", UseCLI: true},
+			expect: authMethodSPCert,
+		},
+		{
+			name:   "secret",
+			cfg:    authConfig{ClientSecret: "secret", UseMSI: true, UseCLI: true},
+			expect: authMethodSPSecret,
+		},
+		{
+			name:   "oidc",
+			cfg:    authConfig{UseOIDC: true, UseMSI: true, UseCLI: true},
+			expect: authMethodOIDC,
+		},
+		{
+			name:   "aks workload identity",
+			cfg:    authConfig{UseAKSWorkloadIdentity: true, UseCLI: true},
+			expect: authMethodOIDC,
+		},
+		{
+			name:   "msi",
+			cfg:    authConfig{UseMSI: true, UseCLI: true},
+			expect: authMethodMSI,
+		},
+		{
+			name:   "fall back to cli",
+			cfg:    authConfig{UseCLI: true},
+			expect: authMethodCLI,
+		},
+		{
+			name: "cli disabled",
+			cfg:  authConfig{},
+			err:  true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := tt.cfg.autoMethod()
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestBuildCredential(t *testing.T) {
+	cert := testCertificate(t)
+
+	cases := []struct {
+		name   string
+		method string
+		cfg    authConfig
+		expect azcore.TokenCredential
+		err    bool
+	}{
+		{
+			name:   "auto falls back to cli",
+			method: authMethodAuto,
+			cfg:    authConfig{UseCLI: true},
+			expect: &azidentity.AzureCLICredential{},
+		},
+		{
+			name:   "auto without any method",
+			method: authMethodAuto,
+			cfg:    authConfig{},
+			err:    true,
+		},
+		{
+			name:   "cli disabled",
+			method: authMethodCLI,
+			cfg:    authConfig{},
+			err:    true,
+		},
+		{
+			name:   "msi",
+			method: authMethodMSI,
+			cfg:    authConfig{ClientId: "client1"},
+			expect: &azidentity.ManagedIdentityCredential{},
+		},
+		{
+			name:   "msi with custom endpoint",
+			method: authMethodAuto,
+			cfg:    authConfig{UseMSI: true, MSIEndpoint: "http://localhost:40342/metadata/identity/oauth2/token"},
+			expect: &msiEndpointCredential{},
+		},
+		{
+			name:   "secret",
+			method: authMethodSPSecret,
+			cfg:    authConfig{TenantId: "tenant1", ClientId: "client1", ClientSecret: "secret1"},
+			expect: &azidentity.ClientSecretCredential{},
+		},
+		{
+			name:   "secret without tenant",
+			method: authMethodSPSecret,
+			cfg:    authConfig{ClientId: "client1", ClientSecret: "secret1"},
+			err:    true,
+		},
+		{
+			name:   "secret without secret",
+			method: authMethodSPSecret,
+			cfg:    authConfig{TenantId: "tenant1", ClientId: "client1"},
+			err:    true,
+		},
+		{
+			name:   "auto certificate",
+			method: authMethodAuto,
+			cfg:    authConfig{TenantId: "tenant1", ClientId: "client1", ClientCertificate: cert},
+			expect: &azidentity.ClientCertificateCredential{},
+		},
+		{
+			name:   "invalid certificate",
+			method: authMethodSPCert,
+			cfg:    authConfig{TenantId: "tenant1", ClientId: "client1", ClientCertificate: []byte("invalid")},
+			err:    true,
+		},
+		{
+			name:   "oidc token",
+			method: authMethodOIDC,
+			cfg:    authConfig{TenantId: "tenant1", ClientId: "client1", UseOIDC: true, OIDCToken: "token"},
+			expect: &azidentity.ClientAssertionCredential{},
+		},
+		{
+			name:   "oidc without token",
+			method: authMethodOIDC,
+			cfg:    authConfig{TenantId: "tenant1", ClientId: "client1", UseOIDC: true},
+			err:    true,
+		},
+		{
+			name:   "default",
+			method: authMethodDefault,
+			cfg:    authConfig{},
+			expect: &azidentity.DefaultAzureCredential{},
+		},
+		{
+			name:   "default with service principal",
+			method: authMethodDefault,
+			cfg:    authConfig{TenantId: "tenant1", ClientId: "client1", ClientSecret: "secret1"},
+			expect: &azidentity.ChainedTokenCredential{},
+		},
+		{
+			name:   "unknown",
+			method: "foo",
+			err:    true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// The default Azure credential reads the AZURE_* environment variables, which must not be changed by building the credential.
+			setAuthEnvs(t, nil)
+			t.Setenv("AZURE_CLIENT_SECRET", "")
+
+			actual, err := buildCredential(tt.method, tt.cfg, policy.ClientOptions{})
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.IsType(t, tt.expect, actual)
+			require.Empty(t, os.Getenv("AZURE_TENANT_ID"))
+			require.Empty(t, os.Getenv("AZURE_CLIENT_ID"))
+			require.Empty(t, os.Getenv("AZURE_CLIENT_SECRET"))
+		})
+	}
+}
+
+func TestMSIEndpointCredential(t *testing.T) {
+	expiresOn := time.Now().Add(time.Hour).Truncate(time.Second)
+	cases := []struct {
+		name       string
+		cfg        authConfig
+		response   string
+		statusCode int
+		expectQS   url.Values
+		expect     azcore.AccessToken
+		err        string
+	}{
+		{
+			name:     "expires on as string",
+			cfg:      authConfig{ClientId: "client1"},
+			response: fmt.Sprintf(`{"access_token": "token1", "expires_on": "%d"}`, expiresOn.Unix()),
+			expectQS: url.Values{"api-version": {msiDefaultAPIVersion}, "resource": {"https://management.azure.com"}, "client_id": {"client1"}},
+			expect:   azcore.AccessToken{Token: "token1", ExpiresOn: expiresOn},
+		},
+		{
+			name:     "expires on as number",
+			cfg:      authConfig{MSIAPIVersion: "2019-08-01"},
+			response: fmt.Sprintf(`{"access_token": "token1", "expires_on": %d}`, expiresOn.Unix()),
+			expectQS: url.Values{"api-version": {"2019-08-01"}, "resource": {"https://management.azure.com"}},
+			expect:   azcore.AccessToken{Token: "token1", ExpiresOn: expiresOn},
+		},
+		{
+			name:       "error status",
+			response:   `{"error": "invalid_request"}`,
+			statusCode: http.StatusBadRequest,
+			err:        "unexpected status code 400",
+		},
+		{
+			name:     "no expiry",
+			response: `{"access_token": "token1"}`,
+			err:      "no expiry",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Metadata") != "true" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				if tt.expectQS != nil && !reflect.DeepEqual(tt.expectQS, r.URL.Query()) {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				if tt.statusCode != 0 {
+					w.WriteHeader(tt.statusCode)
+				}
+				w.Write([]byte(tt.response))
+			}))
+			defer srv.Close()
+
+			cfg := tt.cfg
+			cfg.MSIEndpoint = srv.URL + "/metadata/identity/oauth2/token"
+			cred, err := newMSIEndpointCredential(cfg, policy.ClientOptions{})
+			require.NoError(t, err)
+			token, err := cred.GetToken(context.Background(), policy.TokenRequestOptions{Scopes: []string{"https://management.azure.com/.default"}})
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expect.Token, token.Token)
+			require.True(t, tt.expect.ExpiresOn.Equal(token.ExpiresOn), "expires on %v, expected %v", token.ExpiresOn, tt.expect.ExpiresOn)
+		})
+	}
+}
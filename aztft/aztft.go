@@ -1,16 +1,19 @@
 package aztft
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/magodo/aztft/internal/client"
 	"github.com/magodo/aztft/internal/populate"
 	"github.com/magodo/aztft/internal/resmap"
 	"github.com/magodo/aztft/internal/resolve"
 	"github.com/magodo/aztft/internal/tfid"
+	"github.com/magodo/aztft/internal/tfschema"
 
 	"github.com/magodo/armid"
 )
@@ -18,23 +21,131 @@ import (
 type Type struct {
 	AzureId armid.ResourceId
 	TFType  string
+	// NotInSchema indicates the TF resource type doesn't exist in the provider schema specified by APIOption.ProviderSchemaFile.
+	NotInSchema bool
 }
 
+// CredentialResolver resolves the credential to access the specified subscription.
+// The subscriptionId is empty for the resources that are not under any subscription (e.g. management groups).
+type CredentialResolver func(subscriptionId string) (azcore.TokenCredential, error)
+
 type APIOption struct {
-	Cred         azcore.TokenCredential
+	// Cred is used to call the Azure API. If both Cred and CredResolver are nil, no Azure API will be called, while the other options still take effect.
+	Cred azcore.TokenCredential
+	// CredResolver, if not nil, takes precedence over the Cred to resolve the credential per subscription (e.g. for the subscriptions in different tenants).
+	CredResolver CredentialResolver
 	ClientOption arm.ClientOptions
+	// Context is the context of the Azure API calls, which are cancelled together with it. The background context is used if nil.
+	Context context.Context
+
+	// StorageDNSZone is the DNS zone (e.g. "z24") of the storage accounts that use the Azure DNS zone endpoints.
+	// It is used to build the ids of the storage data plane resources.
+	StorageDNSZone string
+
+	// StorageEndpointSuffix and KeyVaultDNSSuffix are the data plane endpoint suffixes of the cloud (e.g. "core.windows.net" and "vault.azure.net").
+	// They are only needed for the clouds other than the well-known ones (i.e. public, china and usgovernment).
+	StorageEndpointSuffix string
+	KeyVaultDNSSuffix     string
+
+	// DataPlaneEndpointFromAPI indicates to retrieve the data plane endpoints (e.g. the storage account endpoints, the key vault URI) via the Azure API
+	// when building the ids of the data plane resources, instead of deriving them from the cloud configuration.
+	DataPlaneEndpointFromAPI bool
+
+	// MappingFile is a file of the extra resource mappings (e.g. for the resources of a private provider fork), in the same format as the built-in mapping.
+	// It is loaded by LoadMappingFile on the first query that uses it, after which the extra mappings take effect for all the queries.
+	MappingFile string
+
+	// ProviderSchemaFile is the output of "terraform providers schema -json" of the provider version in use. If specified,
+	// the resource types that don't exist in the provider schema are flagged by the Type.NotInSchema, or filtered out if FilterBySchema is true.
+	ProviderSchemaFile string
+	FilterBySchema     bool
+
+	// PopulateExtensions indicates to also populate the extension resources (e.g. the diagnostic settings, the management locks, the role assignments)
+	// that are scoped to the queried resource, regardless of its resource type. It only takes effect when calling the Azure API.
+	PopulateExtensions bool
+
+	// clients is the client builder shared by the copies of the option, see ShareClients.
+	clients *client.ClientBuilder
+}
+
+// ShareClients makes the queries with the option, and with its copies made afterwards, share the same Azure API clients (and thus the pipelines),
+// which are cached per subscription, instead of building new ones for each query. The Cred, CredResolver and ClientOption must not be changed afterwards.
+func (opt *APIOption) ShareClients() {
+	opt.clients = client.NewClientBuilder(opt.Cred, client.CredentialResolver(opt.CredResolver), opt.ClientOption)
+}
+
+func useAPI(apiOpt *APIOption) bool {
+	return apiOpt != nil && (apiOpt.Cred != nil || apiOpt.CredResolver != nil)
+}
+
+func clientBuilder(apiOpt APIOption) *client.ClientBuilder {
+	b := apiOpt.clients
+	if b == nil {
+		b = &client.ClientBuilder{
+			Cred:         apiOpt.Cred,
+			CredResolver: client.CredentialResolver(apiOpt.CredResolver),
+			ClientOpt:    apiOpt.ClientOption,
+		}
+	}
+	return b.WithContext(apiOpt.Context)
+}
+
+// LoadMappingFile loads the extra resource mappings (e.g. for the resources of a private provider fork) from the file, in the same format as the built-in mapping.
+// The entries must not collide with the existing resource types, or overlap with their routing scopes unless "allow_overlap" is set.
+// It can be called concurrently with the queries, which wait for the loading to finish. Each file is only loaded once.
+func LoadMappingFile(path string) error {
+	return resmap.LoadExtensionFile(path)
+}
+
+// lockMapping loads the APIOption.MappingFile, if any, then locks the mapping for reading until the returned function is called.
+func lockMapping(apiOpt *APIOption) (unlock func(), err error) {
+	if apiOpt != nil && apiOpt.MappingFile != "" {
+		if err := LoadMappingFile(apiOpt.MappingFile); err != nil {
+			return nil, err
+		}
+	}
+	return resmap.RLock(), nil
+}
+
+func loadSchema(apiOpt *APIOption) (tfschema.ResourceTypes, error) {
+	if apiOpt == nil || apiOpt.ProviderSchemaFile == "" {
+		return nil, nil
+	}
+	return tfschema.Load(apiOpt.ProviderSchemaFile)
+}
+
+func dataPlaneOption(apiOpt *APIOption) tfid.DataPlaneOption {
+	if apiOpt == nil {
+		return tfid.DataPlaneOption{}
+	}
+	return tfid.DataPlaneOption{
+		Cloud:                 apiOpt.ClientOption.Cloud,
+		StorageDNSZone:        apiOpt.StorageDNSZone,
+		StorageEndpointSuffix: apiOpt.StorageEndpointSuffix,
+		KeyVaultDNSSuffix:     apiOpt.KeyVaultDNSSuffix,
+	}
 }
 
 // QueryType queries a given ARM resource ID and returns a list of potential matched Terraform resource type.
-// It firstly statically search the known resource mappings. If there are multiple matches and the "apiOpt" is not nil,
+// It firstly statically search the known resource mappings. If there are multiple matches and the "apiOpt" has a credential,
 // it will further call Azure API to retrieve additionl information about this resource and return the exact match.
-// Additionally, if "apiOpt" is specified and this resource maps to multiple TF resources, then multiple Types will be returned.
+// Additionally, if "apiOpt" has a credential and this resource maps to multiple TF resources, then multiple Types will be returned.
 func QueryType(idStr string, apiOpt *APIOption) (types []Type, exact bool, err error) {
+	unlock, err := lockMapping(apiOpt)
+	if err != nil {
+		return nil, false, err
+	}
+	defer unlock()
 	return queryType(idStr, apiOpt)
 }
 
 // QueryId queries a given ARM resource ID and its resource type, returns the matched Terraform resource ID.
 func QueryId(idStr string, rt string, apiOpt *APIOption) (string, error) {
+	unlock, err := lockMapping(apiOpt)
+	if err != nil {
+		return "", err
+	}
+	defer unlock()
 	id, err := armid.ParseResourceId(idStr)
 	if err != nil {
 		return "", fmt.Errorf("parsing id: %v", err)
@@ -45,6 +156,11 @@ func QueryId(idStr string, rt string, apiOpt *APIOption) (string, error) {
 
 // QueryTypeAndId is similar to QueryType, except it also returns the Terraform resource ID (having same length as the types).
 func QueryTypeAndId(idStr string, apiOpt *APIOption) (types []Type, ids []string, exact bool, err error) {
+	unlock, err := lockMapping(apiOpt)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	defer unlock()
 	types, exact, err = queryType(idStr, apiOpt)
 	if err != nil {
 		return nil, nil, false, err
@@ -64,12 +180,15 @@ func queryId(id armid.ResourceId, rt string, apiOpt *APIOption) (string, error)
 		spec string
 		err  error
 	)
-	if tfid.NeedsAPI(rt) {
-		if apiOpt == nil {
+	switch {
+	case tfid.CanBuildOffline(rt) && (apiOpt == nil || !apiOpt.DataPlaneEndpointFromAPI):
+		spec, err = tfid.OfflineBuild(id, rt, dataPlaneOption(apiOpt))
+	case tfid.NeedsAPI(rt):
+		if !useAPI(apiOpt) {
 			return "", fmt.Errorf("%s needs call Azure API to build the import spec", rt)
 		}
-		spec, err = tfid.DynamicBuild(id, rt, apiOpt.Cred, apiOpt.ClientOption)
-	} else {
+		spec, err = tfid.DynamicBuild(id, rt, clientBuilder(*apiOpt))
+	default:
 		spec, err = tfid.StaticBuild(id, rt)
 	}
 	if err != nil {
@@ -112,7 +231,7 @@ func queryType(idStr string, apiOpt *APIOption) ([]Type, bool, error) {
 		exact  bool
 	)
 
-	if apiOpt == nil {
+	if !useAPI(apiOpt) {
 		l := getARMId2TFMapItems(id)
 		if len(l) == 0 {
 			return nil, false, nil
@@ -144,24 +263,49 @@ func queryType(idStr string, apiOpt *APIOption) ([]Type, bool, error) {
 		}
 
 		rt := entry.ResourceType
-		propLikeResIds, err := populate.Populate(id, rt, apiOpt.Cred, apiOpt.ClientOption)
+		propLikeResults, err := populate.Populate(id, rt, clientBuilder(*apiOpt))
 		if err != nil {
 			return nil, false, fmt.Errorf("populating property-like resources for %s: %v", rt, err)
 		}
 
-		for _, propLikeResId := range propLikeResIds {
-			entry, err := mapEntryById(propLikeResId, *apiOpt)
-			if err != nil {
-				return nil, false, fmt.Errorf("mapping entry by id %s: %v", id, err)
-			}
-			if entry == nil {
-				continue
+		for _, propLikeRes := range propLikeResults {
+			// The resource type that is already known by the populater doesn't need to be resolved again.
+			tfType := propLikeRes.ResourceType
+			if tfType == "" {
+				entry, err := mapEntryById(propLikeRes.Id, *apiOpt)
+				if err != nil {
+					return nil, false, fmt.Errorf("mapping entry by id %s: %v", propLikeRes.Id, err)
+				}
+				if entry == nil {
+					continue
+				}
+				tfType = entry.ResourceType
 			}
 			result = append(result, Type{
-				AzureId: propLikeResId,
-				TFType:  entry.ResourceType,
+				AzureId: propLikeRes.Id,
+				TFType:  tfType,
 			})
 		}
+
+		if apiOpt.PopulateExtensions {
+			extIds, err := populate.PopulateExtensions(id, clientBuilder(*apiOpt))
+			if err != nil {
+				return nil, false, fmt.Errorf("populating extension resources for %s: %v", id, err)
+			}
+			for _, extId := range extIds {
+				entry, err := mapEntryById(extId, *apiOpt)
+				if err != nil {
+					return nil, false, fmt.Errorf("mapping entry by id %s: %v", extId, err)
+				}
+				if entry == nil {
+					continue
+				}
+				result = append(result, Type{
+					AzureId: extId,
+					TFType:  entry.ResourceType,
+				})
+			}
+		}
 	}
 
 	sort.Slice(result, func(i, j int) bool {
@@ -171,9 +315,52 @@ func queryType(idStr string, apiOpt *APIOption) ([]Type, bool, error) {
 		return result[i].TFType < result[j].TFType
 	})
 
+	schema, err := loadSchema(apiOpt)
+	if err != nil {
+		return nil, false, err
+	}
+	if schema != nil {
+		var filtered []Type
+		for _, t := range result {
+			t.NotInSchema = !schema[t.TFType]
+			if t.NotInSchema && apiOpt.FilterBySchema {
+				continue
+			}
+			filtered = append(filtered, t)
+		}
+		result = filtered
+		if apiOpt.FilterBySchema && (!useAPI(apiOpt) || len(result) == 0) {
+			exact = len(result) == 1
+		}
+	}
+
 	return result, exact, nil
 }
 
+// MissingTypes returns the resource types that exist in the provider schema specified by the APIOption.ProviderSchemaFile,
+// but are missing from the mapping (including the extra mappings from the APIOption.MappingFile, or loaded by LoadMappingFile), sorted.
+func MissingTypes(apiOpt *APIOption) ([]string, error) {
+	unlock, err := lockMapping(apiOpt)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	schema, err := loadSchema(apiOpt)
+	if err != nil {
+		return nil, err
+	}
+	if schema == nil {
+		return nil, fmt.Errorf("no provider schema file specified")
+	}
+	var out []string
+	for _, rt := range schema.List() {
+		if _, ok := resmap.TF2ARMIdMap[rt]; !ok {
+			out = append(out, rt)
+		}
+	}
+	return out, nil
+}
+
 func mapEntryById(id armid.ResourceId, apiOpt APIOption) (*resmap.ARMId2TFMapItem, error) {
 	l := getARMId2TFMapItems(id)
 	if len(l) == 0 {
@@ -181,7 +368,7 @@ func mapEntryById(id armid.ResourceId, apiOpt APIOption) (*resmap.ARMId2TFMapIte
 	}
 	// Resolve ambiguous resources
 	if len(l) > 1 {
-		rt, err := resolve.Resolve(id, apiOpt.Cred, apiOpt.ClientOption)
+		rt, err := resolve.Resolve(id, clientBuilder(apiOpt))
 		if err != nil {
 			return nil, err
 		}
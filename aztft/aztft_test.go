@@ -1,9 +1,17 @@
 package aztft
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/magodo/armid"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -152,6 +160,7 @@ func TestQueryId(t *testing.T) {
 		name   string
 		input  string
 		rt     string
+		apiOpt *APIOption
 		expect string
 		err    bool
 	}{
@@ -215,11 +224,157 @@ func TestQueryId(t *testing.T) {
 			rt:     "azurerm_backup_protected_vm",
 			expect: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.RecoveryServices/vaults/example-recovery-vault/backupFabrics/Azure/protectionContainers/iaasvmcontainer;iaasvmcontainerv2;group1;vm1/protectedItems/vm;iaasvmcontainerv2;group1;vm1",
 		},
+		{
+			name:   "private endpoint application security group association",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/privateEndpoints/pe1/applicationSecurityGroups/L3N1YnNjcmlwdGlvbnMvc3ViMS9yZXNvdXJjZUdyb3Vwcy9yZzEvcHJvdmlkZXJzL01pY3Jvc29mdC5OZXR3b3JrL2FwcGxpY2F0aW9uU2VjdXJpdHlHcm91cHMvYXNnMQ==",
+			rt:     "azurerm_private_endpoint_application_security_group_association",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/privateEndpoints/pe1|/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/applicationSecurityGroups/asg1",
+		},
+		{
+			name:   "virtual machine gallery application assignment",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/vm1/galleryApplications/L3N1YnNjcmlwdGlvbnMvc3ViMS9yZXNvdXJjZUdyb3Vwcy9yZzEvcHJvdmlkZXJzL01pY3Jvc29mdC5Db21wdXRlL2dhbGxlcmllcy9nYWxsZXJ5MS9hcHBsaWNhdGlvbnMvYXBwMS92ZXJzaW9ucy8xLjAuMA==",
+			rt:     "azurerm_virtual_machine_gallery_application_assignment",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/vm1|/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/galleries/gallery1/applications/app1/versions/1.0.0",
+		},
+		{
+			name:   "virtual desktop scaling plan host pool association",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.DesktopVirtualization/scalingPlans/plan1/hostPools/L3N1YnNjcmlwdGlvbnMvc3ViMS9yZXNvdXJjZUdyb3Vwcy9yZzEvcHJvdmlkZXJzL01pY3Jvc29mdC5EZXNrdG9wVmlydHVhbGl6YXRpb24vaG9zdFBvb2xzL3Bvb2wx",
+			rt:     "azurerm_virtual_desktop_scaling_plan_host_pool_association",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.DesktopVirtualization/scalingPlans/plan1|/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.DesktopVirtualization/hostPools/pool1",
+		},
+		{
+			name:   "communication service email domain association",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Communication/communicationServices/cs1/linkedDomains/L3N1YnNjcmlwdGlvbnMvc3ViMS9yZXNvdXJjZUdyb3Vwcy9yZzEvcHJvdmlkZXJzL01pY3Jvc29mdC5Db21tdW5pY2F0aW9uL2VtYWlsU2VydmljZXMvZXMxL2RvbWFpbnMvZXhhbXBsZS5jb20=",
+			rt:     "azurerm_communication_service_email_domain_association",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Communication/communicationServices/cs1|/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Communication/emailServices/es1/domains/example.com",
+		},
+		{
+			name:   "app service certificate binding",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/site1/hostNameBindings/www.example.com/certificates/L3N1YnNjcmlwdGlvbnMvc3ViMS9yZXNvdXJjZUdyb3Vwcy9yZzEvcHJvdmlkZXJzL01pY3Jvc29mdC5XZWIvY2VydGlmaWNhdGVzL2NlcnQx",
+			rt:     "azurerm_app_service_certificate_binding",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/site1/hostNameBindings/www.example.com|/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/certificates/cert1",
+		},
+		{
+			name:   "chaos studio target",
+			input:  "/subscriptions/sub1/resourcegroups/rg1/providers/microsoft.containerservice/managedclusters/aks1/providers/microsoft.chaos/targets/Microsoft-AzureKubernetesServiceChaosMesh",
+			rt:     "azurerm_chaos_studio_target",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.ContainerService/managedClusters/aks1/providers/Microsoft.Chaos/targets/Microsoft-AzureKubernetesServiceChaosMesh",
+		},
+		{
+			name:   "chaos studio capability",
+			input:  "/subscriptions/sub1/resourcegroups/rg1/providers/microsoft.containerservice/managedclusters/aks1/providers/microsoft.chaos/targets/Microsoft-AzureKubernetesServiceChaosMesh/capabilities/PodChaos-2.1",
+			rt:     "azurerm_chaos_studio_capability",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.ContainerService/managedClusters/aks1/providers/Microsoft.Chaos/targets/Microsoft-AzureKubernetesServiceChaosMesh/capabilities/PodChaos-2.1",
+		},
+		{
+			name:   "storage queue",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Storage/storageAccounts/account1/queueServices/default/queues/queue1",
+			rt:     "azurerm_storage_queue",
+			expect: "https://account1.queue.core.windows.net/queue1",
+		},
+		{
+			name:   "storage blob",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Storage/storageAccounts/account1/blobServices/default/containers/container1/blobs/blob1",
+			rt:     "azurerm_storage_blob",
+			expect: "https://account1.blob.core.windows.net/container1/blob1",
+		},
+		{
+			name:   "storage table",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Storage/storageAccounts/account1/tableServices/default/tables/table1",
+			rt:     "azurerm_storage_table",
+			expect: "https://account1.table.core.windows.net/Tables('table1')",
+		},
+		{
+			name:   "storage table entity",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Storage/storageAccounts/account1/tableServices/default/tables/table1/partitionKeys/pk1/rowKeys/rk1",
+			rt:     "azurerm_storage_table_entity",
+			expect: "https://account1.table.core.windows.net/table1(PartitionKey='pk1',RowKey='rk1')",
+		},
+		{
+			name:   "storage share file",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Storage/storageAccounts/account1/fileServices/default/shares/share1/files/dir1:file1",
+			rt:     "azurerm_storage_share_file",
+			expect: "https://account1.file.core.windows.net/share1/dir1/file1",
+		},
+		{
+			name:   "storage data lake gen2 path",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Storage/storageAccounts/account1/dfs/dfs1/paths/dir1:dir2",
+			rt:     "azurerm_storage_data_lake_gen2_path",
+			expect: "https://account1.dfs.core.windows.net/dfs1/dir1/dir2",
+		},
+		{
+			name:  "storage blob (china)",
+			input: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Storage/storageAccounts/account1/blobServices/default/containers/container1/blobs/blob1",
+			rt:    "azurerm_storage_blob",
+			apiOpt: &APIOption{
+				ClientOption: arm.ClientOptions{ClientOptions: policy.ClientOptions{Cloud: cloud.AzureChina}},
+			},
+			expect: "https://account1.blob.core.chinacloudapi.cn/container1/blob1",
+		},
+		{
+			name:  "storage blob (dns zone)",
+			input: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Storage/storageAccounts/account1/blobServices/default/containers/container1/blobs/blob1",
+			rt:    "azurerm_storage_blob",
+			apiOpt: &APIOption{
+				StorageDNSZone: "z24",
+			},
+			expect: "https://account1.z24.blob.storage.azure.net/container1/blob1",
+		},
+		{
+			name:  "storage blob (custom cloud)",
+			input: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Storage/storageAccounts/account1/blobServices/default/containers/container1/blobs/blob1",
+			rt:    "azurerm_storage_blob",
+			apiOpt: &APIOption{
+				ClientOption:          arm.ClientOptions{ClientOptions: policy.ClientOptions{Cloud: cloud.Configuration{ActiveDirectoryAuthorityHost: "https://login.example.com/"}}},
+				StorageEndpointSuffix: "core.example.com",
+			},
+			expect: "https://account1.blob.core.example.com/container1/blob1",
+		},
+		{
+			name:  "storage blob (unknown cloud)",
+			input: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Storage/storageAccounts/account1/blobServices/default/containers/container1/blobs/blob1",
+			rt:    "azurerm_storage_blob",
+			apiOpt: &APIOption{
+				ClientOption: arm.ClientOptions{ClientOptions: policy.ClientOptions{Cloud: cloud.Configuration{ActiveDirectoryAuthorityHost: "https://login.example.com/"}}},
+			},
+			err: true,
+		},
+		{
+			name:   "key vault certificate issuer",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.KeyVault/vaults/vault1/certificates/cert1/issuers/issuer1",
+			rt:     "azurerm_key_vault_certificate_issuer",
+			expect: "https://vault1.vault.azure.net/certificates/issuers/issuer1",
+		},
+		{
+			name:   "key vault managed storage account sas token definition",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.KeyVault/vaults/vault1/storage/storage1/sas/def1",
+			rt:     "azurerm_key_vault_managed_storage_account_sas_token_definition",
+			expect: "https://vault1.vault.azure.net/storage/storage1/sas/def1",
+		},
+		{
+			name:  "key vault certificate contacts (custom cloud)",
+			input: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.KeyVault/vaults/vault1/certificates/cert1/contacts/contact1",
+			rt:    "azurerm_key_vault_certificate_contacts",
+			apiOpt: &APIOption{
+				ClientOption:      arm.ClientOptions{ClientOptions: policy.ClientOptions{Cloud: cloud.Configuration{ActiveDirectoryAuthorityHost: "https://login.example.com/"}}},
+				KeyVaultDNSSuffix: "vault.example.com",
+			},
+			expect: "https://vault1.vault.example.com/certificates/contacts",
+		},
+		{
+			name:  "storage blob (endpoint from api without credential)",
+			input: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Storage/storageAccounts/account1/blobServices/default/containers/container1/blobs/blob1",
+			rt:    "azurerm_storage_blob",
+			apiOpt: &APIOption{
+				DataPlaneEndpointFromAPI: true,
+			},
+			err: true,
+		},
 	}
 
 	for _, tt := range cases {
 		t.Run(tt.name, func(t *testing.T) {
-			actual, err := QueryId(tt.input, tt.rt, nil)
+			actual, err := QueryId(tt.input, tt.rt, tt.apiOpt)
 			if tt.err {
 				require.Error(t, err)
 				return
@@ -386,3 +541,489 @@ func TestQueryTypeAndId(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryDataSource(t *testing.T) {
+	cases := []struct {
+		name        string
+		input       string
+		expect      []string
+		expectExact bool
+		err         bool
+	}{
+		{
+			name:  "invalid id",
+			input: "/subscriptions/sub1/resourceGroups/rg1/foos",
+			err:   true,
+		},
+		{
+			name:  "resource group",
+			input: "/subscriptions/sub1/resourceGroups/rg1",
+			expect: []string{`data "azurerm_resource_group" "example" {
+  name = "rg1"
+}
+`},
+			expectExact: true,
+		},
+		{
+			name:  "subnet",
+			input: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1/subnets/subnet1",
+			expect: []string{`data "azurerm_subnet" "example" {
+  name                 = "subnet1"
+  resource_group_name  = "rg1"
+  virtual_network_name = "vnet1"
+}
+`},
+			expectExact: true,
+		},
+		{
+			name:  "key vault secret (parent id)",
+			input: "/SUBSCRIPTIONS/sub1/RESOURCEGROUPS/rg1/PROVIDERS/MICROSOFT.KEYVAULT/VAULTS/vault1/SECRETS/secret1",
+			expect: []string{`data "azurerm_key_vault_secret" "example" {
+  name         = "secret1"
+  key_vault_id = "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.KeyVault/vaults/vault1"
+}
+`},
+			expectExact: true,
+		},
+		{
+			name:  "virtual machine (deduplicated)",
+			input: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/vm1",
+			expect: []string{`data "azurerm_virtual_machine" "example" {
+  name                = "vm1"
+  resource_group_name = "rg1"
+}
+`},
+			expectExact: false,
+		},
+		{
+			name:        "no data source",
+			input:       "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/networkWatchers/watcher1",
+			expectExact: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, actualExact, err := QueryDataSource(tt.input, nil)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			var actualHCL []string
+			for _, ds := range actual {
+				actualHCL = append(actualHCL, ds.HCL("example"))
+			}
+			require.Equal(t, tt.expect, actualHCL)
+			require.Equal(t, tt.expectExact, actualExact)
+		})
+	}
+}
+
+func TestQueryTypeAndIdWithMappingFile(t *testing.T) {
+	dir := t.TempDir()
+	writeMappingFile := func(name, content string) string {
+		p := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(p, []byte(content), 0644))
+		return p
+	}
+	widgetId := "/subscriptions/sub1/resourcegroups/rg1/providers/microsoft.fork/WIDGETS/w1"
+
+	// The mapping files are loaded into the global mapping, so the cases depend on the ones before them.
+	cases := []struct {
+		name        string
+		mapping     string
+		loadErr     string
+		input       string
+		expectTypes []Type
+		expectIds   []string
+	}{
+		{
+			name: "extra resource type",
+			mapping: `{
+  "azurerm_fork_widget": {
+    "management_plane": {
+      "scopes": ["/subscriptions/resourceGroups"],
+      "provider": "Microsoft.Fork",
+      "types": ["widgets"],
+      "import_specs": ["/subscriptions/resourceGroups/Microsoft.Fork/widgets"]
+    }
+  }
+}`,
+			input: widgetId,
+			expectTypes: []Type{
+				{
+					AzureId: MustParseId(t, widgetId),
+					TFType:  "azurerm_fork_widget",
+				},
+			},
+			expectIds: []string{"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Fork/widgets/w1"},
+		},
+		{
+			name: "collision with built-in type",
+			mapping: `{
+  "azurerm_resource_group": {
+    "management_plane": {
+      "provider": "Microsoft.Resources",
+      "types": ["resourceGroups"]
+    }
+  }
+}`,
+			loadErr: "azurerm_resource_group: collides with the built-in resource type",
+		},
+		{
+			name: "collision with type of another mapping file",
+			mapping: `{
+  "azurerm_fork_widget": {
+    "management_plane": {
+      "scopes": ["/subscriptions"],
+      "provider": "Microsoft.Fork",
+      "types": ["widgets"]
+    }
+  }
+}`,
+			loadErr: "azurerm_fork_widget: already defined in mapping file " + filepath.Join(dir, "mapping0.json"),
+		},
+		{
+			name: "invalid entry",
+			mapping: `{
+  "azurerm_fork_gadget": {
+    "management_plane": {
+      "scopes": ["/subscriptions/resourceGroups"],
+      "types": ["gadgets"]
+    }
+  }
+}`,
+			loadErr: "azurerm_fork_gadget: missing management_plane.provider",
+		},
+		{
+			name: "overlap with built-in type",
+			mapping: `{
+  "azurerm_fork_key_vault": {
+    "management_plane": {
+      "scopes": ["/subscriptions/resourceGroups"],
+      "provider": "Microsoft.KeyVault",
+      "types": ["vaults"]
+    }
+  }
+}`,
+			loadErr: "overlaps with azurerm_key_vault (built-in)",
+		},
+		{
+			name: "overlap with type of another mapping file",
+			mapping: `{
+  "azurerm_fork_widget_v2": {
+    "management_plane": {
+      "scopes": ["/subscriptions/resourceGroups"],
+      "provider": "Microsoft.Fork",
+      "types": ["widgets"]
+    }
+  }
+}`,
+			loadErr: "overlaps with azurerm_fork_widget (from mapping file " + filepath.Join(dir, "mapping0.json") + ")",
+		},
+		{
+			name: "overlap with opt-in",
+			mapping: `{
+  "azurerm_fork_widget_v2": {
+    "allow_overlap": true,
+    "management_plane": {
+      "scopes": ["/subscriptions/resourceGroups"],
+      "provider": "Microsoft.Fork",
+      "types": ["widgets"],
+      "import_specs": ["/subscriptions/resourceGroups/Microsoft.Fork/widgets"]
+    }
+  }
+}`,
+			input: widgetId,
+			expectTypes: []Type{
+				{
+					AzureId: MustParseId(t, widgetId),
+					TFType:  "azurerm_fork_widget",
+				},
+				{
+					AzureId: MustParseId(t, widgetId),
+					TFType:  "azurerm_fork_widget_v2",
+				},
+			},
+			expectIds: []string{
+				"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Fork/widgets/w1",
+				"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Fork/widgets/w1",
+			},
+		},
+	}
+
+	for i, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := LoadMappingFile(writeMappingFile(fmt.Sprintf("mapping%d.json", i), tt.mapping))
+			if tt.loadErr != "" {
+				require.ErrorContains(t, err, tt.loadErr)
+				return
+			}
+			require.NoError(t, err)
+			actualTypes, actualIds, _, err := QueryTypeAndId(tt.input, nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectTypes, actualTypes)
+			require.Equal(t, tt.expectIds, actualIds)
+		})
+	}
+}
+
+// TestQueryWithMappingFileOption loads the mapping file via the APIOption concurrently with the other queries, which is checked by the race detector.
+func TestQueryWithMappingFileOption(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "mapping.json")
+	require.NoError(t, os.WriteFile(p, []byte(`{
+  "azurerm_fork_gizmo": {
+    "management_plane": {
+      "scopes": ["/subscriptions/resourceGroups"],
+      "provider": "Microsoft.Fork",
+      "types": ["gizmos"],
+      "import_specs": ["/subscriptions/resourceGroups/Microsoft.Fork/gizmos"]
+    }
+  }
+}`), 0644))
+	gizmoId := "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Fork/gizmos/g1"
+	vnetId := "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1"
+	expectGizmo := []Type{{AzureId: MustParseId(t, gizmoId), TFType: "azurerm_fork_gizmo"}}
+	expectVnet := []Type{{AzureId: MustParseId(t, vnetId), TFType: "azurerm_virtual_network"}}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			types, _, err := QueryType(gizmoId, &APIOption{MappingFile: p})
+			assert.NoError(t, err)
+			assert.Equal(t, expectGizmo, types)
+		}()
+		go func() {
+			defer wg.Done()
+			types, _, err := QueryType(vnetId, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, expectVnet, types)
+		}()
+	}
+	wg.Wait()
+
+	schemaFile := filepath.Join(t.TempDir(), "schema.json")
+	require.NoError(t, os.WriteFile(schemaFile, []byte(`{"provider_schemas": {"registry.terraform.io/hashicorp/azurerm": {"resource_schemas": {
+  "azurerm_fork_gizmo": {},
+  "azurerm_fork_unknown": {}
+}}}}`), 0644))
+	missing, err := MissingTypes(&APIOption{MappingFile: p, ProviderSchemaFile: schemaFile})
+	require.NoError(t, err)
+	require.Equal(t, []string{"azurerm_fork_unknown"}, missing)
+}
+
+func TestQueryPermissions(t *testing.T) {
+	cases := []struct {
+		name   string
+		input  []string
+		opt    *APIOption
+		expect *Permissions
+		err    bool
+	}{
+		{
+			name:  "unknown resource type",
+			input: []string{"azurerm_foo"},
+			err:   true,
+		},
+		{
+			name:  "resource group",
+			input: []string{"azurerm_resource_group"},
+			expect: &Permissions{
+				Actions:     []string{"Microsoft.Resources/subscriptions/resourceGroups/read"},
+				DataActions: []string{},
+			},
+		},
+		{
+			name:  "resolver reading the parent resource",
+			input: []string{"azurerm_virtual_hub_bgp_connection"},
+			expect: &Permissions{
+				Actions:     []string{"Microsoft.Network/virtualHubs/bgpConnections/read", "Microsoft.Network/virtualHubs/read"},
+				DataActions: []string{},
+			},
+		},
+		{
+			name:  "populater and the property-like resources",
+			input: []string{"azurerm_nat_gateway"},
+			expect: &Permissions{
+				Actions:     []string{"Microsoft.Network/natGateways/read"},
+				DataActions: []string{},
+			},
+		},
+		{
+			name:  "dynamic builder",
+			input: []string{"azurerm_key_vault_secret"},
+			expect: &Permissions{
+				Actions:     []string{"Microsoft.KeyVault/vaults/read", "Microsoft.KeyVault/vaults/secrets/read"},
+				DataActions: []string{"Microsoft.KeyVault/vaults/secrets/getSecret/action", "Microsoft.KeyVault/vaults/secrets/readMetadata/action"},
+			},
+		},
+		{
+			name:  "data plane resource built offline",
+			input: []string{"azurerm_key_vault_certificate_issuer"},
+			expect: &Permissions{
+				Actions:     []string{"Microsoft.KeyVault/vaults/read"},
+				DataActions: []string{"Microsoft.KeyVault/vaults/certificatecas/read"},
+			},
+		},
+		{
+			name:  "populater listing the data plane resources",
+			input: []string{"azurerm_app_configuration"},
+			expect: &Permissions{
+				Actions:     []string{"Microsoft.AppConfiguration/configurationStores/read"},
+				DataActions: []string{"Microsoft.AppConfiguration/configurationStores/keyValues/read"},
+			},
+		},
+		{
+			name:  "data plane resource with endpoint from API",
+			input: []string{"azurerm_storage_queue"},
+			opt:   &APIOption{DataPlaneEndpointFromAPI: true},
+			expect: &Permissions{
+				Actions:     []string{"Microsoft.Storage/storageAccounts/listKeys/action", "Microsoft.Storage/storageAccounts/read"},
+				DataActions: []string{},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := QueryPermissions(tt.input, tt.opt)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestQueryTypeWithProviderSchema(t *testing.T) {
+	schemaFile := filepath.Join(t.TempDir(), "schema.json")
+	require.NoError(t, os.WriteFile(schemaFile, []byte(`{
+  "format_version": "1.0",
+  "provider_schemas": {
+    "registry.terraform.io/hashicorp/azurerm": {
+      "resource_schemas": {
+        "azurerm_linux_virtual_machine": {},
+        "azurerm_resource_group": {},
+        "azurerm_brand_new_resource": {}
+      }
+    },
+    "registry.terraform.io/hashicorp/random": {
+      "resource_schemas": {
+        "random_string": {}
+      }
+    }
+  }
+}`), 0644))
+
+	input := "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/vm1"
+
+	types, exact, err := QueryType(input, &APIOption{ProviderSchemaFile: schemaFile})
+	require.NoError(t, err)
+	require.False(t, exact)
+	require.Equal(t, []Type{
+		{
+			AzureId: MustParseId(t, input),
+			TFType:  "azurerm_linux_virtual_machine",
+		},
+		{
+			AzureId:     MustParseId(t, input),
+			TFType:      "azurerm_virtual_machine",
+			NotInSchema: true,
+		},
+		{
+			AzureId:     MustParseId(t, input),
+			TFType:      "azurerm_windows_virtual_machine",
+			NotInSchema: true,
+		},
+	}, types)
+
+	types, exact, err = QueryType(input, &APIOption{ProviderSchemaFile: schemaFile, FilterBySchema: true})
+	require.NoError(t, err)
+	require.True(t, exact)
+	require.Equal(t, []Type{
+		{
+			AzureId: MustParseId(t, input),
+			TFType:  "azurerm_linux_virtual_machine",
+		},
+	}, types)
+
+	missing, err := MissingTypes(&APIOption{ProviderSchemaFile: schemaFile})
+	require.NoError(t, err)
+	require.Equal(t, []string{"azurerm_brand_new_resource"}, missing)
+}
+
+func TestNormalizeId(t *testing.T) {
+	cases := []struct {
+		name             string
+		input            string
+		expect           string
+		err              bool
+		expectSuggestion string
+	}{
+		{
+			name:   "resource id",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1",
+		},
+		{
+			name:   "quoted resource id from az CLI output",
+			input:  `  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1",`,
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1",
+		},
+		{
+			name:   "portal URL",
+			input:  "https://portal.azure.com/#@contoso.onmicrosoft.com/resource/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1/overview",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1",
+		},
+		{
+			name:  "portal URL without resource id",
+			input: "https://portal.azure.com/#home",
+			err:   true,
+		},
+		{
+			name:   "ARM API URL",
+			input:  "https://management.azure.com/subscriptions/sub1/resourceGroups/rg1?api-version=2021-04-01",
+			expect: "/subscriptions/sub1/resourceGroups/rg1",
+		},
+		{
+			name:   "blade suffix and trailing slash",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/app1/Overview/",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/app1",
+		},
+		{
+			name:   "URL escapes",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet%25201",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet 1",
+		},
+		{
+			name:             "invalid id with a typo",
+			input:            "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Netwrk/virtualNetworks/vnet1/subnets",
+			err:              true,
+			expectSuggestion: "/MICROSOFT.NETWORK/VIRTUALNETWORKS/SUBNETS",
+		},
+		{
+			name:  "invalid id without suggestion",
+			input: "/subscriptions/sub1/resourceGroups/rg1/providers/Foo.Bar/bazs",
+			err:   true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := NormalizeId(tt.input)
+			if tt.err {
+				require.Error(t, err)
+				var ierr *InvalidIdError
+				require.ErrorAs(t, err, &ierr)
+				require.Equal(t, tt.expectSuggestion, ierr.Suggestion)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expect, actual)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package aztft
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/resmap"
+	"github.com/magodo/aztft/internal/tfid"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// DataSourceArg is a required argument of the data source, together with its value taken from the resource id.
+type DataSourceArg struct {
+	Name  string
+	Value string
+}
+
+// DataSource is the Terraform data source that reads the Azure resource of the Type.
+type DataSource struct {
+	Type           Type
+	DataSourceType string
+	Args           []DataSourceArg
+}
+
+// HCL returns the data source block, labeled by the name.
+func (ds DataSource) HCL(name string) string {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body().AppendNewBlock("data", []string{ds.DataSourceType, name}).Body()
+	for _, arg := range ds.Args {
+		body.SetAttributeValue(arg.Name, cty.StringVal(arg.Value))
+	}
+	return string(f.Bytes())
+}
+
+// QueryDataSource is similar to QueryType, except it returns the data sources of the matched Terraform resource types, whose required arguments
+// are taken from the resource id. The matched resource types that have no known data source are skipped, and the ones that share the same data source
+// (e.g. the Linux and Windows VMs, when the type is not exact) are deduplicated.
+func QueryDataSource(idStr string, apiOpt *APIOption) (dataSources []DataSource, exact bool, err error) {
+	unlock, err := lockMapping(apiOpt)
+	if err != nil {
+		return nil, false, err
+	}
+	defer unlock()
+	types, exact, err := queryType(idStr, apiOpt)
+	if err != nil {
+		return nil, false, err
+	}
+	seen := map[string]bool{}
+	for _, t := range types {
+		item, ok := resmap.TF2DataSourceMap[t.TFType]
+		if !ok {
+			continue
+		}
+		k := t.AzureId.String() + "|" + item.DataSourceType
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		args, err := dataSourceArgs(t.AzureId, item)
+		if err != nil {
+			return nil, false, fmt.Errorf("building the data source arguments of %q as %q: %v", t.AzureId, t.TFType, err)
+		}
+		dataSources = append(dataSources, DataSource{
+			Type:           t,
+			DataSourceType: item.DataSourceType,
+			Args:           args,
+		})
+	}
+	return dataSources, exact, nil
+}
+
+func dataSourceArgs(id armid.ResourceId, item resmap.DataSourceMapItem) ([]DataSourceArg, error) {
+	names := id.Names()
+	var args []DataSourceArg
+	for _, arg := range item.Args {
+		var value string
+		switch arg.From {
+		case resmap.DataSourceArgFromName:
+			if arg.Index >= len(names) {
+				return nil, fmt.Errorf("no name at index %d for %s", arg.Index, arg.Name)
+			}
+			value = names[arg.Index]
+		case resmap.DataSourceArgFromResourceGroup:
+			rg, ok := id.RootScope().(*armid.ResourceGroup)
+			if !ok {
+				return nil, fmt.Errorf("not a resource under a resource group for %s", arg.Name)
+			}
+			value = rg.Name
+		case resmap.DataSourceArgFromParentId:
+			parentId := id
+			for parentId != nil && len(parentId.Names()) > arg.Index {
+				parentId = parentId.Parent()
+			}
+			if parentId == nil || len(parentId.Names()) != arg.Index {
+				return nil, fmt.Errorf("no parent resource with %d names for %s", arg.Index, arg.Name)
+			}
+			var err error
+			value, err = tfid.StaticBuild(parentId, arg.ParentType)
+			if err != nil {
+				return nil, fmt.Errorf("building the id of the parent resource for %s: %v", arg.Name, err)
+			}
+		default:
+			return nil, fmt.Errorf("unknown source of %s", arg.Name)
+		}
+		args = append(args, DataSourceArg{Name: arg.Name, Value: value})
+	}
+	return args, nil
+}
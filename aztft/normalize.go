@@ -0,0 +1,171 @@
+package aztft
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/resmap"
+)
+
+// bladeSuffixes are the trailing segments of the Azure portal URLs (and the IDs copied from them) that open a blade of the resource, in lower case.
+var bladeSuffixes = map[string]bool{
+	"overview":       true,
+	"properties":     true,
+	"locks":          true,
+	"users":          true,
+	"tags":           true,
+	"activitylog":    true,
+	"diagnostics":    true,
+	"metrics":        true,
+	"alerts":         true,
+	"logs":           true,
+	"exporttemplate": true,
+	"deployments":    true,
+	"resources":      true,
+}
+
+// InvalidIdError is returned by NormalizeId if the input can't be normalized to a valid Azure resource id.
+type InvalidIdError struct {
+	Input string
+	Err   error
+	// Suggestion is the nearest valid routing scope (e.g. "/MICROSOFT.NETWORK/VIRTUALNETWORKS") in the mapping, if any.
+	Suggestion string
+}
+
+func (e *InvalidIdError) Error() string {
+	msg := fmt.Sprintf("invalid resource id %q: %v", e.Input, e.Err)
+	if e.Suggestion != "" {
+		msg += fmt.Sprintf(" (did you mean the resource type %s?)", e.Suggestion)
+	}
+	return msg
+}
+
+func (e *InvalidIdError) Unwrap() error { return e.Err }
+
+// NormalizeId normalizes the user input to an Azure resource id. The input can be:
+// - An Azure resource id, maybe quoted (e.g. copied from the "az" CLI output), or with the URL escapes
+// - An Azure portal URL of the resource (e.g. https://portal.azure.com/#@tenant/resource/subscriptions/.../overview)
+// - An ARM API URL of the resource (e.g. https://management.azure.com/subscriptions/...?api-version=...)
+// The known portal blade suffixes (e.g. "/overview") are stripped. If the result is still not a valid resource id,
+// an *InvalidIdError is returned, which suggests the nearest valid routing scope in the mapping.
+func NormalizeId(input string) (string, error) {
+	id := strings.TrimSpace(input)
+	id = strings.TrimSuffix(id, ",")
+	id = strings.Trim(id, `"'`)
+
+	if strings.HasPrefix(id, "https://") || strings.HasPrefix(id, "http://") {
+		u, err := url.Parse(id)
+		if err != nil {
+			return "", &InvalidIdError{Input: input, Err: fmt.Errorf("parsing URL: %v", err)}
+		}
+		// The portal URL has the resource id in its fragment, e.g. "#@tenant/resource/subscriptions/...".
+		switch {
+		case strings.Contains(u.Fragment, "/resource/"):
+			id = u.Fragment[strings.Index(u.Fragment, "/resource/")+len("/resource"):]
+		case u.Fragment != "":
+			return "", &InvalidIdError{Input: input, Err: fmt.Errorf("no resource id found in the portal URL")}
+		default:
+			id = u.EscapedPath()
+		}
+	}
+
+	// Decode the escapes until there is nothing to decode, as the portal URL might be encoded multiple times.
+	for strings.Contains(id, "%") {
+		decoded, err := url.PathUnescape(id)
+		if err != nil || decoded == id {
+			break
+		}
+		id = decoded
+	}
+
+	id = strings.TrimRight(id, "/")
+	if !strings.HasPrefix(id, "/") {
+		id = "/" + id
+	}
+
+	for {
+		_, err := armid.ParseResourceId(id)
+		if err == nil {
+			return id, nil
+		}
+		idx := strings.LastIndex(id, "/")
+		if idx <= 0 || !bladeSuffixes[strings.ToLower(id[idx+1:])] {
+			return "", &InvalidIdError{Input: input, Err: err, Suggestion: SuggestRouteScope(id)}
+		}
+		id = id[:idx]
+	}
+}
+
+// SuggestRouteScope suggests the nearest routing scope (e.g. "/MICROSOFT.NETWORK/VIRTUALNETWORKS") in the mapping for the (maybe invalid) resource id,
+// which is useful when the resource id has a typo in its provider or types. It returns an empty string if the routing scope of the id
+// already exists in the mapping, or nothing is near enough.
+func SuggestRouteScope(id string) string {
+	defer resmap.RLock()()
+
+	route := routeScopeOf(id)
+	if route == "" {
+		return ""
+	}
+	if _, ok := resmap.ARMId2TFMap[route]; ok {
+		return ""
+	}
+
+	var (
+		suggestion string
+		minDist    = len(route)/4 + 1
+	)
+	for k := range resmap.ARMId2TFMap {
+		d := levenshtein(route, k)
+		if d < minDist || (d == minDist && suggestion != "" && k < suggestion) {
+			suggestion, minDist = k, d
+		}
+	}
+	return suggestion
+}
+
+// routeScopeOf returns the upper cased routing scope of the (maybe invalid) resource id, i.e. the provider followed by the types,
+// which are the segments at the odd positions behind the last "/providers/".
+func routeScopeOf(id string) string {
+	upperId := strings.ToUpper("/" + strings.Trim(id, "/"))
+	idx := strings.LastIndex(upperId, "/PROVIDERS/")
+	if idx == -1 {
+		return ""
+	}
+	segs := strings.Split(upperId[idx+len("/PROVIDERS/"):], "/")
+	out := []string{segs[0]}
+	for i := 1; i < len(segs); i += 2 {
+		out = append(out, segs[i])
+	}
+	if len(out) == 1 {
+		return ""
+	}
+	return "/" + strings.Join(out, "/")
+}
+
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = prev[j] + 1
+			if cur[j-1]+1 < cur[j] {
+				cur[j] = cur[j-1] + 1
+			}
+			if prev[j-1]+cost < cur[j] {
+				cur[j] = prev[j-1] + cost
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,188 @@
+package aztft
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/magodo/aztft/internal/populate"
+	"github.com/magodo/aztft/internal/resmap"
+	"github.com/magodo/aztft/internal/resolve"
+	"github.com/magodo/aztft/internal/tfid"
+)
+
+// Permissions are the Azure RBAC permissions, in the form of the actions and data actions of a role definition.
+type Permissions struct {
+	Actions     []string
+	DataActions []string
+}
+
+// importPermissions are the permissions that the provider needs to read (i.e. import) the data plane resources,
+// which can't be derived from the management plane mapping.
+var importPermissions = map[string]Permissions{
+	// The provider reads the storage data plane resources via the storage account key by default.
+	"azurerm_storage_queue":                     storageImportPermissions,
+	"azurerm_storage_table":                     storageImportPermissions,
+	"azurerm_storage_blob":                      storageImportPermissions,
+	"azurerm_storage_share_directory":           storageImportPermissions,
+	"azurerm_storage_share_file":                storageImportPermissions,
+	"azurerm_storage_table_entity":              storageImportPermissions,
+	"azurerm_storage_data_lake_gen2_filesystem": storageImportPermissions,
+	"azurerm_storage_data_lake_gen2_path":       storageImportPermissions,
+
+	"azurerm_key_vault_key": {
+		Actions:     []string{"Microsoft.KeyVault/vaults/read"},
+		DataActions: []string{"Microsoft.KeyVault/vaults/keys/read"},
+	},
+	"azurerm_key_vault_secret": {
+		Actions:     []string{"Microsoft.KeyVault/vaults/read"},
+		DataActions: []string{"Microsoft.KeyVault/vaults/secrets/readMetadata/action", "Microsoft.KeyVault/vaults/secrets/getSecret/action"},
+	},
+	"azurerm_key_vault_certificate": {
+		Actions:     []string{"Microsoft.KeyVault/vaults/read"},
+		DataActions: []string{"Microsoft.KeyVault/vaults/certificates/read", "Microsoft.KeyVault/vaults/secrets/getSecret/action"},
+	},
+	"azurerm_key_vault_certificate_issuer": {
+		Actions:     []string{"Microsoft.KeyVault/vaults/read"},
+		DataActions: []string{"Microsoft.KeyVault/vaults/certificatecas/read"},
+	},
+	// The certificate contacts and the managed storage accounts are only accessible via the vault access policies, instead of RBAC.
+	"azurerm_key_vault_certificate_contacts": {
+		Actions: []string{"Microsoft.KeyVault/vaults/read"},
+	},
+	"azurerm_key_vault_managed_storage_account": {
+		Actions: []string{"Microsoft.KeyVault/vaults/read"},
+	},
+	"azurerm_key_vault_managed_storage_account_sas_token_definition": {
+		Actions: []string{"Microsoft.KeyVault/vaults/read"},
+	},
+
+	"azurerm_app_configuration_key":     appConfigurationImportPermissions,
+	"azurerm_app_configuration_feature": appConfigurationImportPermissions,
+}
+
+var storageImportPermissions = Permissions{
+	Actions: []string{"Microsoft.Storage/storageAccounts/read", "Microsoft.Storage/storageAccounts/listKeys/action"},
+}
+
+var appConfigurationImportPermissions = Permissions{
+	Actions:     []string{"Microsoft.AppConfiguration/configurationStores/read"},
+	DataActions: []string{"Microsoft.AppConfiguration/configurationStores/keyValues/read"},
+}
+
+// QueryPermissions returns the least-privilege permissions to query (with Azure API) and import the resources of the specified TF resource types.
+// This includes the read actions called by the resolvers, populaters and id builders, and the provider's own read actions for import.
+// As the populaters emit the property-like resources, the permissions to import them are included as well.
+func QueryPermissions(rts []string, apiOpt *APIOption) (*Permissions, error) {
+	unlock, err := lockMapping(apiOpt)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	actions := map[string]bool{}
+	dataActions := map[string]bool{}
+	add := func(m map[string]bool, l []string) {
+		for _, a := range l {
+			m[a] = true
+		}
+	}
+
+	// The property-like resource types emitted by the populaters, which are read by the provider via the populated resource.
+	// The real child resources (e.g. the app service slots) emitted by the populaters are read by themselves as usual.
+	propertyLikeParents := map[string]string{}
+	for prt, pts := range populate.PopulatedTypes {
+		item, ok := resmap.TF2ARMIdMap[prt]
+		if !ok || item.ManagementPlane == nil {
+			continue
+		}
+		mp := item.ManagementPlane
+		parent := strings.Join(append([]string{mp.Provider}, mp.Types...), "/")
+		for _, pt := range pts {
+			propertyLikeParents[strings.ToUpper(parent+"/"+pt)] = parent
+		}
+	}
+
+	var addType func(rt string) error
+	addType = func(rt string) error {
+		item, ok := resmap.TF2ARMIdMap[rt]
+		if !ok {
+			return fmt.Errorf("unknown resource type %q", rt)
+		}
+
+		// The provider's own read actions for import
+		if perms, ok := importPermissions[rt]; ok {
+			add(actions, perms.Actions)
+			add(dataActions, perms.DataActions)
+		} else if mp := item.ManagementPlane; mp != nil {
+			typeStr := strings.Join(append([]string{mp.Provider}, mp.Types...), "/")
+			parent, ok := propertyLikeParents[strings.ToUpper(typeStr)]
+			isPseudo := len(mp.ImportSpecs) == 0 || !strings.HasSuffix(strings.ToUpper(mp.ImportSpecs[0]), "/"+strings.ToUpper(typeStr))
+			if ok && isPseudo {
+				add(actions, []string{parent + "/read"})
+			} else {
+				add(actions, []string{typeStr + "/read"})
+			}
+		}
+
+		// The resolvers that disambiguate this resource type
+		for k1, m := range resolve.Resolvers {
+			for _, resolver := range m {
+				for _, t := range resolver.ResourceTypes() {
+					if t == rt {
+						add(actions, resolve.ReadActions[k1])
+					}
+				}
+			}
+		}
+
+		// The id builders
+		if !tfid.CanBuildOffline(rt) || (apiOpt != nil && apiOpt.DataPlaneEndpointFromAPI) {
+			add(actions, tfid.ReadActions[rt])
+		}
+
+		return nil
+	}
+
+	for _, rt := range rts {
+		if err := addType(rt); err != nil {
+			return nil, err
+		}
+
+		// The populater, together with the property-like resources that it emits
+		if !populate.NeedsAPI(rt) {
+			continue
+		}
+		add(actions, populate.ReadActions[rt])
+		mp := resmap.TF2ARMIdMap[rt].ManagementPlane
+		for _, pt := range populate.PopulatedTypes[rt] {
+			k1 := "/" + strings.ToUpper(strings.Join(append(append([]string{mp.Provider}, mp.Types...), pt), "/"))
+			for _, l := range resmap.ARMId2TFMap[k1] {
+				for _, item := range l {
+					if err := addType(item.ResourceType); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+	}
+
+	// The extension resources are read by the same actions as they are listed.
+	if apiOpt != nil && apiOpt.PopulateExtensions {
+		add(actions, populate.ExtensionReadActions)
+	}
+
+	return &Permissions{
+		Actions:     sortedKeys(actions),
+		DataActions: sortedKeys(dataActions),
+	}, nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	out := []string{}
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,120 @@
+package aztft
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/exampleid"
+	"github.com/magodo/aztft/internal/resmap"
+	"github.com/magodo/aztft/internal/tfid"
+	"github.com/stretchr/testify/require"
+)
+
+// roundTripSkips are the resource types that can't round-trip, with the reasons.
+var roundTripSkips = map[string]string{
+	"azurerm_network_manager_deployment": "the TF id is synthetic (i.e. <manager id>/commit|<location>|<type>), which is not an Azure resource id",
+}
+
+// roundTripIds synthesizes a representative Azure resource id for each parent scope of the mapping item.
+func roundTripIds(rt string, item resmap.TF2ARMIdMapItem) []armid.ResourceId {
+	mp := item.ManagementPlane
+
+	// Root scope resource ids
+	if len(mp.ParentScopes) == 0 {
+		return []armid.ResourceId{exampleid.Id(rt, "")}
+	}
+
+	var out []armid.ResourceId
+	for _, scope := range mp.ParentScopes {
+		if scope == resmap.ScopeAny {
+			// Use a resource scope, as the resource group and subscription scopes usually have their own resource types (e.g. azurerm_resource_group_policy_assignment).
+			scope = "/subscriptions/resourceGroups/Microsoft.Network/virtualNetworks"
+		}
+		out = append(out, exampleid.Id(rt, scope))
+	}
+	return out
+}
+
+// lowerTypes returns a copy of the resource id with the provider and types in lower case, to check the casing is normalized.
+func lowerTypes(id armid.ResourceId) armid.ResourceId {
+	id = id.Clone()
+	if rid, ok := id.(*armid.ScopedResourceId); ok {
+		rid.AttrProvider = strings.ToLower(rid.AttrProvider)
+		for i := range rid.AttrTypes {
+			rid.AttrTypes[i] = strings.ToLower(rid.AttrTypes[i])
+		}
+	}
+	return id
+}
+
+func hasTFType(types []Type, rt string) bool {
+	for _, typ := range types {
+		if typ.TFType == rt {
+			return true
+		}
+	}
+	return false
+}
+
+func TestRoundTrip(t *testing.T) {
+	resmap.Init()
+
+	var rts []string
+	for rt, item := range resmap.TF2ARMIdMap {
+		if item.IsRemoved || item.ManagementPlane == nil {
+			continue
+		}
+		rts = append(rts, rt)
+	}
+	sort.Strings(rts)
+
+	for _, rt := range rts {
+		rt := rt
+		t.Run(rt, func(t *testing.T) {
+			if reason, ok := roundTripSkips[rt]; ok {
+				t.Skip(reason)
+			}
+			for _, id := range roundTripIds(rt, resmap.TF2ARMIdMap[rt]) {
+				input := lowerTypes(id).String()
+
+				// QueryType finds the type
+				types, _, err := QueryType(input, nil)
+				require.NoError(t, err, input)
+				require.True(t, hasTFType(types, rt), "querying type of %s: %v", input, types)
+
+				if tfid.NeedsAPI(rt) {
+					continue
+				}
+
+				// StaticBuild succeeds
+				tfId, err := tfid.StaticBuild(lowerTypes(id), rt)
+				require.NoError(t, err, input)
+
+				importSpec, err := tfid.GetImportSpec(id, rt)
+				require.NoError(t, err, input)
+				if importSpec == "" {
+					continue
+				}
+
+				// The result matches the import spec's casing. Only the provider and types of the scoped resource id are compared,
+				// as the parsed root scope (e.g. the management group) always has the casing of armid.
+				outId, err := armid.ParseResourceId(tfId)
+				require.NoError(t, err, "parsing the TF id %s", tfId)
+				require.True(t, strings.EqualFold(importSpec, outId.ScopeString()), "TF id %s doesn't match the import spec %s", tfId, importSpec)
+				if _, ok := outId.(*armid.ScopedResourceId); ok {
+					require.True(t, strings.HasSuffix(importSpec, "/"+outId.TypeString()), "TF id %s doesn't match the casing of the import spec %s", tfId, importSpec)
+				}
+
+				// The TF id maps back to the same Azure resource id, if the import spec is of the same resource type
+				if strings.EqualFold(importSpec, id.ScopeString()) {
+					require.True(t, strings.EqualFold(id.String(), tfId), "TF id %s doesn't map back to %s", tfId, id)
+					types, _, err := QueryType(tfId, nil)
+					require.NoError(t, err, tfId)
+					require.True(t, hasTFType(types, rt), "querying type of the TF id %s: %v", tfId, types)
+				}
+			}
+		})
+	}
+}
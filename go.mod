@@ -55,6 +55,7 @@ require (
 	github.com/magodo/armid v0.0.0-20230511151020-27880e5961c3
 	github.com/stretchr/testify v1.9.0
 	github.com/urfave/cli/v2 v2.24.1
+	github.com/zclconf/go-cty v1.13.0
 )
 
 require (
@@ -73,7 +74,6 @@ require (
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/russross/blackfriday/v2 v2.1.0 // indirect
 	github.com/xrash/smetrics v0.0.0-20201216005158-039620a65673 // indirect
-	github.com/zclconf/go-cty v1.13.0 // indirect
 	golang.org/x/crypto v0.25.0 // indirect
 	golang.org/x/net v0.27.0 // indirect
 	golang.org/x/sys v0.22.0 // indirect
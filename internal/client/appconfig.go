@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+)
+
+// AppConfigurationDataClient is the client of the App Configuration store's data plane API, as there is no SDK for it in use.
+type AppConfigurationDataClient struct {
+	endpoint string
+	pl       runtime.Pipeline
+}
+
+// AppConfigurationKeyValue is a key-value of the App Configuration store. The feature flags are also key-values, whose keys are prefixed by ".appconfig.featureflag/".
+type AppConfigurationKeyValue struct {
+	Key string `json:"key"`
+	// Label is nil for the key-values without a label.
+	Label       *string `json:"label"`
+	ContentType *string `json:"content_type"`
+}
+
+// NewAppConfigurationDataClient creates the data plane client of the App Configuration store, whose endpoint is e.g. "https://store1.azconfig.io".
+// The subscription id is the one that the store belongs to, which is only used to choose the credential.
+func (b *ClientBuilder) NewAppConfigurationDataClient(subscriptionId, endpoint string) (*AppConfigurationDataClient, error) {
+	endpoint = strings.TrimSuffix(endpoint, "/")
+	return cachedClient(b, subscriptionId, endpoint, func(cred azcore.TokenCredential) (*AppConfigurationDataClient, error) {
+		pl := runtime.NewPipeline("appconfiguration", "v0.1.0", runtime.PipelineOptions{
+			PerRetry: []policy.Policy{runtime.NewBearerTokenPolicy(cred, []string{endpoint + "/.default"}, nil)},
+		}, &b.ClientOpt.ClientOptions)
+		return &AppConfigurationDataClient{
+			endpoint: endpoint,
+			pl:       pl,
+		}, nil
+	})
+}
+
+// ListKeyValues lists all the key-values (including the feature flags) of the store, following the next links of the pages.
+func (client *AppConfigurationDataClient) ListKeyValues(ctx context.Context) ([]AppConfigurationKeyValue, error) {
+	link := "/kv?api-version=1.0"
+
+	var result []AppConfigurationKeyValue
+	for link != "" {
+		req, err := runtime.NewRequest(ctx, http.MethodGet, client.endpoint+link)
+		if err != nil {
+			return nil, err
+		}
+		req.Raw().Header.Set("Accept", "application/vnd.microsoft.appconfig.kvset+json, application/problem+json")
+		resp, err := client.pl.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if !runtime.HasStatusCode(resp, http.StatusOK) {
+			return nil, runtime.NewResponseError(resp)
+		}
+		var page struct {
+			Items    []AppConfigurationKeyValue `json:"items"`
+			NextLink string                     `json:"@nextLink"`
+		}
+		if err := runtime.UnmarshalAsJSON(resp, &page); err != nil {
+			return nil, err
+		}
+		result = append(result, page.Items...)
+		link = page.NextLink
+	}
+	return result, nil
+}
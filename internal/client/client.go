@@ -1,6 +1,11 @@
 package client
 
 import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/alertsmanagement/armalertsmanagement"
@@ -51,687 +56,518 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/workloads/armworkloads"
 )
 
+// CredentialResolver resolves the credential to access the specified subscription.
+// The subscriptionId is empty for the resources that are not under any subscription (e.g. management groups).
+type CredentialResolver func(subscriptionId string) (azcore.TokenCredential, error)
+
 type ClientBuilder struct {
-	Cred      azcore.TokenCredential
-	ClientOpt arm.ClientOptions
+	Cred azcore.TokenCredential
+	// CredResolver, if not nil, takes precedence over the Cred to resolve the credential per subscription.
+	CredResolver CredentialResolver
+	ClientOpt    arm.ClientOptions
+
+	// ctx is the context of the Azure API calls, see WithContext.
+	ctx context.Context
+	// cache caches the clients per subscription, which is only set by NewClientBuilder and is shared with the copies made by WithContext.
+	cache *clientCache
+}
+
+// NewClientBuilder creates a ClientBuilder that caches the clients per subscription, so that the clients (and their pipelines) are built only once,
+// and can be shared by the queries, e.g. the ones of a long running server. The fields must not be changed afterwards.
+func NewClientBuilder(cred azcore.TokenCredential, credResolver CredentialResolver, clientOpt arm.ClientOptions) *ClientBuilder {
+	return &ClientBuilder{
+		Cred:         cred,
+		CredResolver: credResolver,
+		ClientOpt:    clientOpt,
+		cache:        &clientCache{clients: map[clientKey]interface{}{}},
+	}
+}
+
+// WithContext returns a copy of the builder, sharing the same cached clients, whose Context is ctx.
+func (b *ClientBuilder) WithContext(ctx context.Context) *ClientBuilder {
+	nb := *b
+	nb.ctx = ctx
+	return &nb
+}
+
+// Context returns the context that the Azure API calls are made with, which defaults to the background context.
+func (b *ClientBuilder) Context() context.Context {
+	if b.ctx == nil {
+		return context.Background()
+	}
+	return b.ctx
+}
+
+func (b *ClientBuilder) credential(subscriptionId string) (azcore.TokenCredential, error) {
+	if b.CredResolver == nil {
+		return b.Cred, nil
+	}
+	cred, err := b.CredResolver(subscriptionId)
+	if err != nil {
+		return nil, fmt.Errorf("resolving credential for subscription %q: %v", subscriptionId, err)
+	}
+	return cred, nil
+}
+
+// clientKey identifies a cached client by its type, subscription and the extra arguments (e.g. the data plane endpoint) of its constructor.
+type clientKey struct {
+	typ            reflect.Type
+	subscriptionId string
+	args           string
+}
+
+type clientCache struct {
+	mu      sync.Mutex
+	clients map[clientKey]interface{}
+}
+
+// cachedClient returns the client of the subscription, which is built by the build function, and cached if the builder has a cache.
+// The args must identify the other arguments of the client constructor than the subscription id and the credential.
+func cachedClient[T any](b *ClientBuilder, subscriptionId, args string, build func(cred azcore.TokenCredential) (T, error)) (T, error) {
+	var zero T
+	if b.cache != nil {
+		b.cache.mu.Lock()
+		defer b.cache.mu.Unlock()
+	}
+	key := clientKey{typ: reflect.TypeOf(zero), subscriptionId: subscriptionId, args: args}
+	if b.cache != nil {
+		if client, ok := b.cache.clients[key]; ok {
+			return client.(T), nil
+		}
+	}
+	cred, err := b.credential(subscriptionId)
+	if err != nil {
+		return zero, err
+	}
+	client, err := build(cred)
+	if err != nil {
+		return zero, err
+	}
+	if b.cache != nil {
+		b.cache.clients[key] = client
+	}
+	return client, nil
+}
+
+// newClient returns the (cached) client of the subscription, which is built by the SDK client constructor.
+func newClient[T any](b *ClientBuilder, subscriptionId string, newFunc func(string, azcore.TokenCredential, *arm.ClientOptions) (T, error)) (T, error) {
+	return cachedClient(b, subscriptionId, "", func(cred azcore.TokenCredential) (T, error) {
+		return newFunc(subscriptionId, cred, &b.ClientOpt)
+	})
 }
 
 func (b *ClientBuilder) NewVirtualMachinesClient(subscriptionId string) (*armcompute.VirtualMachinesClient, error) {
-	return armcompute.NewVirtualMachinesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armcompute.NewVirtualMachinesClient)
 }
 
 func (b *ClientBuilder) NewVirtualMachineScaleSetsClient(subscriptionId string) (*armcompute.VirtualMachineScaleSetsClient, error) {
-	return armcompute.NewVirtualMachineScaleSetsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armcompute.NewVirtualMachineScaleSetsClient)
 }
 
 func (b *ClientBuilder) NewDevTestVirtualMachinesClient(subscriptionId string) (*armdevtestlabs.VirtualMachinesClient, error) {
-	return armdevtestlabs.NewVirtualMachinesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armdevtestlabs.NewVirtualMachinesClient)
 }
 
 func (b *ClientBuilder) NewRecoveryservicesBackupProtectedItemsClient(subscriptionId string) (*armrecoveryservicesbackup.ProtectedItemsClient, error) {
-	return armrecoveryservicesbackup.NewProtectedItemsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armrecoveryservicesbackup.NewProtectedItemsClient)
 }
 
 func (b *ClientBuilder) NewRecoveryServicesBackupProtectionPoliciesClient(subscriptionId string) (*armrecoveryservicesbackup.ProtectionPoliciesClient, error) {
-	return armrecoveryservicesbackup.NewProtectionPoliciesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armrecoveryservicesbackup.NewProtectionPoliciesClient)
 }
 
 func (b *ClientBuilder) NewDataProtectionBackupPoliciesClient(subscriptionId string) (*armdataprotection.BackupPoliciesClient, error) {
-	return armdataprotection.NewBackupPoliciesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armdataprotection.NewBackupPoliciesClient)
 }
 
 func (b *ClientBuilder) NewDataProtectionBackupInstancesClient(subscriptionId string) (*armdataprotection.BackupInstancesClient, error) {
-	return armdataprotection.NewBackupInstancesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armdataprotection.NewBackupInstancesClient)
 }
 
 func (b *ClientBuilder) NewSynapseIntegrationRuntimesClient(subscriptionId string) (*armsynapse.IntegrationRuntimesClient, error) {
-	return armsynapse.NewIntegrationRuntimesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armsynapse.NewIntegrationRuntimesClient)
 }
 
 func (b *ClientBuilder) NewDigitalTwinsEndpointsClient(subscriptionId string) (*armdigitaltwins.EndpointClient, error) {
-	return armdigitaltwins.NewEndpointClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armdigitaltwins.NewEndpointClient)
 }
 
 func (b *ClientBuilder) NewDataFactoryTriggersClient(subscriptionId string) (*armdatafactory.TriggersClient, error) {
-	return armdatafactory.NewTriggersClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armdatafactory.NewTriggersClient)
 }
 
 func (b *ClientBuilder) NewDataFactoryDatasetsClient(subscriptionId string) (*armdatafactory.DatasetsClient, error) {
-	return armdatafactory.NewDatasetsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armdatafactory.NewDatasetsClient)
 }
 
 func (b *ClientBuilder) NewDataFactoryDataFlowsClient(subscriptionId string) (*armdatafactory.DataFlowsClient, error) {
-	return armdatafactory.NewDataFlowsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armdatafactory.NewDataFlowsClient)
 }
 
 func (b *ClientBuilder) NewDataFactoryLinkedServicesClient(subscriptionId string) (*armdatafactory.LinkedServicesClient, error) {
-	return armdatafactory.NewLinkedServicesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armdatafactory.NewLinkedServicesClient)
 }
 
 func (b *ClientBuilder) NewDataFactoryIntegrationRuntimesClient(subscriptionId string) (*armdatafactory.IntegrationRuntimesClient, error) {
-	return armdatafactory.NewIntegrationRuntimesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armdatafactory.NewIntegrationRuntimesClient)
 }
 
 func (b *ClientBuilder) NewDataFactoryCredentialsClient(subscriptionId string) (*armdatafactory.CredentialOperationsClient, error) {
-	return armdatafactory.NewCredentialOperationsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armdatafactory.NewCredentialOperationsClient)
 }
 
 func (b *ClientBuilder) NewKustoDataConnectionsClient(subscriptionId string) (*armkusto.DataConnectionsClient, error) {
-	return armkusto.NewDataConnectionsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armkusto.NewDataConnectionsClient)
 }
 
 func (b *ClientBuilder) NewMachineLearningWorkspaceClient(subscriptionId string) (*armmachinelearning.WorkspacesClient, error) {
-	return armmachinelearning.NewWorkspacesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armmachinelearning.NewWorkspacesClient)
 }
 
 func (b *ClientBuilder) NewMachineLearningComputeClient(subscriptionId string) (*armmachinelearning.ComputeClient, error) {
-	return armmachinelearning.NewComputeClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armmachinelearning.NewComputeClient)
 }
 
 func (b *ClientBuilder) NewMachineLearningDataStoreClient(subscriptionId string) (*armmachinelearning.DatastoresClient, error) {
-	return armmachinelearning.NewDatastoresClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armmachinelearning.NewDatastoresClient)
 }
 
 func (b *ClientBuilder) NewMachineLearningOutboundRulesClient(subscriptionId string) (*armmachinelearning.ManagedNetworkSettingsRuleClient, error) {
-	return armmachinelearning.NewManagedNetworkSettingsRuleClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armmachinelearning.NewManagedNetworkSettingsRuleClient)
 }
 func (b *ClientBuilder) NewTimeSeriesInsightEnvironmentsClient(subscriptionId string) (*armtimeseriesinsights.EnvironmentsClient, error) {
-	return armtimeseriesinsights.NewEnvironmentsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armtimeseriesinsights.NewEnvironmentsClient)
 }
 
 func (b *ClientBuilder) NewTimeSeriesInsightEventSourcesClient(subscriptionId string) (*armtimeseriesinsights.EventSourcesClient, error) {
-	return armtimeseriesinsights.NewEventSourcesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armtimeseriesinsights.NewEventSourcesClient)
 }
 
 func (b *ClientBuilder) NewStorageCacheTargetsClient(subscriptionId string) (*armstoragecache.StorageTargetsClient, error) {
-	return armstoragecache.NewStorageTargetsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armstoragecache.NewStorageTargetsClient)
 }
 
 func (b *ClientBuilder) NewAutomationConnectionClient(subscriptionId string) (*armautomation.ConnectionClient, error) {
-	return armautomation.NewConnectionClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armautomation.NewConnectionClient)
 }
 
 func (b *ClientBuilder) NewAutomationVariableClient(subscriptionId string) (*armautomation.VariableClient, error) {
-	return armautomation.NewVariableClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armautomation.NewVariableClient)
 }
 
 func (b *ClientBuilder) NewAutomationJobScheduleClient(subscriptionId string) (*armautomation.JobScheduleClient, error) {
-	return armautomation.NewJobScheduleClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armautomation.NewJobScheduleClient)
 }
 
 func (b *ClientBuilder) NewBotServiceBotsClient(subscriptionId string) (*armbotservice.BotsClient, error) {
-	return armbotservice.NewBotsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armbotservice.NewBotsClient)
 }
 
 func (b *ClientBuilder) NewBotServiceChannelsClient(subscriptionId string) (*armbotservice.ChannelsClient, error) {
-	return armbotservice.NewChannelsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armbotservice.NewChannelsClient)
 }
 
 func (b *ClientBuilder) NewSecurityInsightsDataConnectorsClient(subscriptionId string) (*armsecurityinsights.DataConnectorsClient, error) {
-	return armsecurityinsights.NewDataConnectorsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armsecurityinsights.NewDataConnectorsClient)
 }
 
 func (b *ClientBuilder) NewSecurityInsightsAlertRulesClient(subscriptionId string) (*armsecurityinsights.AlertRulesClient, error) {
-	return armsecurityinsights.NewAlertRulesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armsecurityinsights.NewAlertRulesClient)
 }
 
 func (b *ClientBuilder) NewSecurityInsightsSecurityMLAnalyticsSettingsClient(subscriptionId string) (*armsecurityinsights.SecurityMLAnalyticsSettingsClient, error) {
-	return armsecurityinsights.NewSecurityMLAnalyticsSettingsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armsecurityinsights.NewSecurityMLAnalyticsSettingsClient)
 }
 
 func (b *ClientBuilder) NewOperationalInsightsDataSourcesClient(subscriptionId string) (*armoperationalinsights.DataSourcesClient, error) {
-	return armoperationalinsights.NewDataSourcesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armoperationalinsights.NewDataSourcesClient)
 }
 
 func (b *ClientBuilder) NewAppPlatformBindingsClient(subscriptionId string) (*armappplatform.BindingsClient, error) {
-	return armappplatform.NewBindingsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armappplatform.NewBindingsClient)
 }
 
 func (b *ClientBuilder) NewAppPlatformDeploymentsClient(subscriptionId string) (*armappplatform.DeploymentsClient, error) {
-	return armappplatform.NewDeploymentsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armappplatform.NewDeploymentsClient)
 }
 
 func (b *ClientBuilder) NewDatashareDatasetsClient(subscriptionId string) (*armdatashare.DataSetsClient, error) {
-	return armdatashare.NewDataSetsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armdatashare.NewDataSetsClient)
 }
 
 func (b *ClientBuilder) NewHDInsightClustersClient(subscriptionId string) (*armhdinsight.ClustersClient, error) {
-	return armhdinsight.NewClustersClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armhdinsight.NewClustersClient)
 }
 
 func (b *ClientBuilder) NewStreamAnalyticsInputsClient(subscriptionId string) (*armstreamanalytics.InputsClient, error) {
-	return armstreamanalytics.NewInputsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armstreamanalytics.NewInputsClient)
 }
 
 func (b *ClientBuilder) NewStreamAnalyticsOutputsClient(subscriptionId string) (*armstreamanalytics.OutputsClient, error) {
-	return armstreamanalytics.NewOutputsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armstreamanalytics.NewOutputsClient)
 }
 
 func (b *ClientBuilder) NewStreamAnalyticsFunctionsClient(subscriptionId string) (*armstreamanalytics.FunctionsClient, error) {
-	return armstreamanalytics.NewFunctionsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armstreamanalytics.NewFunctionsClient)
 }
 
 func (b *ClientBuilder) NewStreamAnalyticsJobsClient(subscriptionId string) (*armstreamanalytics.StreamingJobsClient, error) {
-	return armstreamanalytics.NewStreamingJobsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armstreamanalytics.NewStreamingJobsClient)
 }
 
 func (b *ClientBuilder) NewMonitorScheduledQueryRulesClient(subscriptionId string) (*armmonitor.ScheduledQueryRulesClient, error) {
-	return armmonitor.NewScheduledQueryRulesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armmonitor.NewScheduledQueryRulesClient)
 }
 
 func (b *ClientBuilder) NewCdnProfilesClient(subscriptionId string) (*armcdn.ProfilesClient, error) {
-	return armcdn.NewProfilesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armcdn.NewProfilesClient)
 }
 
 func (b *ClientBuilder) NewAppServiceCertificatesClient(subscriptionId string) (*armappservice.CertificatesClient, error) {
-	return armappservice.NewCertificatesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armappservice.NewCertificatesClient)
 }
 
 func (b *ClientBuilder) NewAppServiceWebAppsClient(subscriptionId string) (*armappservice.WebAppsClient, error) {
-	return armappservice.NewWebAppsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armappservice.NewWebAppsClient)
 }
 
 func (b *ClientBuilder) NewAppServiceEnvironmentsClient(subscriptionId string) (*armappservice.EnvironmentsClient, error) {
-	return armappservice.NewEnvironmentsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armappservice.NewEnvironmentsClient)
 }
 
 func (b *ClientBuilder) NewAlertsManagementProcessingRulesClient(subscriptionId string) (*armalertsmanagement.AlertProcessingRulesClient, error) {
-	return armalertsmanagement.NewAlertProcessingRulesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armalertsmanagement.NewAlertProcessingRulesClient)
 }
 
 func (b *ClientBuilder) NewDomainServiceClient(subscriptionId string) (*armdomainservices.Client, error) {
-	return armdomainservices.NewClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armdomainservices.NewClient)
 }
 
 func (b *ClientBuilder) NewStorageObjectReplicationPoliciesClient(subscriptionId string) (*armstorage.ObjectReplicationPoliciesClient, error) {
-	return armstorage.NewObjectReplicationPoliciesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armstorage.NewObjectReplicationPoliciesClient)
 }
 
 func (b *ClientBuilder) NewStorageFileSharesClient(subscriptionId string) (*armstorage.FileSharesClient, error) {
-	return armstorage.NewFileSharesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armstorage.NewFileSharesClient)
 }
 
 func (b *ClientBuilder) NewStorageAccountsClient(subscriptionId string) (*armstorage.AccountsClient, error) {
-	return armstorage.NewAccountsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armstorage.NewAccountsClient)
+}
+
+func (b *ClientBuilder) NewStorageManagementPoliciesClient(subscriptionId string) (*armstorage.ManagementPoliciesClient, error) {
+	return newClient(b, subscriptionId, armstorage.NewManagementPoliciesClient)
+}
+
+func (b *ClientBuilder) NewStorageBlobInventoryPoliciesClient(subscriptionId string) (*armstorage.BlobInventoryPoliciesClient, error) {
+	return newClient(b, subscriptionId, armstorage.NewBlobInventoryPoliciesClient)
 }
 
 func (b *ClientBuilder) NewKeyVaultVaultsClient(subscriptionId string) (*armkeyvault.VaultsClient, error) {
-	return armkeyvault.NewVaultsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armkeyvault.NewVaultsClient)
 }
 
 func (b *ClientBuilder) NewKeyVaultKeysClient(subscriptionId string) (*armkeyvault.KeysClient, error) {
-	return armkeyvault.NewKeysClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armkeyvault.NewKeysClient)
 }
 
 func (b *ClientBuilder) NewKeyVaultSecretsClient(subscriptionId string) (*armkeyvault.SecretsClient, error) {
-	return armkeyvault.NewSecretsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armkeyvault.NewSecretsClient)
 }
 
 func (b *ClientBuilder) NewNetworkVirtualHubsClient(subscriptionId string) (*armnetwork.VirtualHubsClient, error) {
-	return armnetwork.NewVirtualHubsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armnetwork.NewVirtualHubsClient)
 }
 
 func (b *ClientBuilder) NewNetworkVirtualHubBgpConnectionClient(subscriptionId string) (*armnetwork.VirtualHubBgpConnectionClient, error) {
-	return armnetwork.NewVirtualHubBgpConnectionClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armnetwork.NewVirtualHubBgpConnectionClient)
 }
 
 func (b *ClientBuilder) NewNetworkInterfacesClient(subscriptionId string) (*armnetwork.InterfacesClient, error) {
-	return armnetwork.NewInterfacesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armnetwork.NewInterfacesClient)
 }
 
 func (b *ClientBuilder) NewNetworkSubnetsClient(subscriptionId string) (*armnetwork.SubnetsClient, error) {
-	return armnetwork.NewSubnetsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armnetwork.NewSubnetsClient)
 }
 
 func (b *ClientBuilder) NewNetworkNatGatewaysClient(subscriptionId string) (*armnetwork.NatGatewaysClient, error) {
-	return armnetwork.NewNatGatewaysClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armnetwork.NewNatGatewaysClient)
+}
+
+func (b *ClientBuilder) NewNetworkPrivateEndpointsClient(subscriptionId string) (*armnetwork.PrivateEndpointsClient, error) {
+	return newClient(b, subscriptionId, armnetwork.NewPrivateEndpointsClient)
 }
 
 func (b *ClientBuilder) NewNetworkPacketCapturesClient(subscriptionId string) (*armnetwork.PacketCapturesClient, error) {
-	return armnetwork.NewPacketCapturesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armnetwork.NewPacketCapturesClient)
 }
 
 func (b *ClientBuilder) NewNetworkManagementDeploymentStatusClient(subscriptionId string) (*armnetwork.ManagerDeploymentStatusClient, error) {
-	return armnetwork.NewManagerDeploymentStatusClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armnetwork.NewManagerDeploymentStatusClient)
 }
 
 func (b *ClientBuilder) NewNetworkLoadBalancersClient(subscriptionId string) (*armnetwork.LoadBalancersClient, error) {
-	return armnetwork.NewLoadBalancersClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armnetwork.NewLoadBalancersClient)
 }
 
 func (b *ClientBuilder) NewFrontdoorPoliciesClient(subscriptionId string) (*armfrontdoor.PoliciesClient, error) {
-	return armfrontdoor.NewPoliciesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armfrontdoor.NewPoliciesClient)
 }
 
 func (b *ClientBuilder) NewDesktopVirtualizationWorkspacesClient(subscriptionId string) (*armdesktopvirtualization.WorkspacesClient, error) {
-	return armdesktopvirtualization.NewWorkspacesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armdesktopvirtualization.NewWorkspacesClient)
+}
+
+func (b *ClientBuilder) NewDesktopVirtualizationScalingPlansClient(subscriptionId string) (*armdesktopvirtualization.ScalingPlansClient, error) {
+	return newClient(b, subscriptionId, armdesktopvirtualization.NewScalingPlansClient)
 }
 
 func (b *ClientBuilder) NewStoragePoolDiskPoolsClient(subscriptionId string) (*armstoragepool.DiskPoolsClient, error) {
-	return armstoragepool.NewDiskPoolsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armstoragepool.NewDiskPoolsClient)
 }
 
 func (b *ClientBuilder) NewStoragePoolIscsiTargetsClient(subscriptionId string) (*armstoragepool.IscsiTargetsClient, error) {
-	return armstoragepool.NewIscsiTargetsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armstoragepool.NewIscsiTargetsClient)
 }
 
 func (b *ClientBuilder) NewDeploymentScriptsClient(subscriptionId string) (*armdeploymentscripts.Client, error) {
-	return armdeploymentscripts.NewClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armdeploymentscripts.NewClient)
 }
 
 func (b *ClientBuilder) NewSiteRecoveryReplicationPoliciesClient(subscriptionId, resourceGroupName, vaultName string) (*armrecoveryservicessiterecovery.ReplicationPoliciesClient, error) {
-	return armrecoveryservicessiterecovery.NewReplicationPoliciesClient(
-		vaultName,
-		resourceGroupName,
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return cachedClient(b, subscriptionId, resourceGroupName+"/"+vaultName, func(cred azcore.TokenCredential) (*armrecoveryservicessiterecovery.ReplicationPoliciesClient, error) {
+		return armrecoveryservicessiterecovery.NewReplicationPoliciesClient(
+			vaultName,
+			resourceGroupName,
+			subscriptionId,
+			cred,
+			&b.ClientOpt,
+		)
+	})
 }
 
 func (b *ClientBuilder) NewSiteRecoveryReplicationFabricsClient(subscriptionId, resourceGroupName, vaultName string) (*armrecoveryservicessiterecovery.ReplicationFabricsClient, error) {
-	return armrecoveryservicessiterecovery.NewReplicationFabricsClient(
-		vaultName,
-		resourceGroupName,
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return cachedClient(b, subscriptionId, resourceGroupName+"/"+vaultName, func(cred azcore.TokenCredential) (*armrecoveryservicessiterecovery.ReplicationFabricsClient, error) {
+		return armrecoveryservicessiterecovery.NewReplicationFabricsClient(
+			vaultName,
+			resourceGroupName,
+			subscriptionId,
+			cred,
+			&b.ClientOpt,
+		)
+	})
 }
 
 func (b *ClientBuilder) NewSiteRecoveryReplicationProtectedItemsClient(subscriptionId, resourceGroupName, vaultName string) (*armrecoveryservicessiterecovery.ReplicationProtectedItemsClient, error) {
-	return armrecoveryservicessiterecovery.NewReplicationProtectedItemsClient(
-		vaultName,
-		resourceGroupName,
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return cachedClient(b, subscriptionId, resourceGroupName+"/"+vaultName, func(cred azcore.TokenCredential) (*armrecoveryservicessiterecovery.ReplicationProtectedItemsClient, error) {
+		return armrecoveryservicessiterecovery.NewReplicationProtectedItemsClient(
+			vaultName,
+			resourceGroupName,
+			subscriptionId,
+			cred,
+			&b.ClientOpt,
+		)
+	})
 }
 
 func (b *ClientBuilder) NewSiteRecoveryReplicationProtectionContainerMappingsClient(subscriptionId, resourceGroupName, vaultName string) (*armrecoveryservicessiterecovery.ReplicationProtectionContainerMappingsClient, error) {
-	return armrecoveryservicessiterecovery.NewReplicationProtectionContainerMappingsClient(
-		vaultName,
-		resourceGroupName,
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return cachedClient(b, subscriptionId, resourceGroupName+"/"+vaultName, func(cred azcore.TokenCredential) (*armrecoveryservicessiterecovery.ReplicationProtectionContainerMappingsClient, error) {
+		return armrecoveryservicessiterecovery.NewReplicationProtectionContainerMappingsClient(
+			vaultName,
+			resourceGroupName,
+			subscriptionId,
+			cred,
+			&b.ClientOpt,
+		)
+	})
 }
 
 func (b *ClientBuilder) NewSiteRecoveryReplicationNetworkMappingsClient(subscriptionId, resourceGroupName, vaultName string) (*armrecoveryservicessiterecovery.ReplicationNetworkMappingsClient, error) {
-	return armrecoveryservicessiterecovery.NewReplicationNetworkMappingsClient(
-		vaultName,
-		resourceGroupName,
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return cachedClient(b, subscriptionId, resourceGroupName+"/"+vaultName, func(cred azcore.TokenCredential) (*armrecoveryservicessiterecovery.ReplicationNetworkMappingsClient, error) {
+		return armrecoveryservicessiterecovery.NewReplicationNetworkMappingsClient(
+			vaultName,
+			resourceGroupName,
+			subscriptionId,
+			cred,
+			&b.ClientOpt,
+		)
+	})
 }
 
 func (b *ClientBuilder) NewStorageMoverEndpointsClient(subscriptionId string) (*armstoragemover.EndpointsClient, error) {
-	return armstoragemover.NewEndpointsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armstoragemover.NewEndpointsClient)
 }
 
-func (b *ClientBuilder) NewCostManagementScheduledActionsClient() (*armcostmanagement.ScheduledActionsClient, error) {
-	return armcostmanagement.NewScheduledActionsClient(
-		b.Cred,
-		&b.ClientOpt,
-	)
+func (b *ClientBuilder) NewCostManagementScheduledActionsClient(subscriptionId string) (*armcostmanagement.ScheduledActionsClient, error) {
+	return cachedClient(b, subscriptionId, "", func(cred azcore.TokenCredential) (*armcostmanagement.ScheduledActionsClient, error) {
+		return armcostmanagement.NewScheduledActionsClient(
+			cred,
+			&b.ClientOpt,
+		)
+	})
 }
 
 func (b *ClientBuilder) NewApplicationInsightsWebTestsClient(subscriptionId string) (*armapplicationinsights.WebTestsClient, error) {
-	return armapplicationinsights.NewWebTestsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armapplicationinsights.NewWebTestsClient)
 }
 
 func (b *ClientBuilder) NewLogicWorkflowsClient(subscriptionId string) (*armlogic.WorkflowsClient, error) {
-	return armlogic.NewWorkflowsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armlogic.NewWorkflowsClient)
 }
 
 func (b *ClientBuilder) NewPaloalToNetworkFirewallsClient(subscriptionId string) (*armpanngfw.FirewallsClient, error) {
-	return armpanngfw.NewFirewallsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armpanngfw.NewFirewallsClient)
 }
 
 func (b *ClientBuilder) NewIothubsClient(subscriptionId string) (*armiothub.ResourceClient, error) {
-	return armiothub.NewResourceClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armiothub.NewResourceClient)
 }
 
 func (b *ClientBuilder) NewApiManagementApiClient(subscriptionId string) (*armapimanagement.APIClient, error) {
-	return armapimanagement.NewAPIClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armapimanagement.NewAPIClient)
 }
 
 func (b *ClientBuilder) NewNetAppAccountClient(subscriptionId string) (*armnetapp.AccountsClient, error) {
-	return armnetapp.NewAccountsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armnetapp.NewAccountsClient)
 }
 
 func (b *ClientBuilder) NewNetAppVolumeGroupClient(subscriptionId string) (*armnetapp.VolumeGroupsClient, error) {
-	return armnetapp.NewVolumeGroupsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armnetapp.NewVolumeGroupsClient)
 }
 
 func (b *ClientBuilder) NewWorkloadSAPVirtualInstanceClient(subscriptionId string) (*armworkloads.SAPVirtualInstancesClient, error) {
-	return armworkloads.NewSAPVirtualInstancesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armworkloads.NewSAPVirtualInstancesClient)
 }
 
 func (b *ClientBuilder) NewContainerAppEnvironmentsClient(subscriptionId string) (*armappcontainers.ManagedEnvironmentsClient, error) {
-	return armappcontainers.NewManagedEnvironmentsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
+	return newClient(b, subscriptionId, armappcontainers.NewManagedEnvironmentsClient)
 }
 
 func (b *ClientBuilder) NewCognitiveServiceAccountsClient(subscriptionId string) (*armcognitiveservices.AccountsClient, error) {
-	return armcognitiveservices.NewAccountsClient(subscriptionId, b.Cred, &b.ClientOpt)
+	return newClient(b, subscriptionId, armcognitiveservices.NewAccountsClient)
 }
 
 func (b *ClientBuilder) NewHybridKubernetesConnectedClient(subscriptionId string) (*armhybridkubernetes.ConnectedClusterClient, error) {
-	return armhybridkubernetes.NewConnectedClusterClient(subscriptionId, b.Cred, &b.ClientOpt)
+	return newClient(b, subscriptionId, armhybridkubernetes.NewConnectedClusterClient)
 }
 
 func (b *ClientBuilder) NewSqlJobsClient(subscriptionId string) (*armsql.JobsClient, error) {
-	return armsql.NewJobsClient(subscriptionId, b.Cred, &b.ClientOpt)
+	return newClient(b, subscriptionId, armsql.NewJobsClient)
+}
+
+func (b *ClientBuilder) NewSqlEncryptionProtectorsClient(subscriptionId string) (*armsql.EncryptionProtectorsClient, error) {
+	return newClient(b, subscriptionId, armsql.NewEncryptionProtectorsClient)
+}
+
+func (b *ClientBuilder) NewSqlExtendedServerBlobAuditingPoliciesClient(subscriptionId string) (*armsql.ExtendedServerBlobAuditingPoliciesClient, error) {
+	return newClient(b, subscriptionId, armsql.NewExtendedServerBlobAuditingPoliciesClient)
+}
+
+func (b *ClientBuilder) NewSqlExtendedDatabaseBlobAuditingPoliciesClient(subscriptionId string) (*armsql.ExtendedDatabaseBlobAuditingPoliciesClient, error) {
+	return newClient(b, subscriptionId, armsql.NewExtendedDatabaseBlobAuditingPoliciesClient)
 }
 
 func (b *ClientBuilder) NewWebPubSubsClient(subscriptionId string) (*armwebpubsub.Client, error) {
-	return armwebpubsub.NewClient(subscriptionId, b.Cred, &b.ClientOpt)
+	return newClient(b, subscriptionId, armwebpubsub.NewClient)
 }
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/stretchr/testify/require"
+)
+
+type credential struct{}
+
+func (credential) GetToken(context.Context, policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: "token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+func TestClientBuilderCache(t *testing.T) {
+	resolved := map[string]int{}
+	b := NewClientBuilder(nil, func(subscriptionId string) (azcore.TokenCredential, error) {
+		resolved[subscriptionId]++
+		return credential{}, nil
+	}, arm.ClientOptions{})
+
+	vmClient1, err := b.NewVirtualMachinesClient("sub1")
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// The copies with a different context share the same cached clients.
+	vmClient2, err := b.WithContext(ctx).NewVirtualMachinesClient("sub1")
+	require.NoError(t, err)
+	require.Same(t, vmClient1, vmClient2)
+
+	vmClient3, err := b.NewVirtualMachinesClient("sub2")
+	require.NoError(t, err)
+	require.NotSame(t, vmClient1, vmClient3)
+
+	// The clients of different types, or with different arguments, are cached separately.
+	_, err = b.NewVirtualMachineScaleSetsClient("sub1")
+	require.NoError(t, err)
+	storageClient1, err := b.NewStorageDataClient("sub1", "https://account1.blob.core.windows.net/")
+	require.NoError(t, err)
+	storageClient2, err := b.NewStorageDataClient("sub1", "https://account1.blob.core.windows.net")
+	require.NoError(t, err)
+	require.Same(t, storageClient1, storageClient2)
+	storageClient3, err := b.NewStorageDataClient("sub1", "https://account1.queue.core.windows.net")
+	require.NoError(t, err)
+	require.NotSame(t, storageClient1, storageClient3)
+
+	require.Equal(t, map[string]int{"sub1": 4, "sub2": 1}, resolved)
+	require.Equal(t, context.Background(), b.Context())
+	require.Equal(t, ctx, b.WithContext(ctx).Context())
+}
+
+func TestClientBuilderNoCache(t *testing.T) {
+	b := &ClientBuilder{Cred: credential{}}
+	vmClient1, err := b.NewVirtualMachinesClient("sub1")
+	require.NoError(t, err)
+	vmClient2, err := b.NewVirtualMachinesClient("sub1")
+	require.NoError(t, err)
+	require.NotSame(t, vmClient1, vmClient2)
+}
@@ -0,0 +1,123 @@
+// Package clienttest provides a fake transport for the clients built by client.ClientBuilder, which responds the fixtures and records the requests.
+package clienttest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/magodo/aztft/internal/client"
+)
+
+// Transport responds the requests with the fixtures, and records the requests.
+type Transport struct {
+	// Fixtures maps the "<host><path>" of the requests to the JSON (or XML) bodies of the responses, the keys are case-insensitive.
+	Fixtures map[string]string
+	// Default is the body of the responses to the requests that have no fixture. These requests are responded with 404 if it is empty.
+	Default string
+	// StatusCodes maps the "<host><path>" of the requests to the status codes of the responses, which are 200 by default. The responses of the
+	// error status codes have an error body, unless there is a fixture.
+	StatusCodes map[string]int
+	// Errors maps the "<host><path>" of the requests to the errors of sending them, e.g. a DNS failure.
+	Errors map[string]error
+
+	mu       sync.Mutex
+	requests []*http.Request
+}
+
+func (t *Transport) Do(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	t.requests = append(t.requests, req)
+	t.mu.Unlock()
+
+	key := req.URL.Host + req.URL.Path
+	if err, ok := lookup(t.Errors, key); ok {
+		return nil, err
+	}
+	statusCode, body := http.StatusOK, t.Default
+	fixture, hasFixture := lookup(t.Fixtures, key)
+	if hasFixture {
+		body = fixture
+	} else if body == "" {
+		statusCode = http.StatusNotFound
+	}
+	if code, ok := lookup(t.StatusCodes, key); ok {
+		statusCode = code
+	}
+	if statusCode >= http.StatusBadRequest && !hasFixture {
+		body = fmt.Sprintf(`{"error": {"code": %q, "message": "fake error"}}`, strings.ReplaceAll(http.StatusText(statusCode), " ", ""))
+	}
+	contentType := "application/json"
+	if strings.HasPrefix(body, "<") {
+		contentType = "application/xml"
+	}
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{"Content-Type": []string{contentType}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+// lookup returns the value of the key in the map, the keys are case-insensitive.
+func lookup[T any](m map[string]T, key string) (T, bool) {
+	for k, v := range m {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// Requests returns the requests that have been sent.
+func (t *Transport) Requests() []*http.Request {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]*http.Request{}, t.requests...)
+}
+
+// NewClientBuilder returns a client builder, whose clients send the requests to the transport.
+func NewClientBuilder(t *Transport) *client.ClientBuilder {
+	b := &client.ClientBuilder{Cred: credential{}}
+	b.ClientOpt.Transport = t
+	b.ClientOpt.Retry.MaxRetries = -1
+	return b
+}
+
+type credential struct{}
+
+func (credential) GetToken(context.Context, policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: "token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+// ReadAction returns the ARM read action of the request to the management plane, e.g. "Microsoft.Compute/virtualMachines/read".
+// It returns an empty string for the requests to the data plane.
+func ReadAction(req *http.Request) string {
+	if !strings.EqualFold(req.URL.Host, "management.azure.com") {
+		return ""
+	}
+	path := strings.Trim(req.URL.Path, "/")
+	idx := strings.LastIndex(strings.ToLower(path), "providers/")
+	if idx == -1 {
+		// The resource group or the subscription itself
+		return "Microsoft.Resources/" + strings.Join(typesOf(strings.Split(path, "/")), "/") + "/read"
+	}
+	segs := strings.Split(path[idx+len("providers/"):], "/")
+	return strings.Join(append([]string{segs[0]}, typesOf(segs[1:])...), "/") + "/read"
+}
+
+// typesOf returns the types of the segments, which are alternately the types and the names. The last type has no name for the list requests.
+func typesOf(segs []string) []string {
+	var types []string
+	for i := 0; i < len(segs); i += 2 {
+		types = append(types, segs[i])
+	}
+	return types
+}
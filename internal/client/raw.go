@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	armruntime "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/runtime"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
@@ -15,20 +16,22 @@ type RawClient struct {
 	pl   runtime.Pipeline
 }
 
-func (b *ClientBuilder) NewRawClient() (*RawClient, error) {
+func (b *ClientBuilder) NewRawClient(subscriptionId string) (*RawClient, error) {
 	ep := cloud.AzurePublic.Services[cloud.ResourceManager].Endpoint
 	if c, ok := b.ClientOpt.Cloud.Services[cloud.ResourceManager]; ok {
 		ep = c.Endpoint
 	}
-	pl, err := armruntime.NewPipeline("resource", "v0.1.0", b.Cred, runtime.PipelineOptions{}, &b.ClientOpt)
-	if err != nil {
-		return nil, err
-	}
-	client := &RawClient{
-		host: ep,
-		pl:   pl,
-	}
-	return client, nil
+	return cachedClient(b, subscriptionId, "", func(cred azcore.TokenCredential) (*RawClient, error) {
+		pl, err := armruntime.NewPipeline("resource", "v0.1.0", cred, runtime.PipelineOptions{}, &b.ClientOpt)
+		if err != nil {
+			return nil, err
+		}
+		client := &RawClient{
+			host: ep,
+			pl:   pl,
+		}
+		return client, nil
+	})
 }
 
 func (client *RawClient) Get(ctx context.Context, resourceID string, apiVersion string) (interface{}, error) {
@@ -51,6 +54,47 @@ func (client *RawClient) Get(ctx context.Context, resourceID string, apiVersion
 	return responseBody, nil
 }
 
+// List lists the resources of a collection (e.g. the extension resources under a scope), following the next links of the pages.
+// The filter is set as the "$filter" query parameter, if not empty.
+func (client *RawClient) List(ctx context.Context, collectionID string, apiVersion string, filter string) ([]interface{}, error) {
+	req, err := client.getCreateRequest(ctx, collectionID, apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	if filter != "" {
+		reqQP := req.Raw().URL.Query()
+		reqQP.Set("$filter", filter)
+		req.Raw().URL.RawQuery = reqQP.Encode()
+	}
+
+	var result []interface{}
+	for {
+		resp, err := client.pl.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if !runtime.HasStatusCode(resp, http.StatusOK) {
+			return nil, runtime.NewResponseError(resp)
+		}
+		var page struct {
+			Value    []interface{} `json:"value"`
+			NextLink string        `json:"nextLink"`
+		}
+		if err := runtime.UnmarshalAsJSON(resp, &page); err != nil {
+			return nil, err
+		}
+		result = append(result, page.Value...)
+		if page.NextLink == "" {
+			return result, nil
+		}
+		req, err = runtime.NewRequest(ctx, http.MethodGet, page.NextLink)
+		if err != nil {
+			return nil, err
+		}
+		req.Raw().Header.Set("Accept", "application/json")
+	}
+}
+
 func (client *RawClient) getCreateRequest(ctx context.Context, resourceID string, apiVersion string) (*policy.Request, error) {
 	urlPath := resourceID
 	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.host, urlPath))
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+)
+
+// storageScope is the token scope of the storage data plane API, which is the same for all the clouds.
+const storageScope = "https://storage.azure.com/.default"
+
+// StorageDataClient is the client of a storage account service's (e.g. blob, queue) data plane API, as there is no SDK for it in use.
+type StorageDataClient struct {
+	endpoint string
+	pl       runtime.Pipeline
+}
+
+// StorageServiceProperties are the properties of a storage account service, only the ones in use are defined.
+type StorageServiceProperties struct {
+	Logging       *StorageLogging       `xml:"Logging"`
+	HourMetrics   *StorageMetrics       `xml:"HourMetrics"`
+	MinuteMetrics *StorageMetrics       `xml:"MinuteMetrics"`
+	CorsRules     []StorageCorsRule     `xml:"Cors>CorsRule"`
+	StaticWebsite *StorageStaticWebsite `xml:"StaticWebsite"`
+}
+
+type StorageLogging struct {
+	Delete bool `xml:"Delete"`
+	Read   bool `xml:"Read"`
+	Write  bool `xml:"Write"`
+}
+
+type StorageMetrics struct {
+	Enabled bool `xml:"Enabled"`
+}
+
+type StorageCorsRule struct {
+	AllowedOrigins string `xml:"AllowedOrigins"`
+}
+
+type StorageStaticWebsite struct {
+	Enabled bool `xml:"Enabled"`
+}
+
+// NewStorageDataClient creates the data plane client of a storage account service, whose endpoint is e.g. "https://account1.blob.core.windows.net/".
+// The subscription id is the one that the storage account belongs to, which is only used to choose the credential.
+func (b *ClientBuilder) NewStorageDataClient(subscriptionId, endpoint string) (*StorageDataClient, error) {
+	endpoint = strings.TrimSuffix(endpoint, "/")
+	return cachedClient(b, subscriptionId, endpoint, func(cred azcore.TokenCredential) (*StorageDataClient, error) {
+		pl := runtime.NewPipeline("storage", "v0.1.0", runtime.PipelineOptions{
+			PerRetry: []policy.Policy{runtime.NewBearerTokenPolicy(cred, []string{storageScope}, nil)},
+		}, &b.ClientOpt.ClientOptions)
+		return &StorageDataClient{
+			endpoint: endpoint,
+			pl:       pl,
+		}, nil
+	})
+}
+
+// GetServiceProperties gets the properties of the service, e.g. the logging, metrics, CORS rules and the static website settings.
+func (client *StorageDataClient) GetServiceProperties(ctx context.Context) (*StorageServiceProperties, error) {
+	req, err := runtime.NewRequest(ctx, http.MethodGet, client.endpoint+"/?restype=service&comp=properties")
+	if err != nil {
+		return nil, err
+	}
+	req.Raw().Header.Set("x-ms-version", "2021-12-02")
+	resp, err := client.pl.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return nil, runtime.NewResponseError(resp)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var props StorageServiceProperties
+	// The response body might start with the UTF-8 BOM.
+	if err := xml.Unmarshal(bytes.TrimPrefix(b, []byte("\xef\xbb\xbf")), &props); err != nil {
+		return nil, err
+	}
+	return &props, nil
+}
@@ -0,0 +1,194 @@
+package cloudenv
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+)
+
+// Environment is a cloud environment, that is described by the ARM metadata.
+type Environment struct {
+	Name                  string
+	Cloud                 cloud.Configuration
+	StorageEndpointSuffix string
+	KeyVaultDNSSuffix     string
+}
+
+// metadata is the ARM metadata of a cloud environment, as is returned by the "<ARM endpoint>/metadata/endpoints" API.
+// See: https://github.com/Azure/azure-sdk-for-go/blob/main/sdk/azcore/cloud/doc.go
+//
+// The Azure Stack Hub returns the legacy format (i.e. api-version 2015-01-01), which has neither the resourceManager nor the suffixes,
+// but the portalEndpoint instead. See: https://learn.microsoft.com/en-us/azure-stack/user/azure-stack-version-profiles-go
+type metadata struct {
+	Name            string `json:"name"`
+	ResourceManager string `json:"resourceManager"`
+	PortalEndpoint  string `json:"portalEndpoint"`
+	Authentication  struct {
+		LoginEndpoint string   `json:"loginEndpoint"`
+		Audiences     []string `json:"audiences"`
+	} `json:"authentication"`
+	Suffixes struct {
+		Storage     string `json:"storage"`
+		KeyVaultDNS string `json:"keyVaultDns"`
+	} `json:"suffixes"`
+}
+
+const metadataAPIVersion = "2022-09-01"
+
+// Load loads the cloud environment from the location, which is either a local metadata file, or an ARM metadata endpoint URL (e.g. "https://management.azure.com/metadata/endpoints").
+func Load(location string) (*Environment, error) {
+	var (
+		b                  []byte
+		defaultARMEndpoint string
+		err                error
+	)
+	if strings.HasPrefix(location, "https://") || strings.HasPrefix(location, "http://") {
+		b, defaultARMEndpoint, err = fetch(location)
+		if err != nil {
+			return nil, fmt.Errorf("fetching metadata from %s: %v", location, err)
+		}
+	} else {
+		b, err = os.ReadFile(location)
+		if err != nil {
+			return nil, fmt.Errorf("reading metadata file %s: %v", location, err)
+		}
+	}
+
+	md, err := parse(b, defaultARMEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing metadata from %s: %v", location, err)
+	}
+	return md.toEnvironment(defaultARMEndpoint)
+}
+
+func fetch(location string) ([]byte, string, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, "", err
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = "/metadata/endpoints"
+	}
+	q := u.Query()
+	if !q.Has("api-version") {
+		q.Set("api-version", metadataAPIVersion)
+		u.RawQuery = q.Encode()
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Get(u.String())
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", err
+	}
+	return b, u.Scheme + "://" + u.Host, nil
+}
+
+// parse parses the metadata, which is either a single object, or a list of objects (since api-version 2022-09-01).
+// For the latter, the one whose resource manager endpoint matches the armEndpoint is returned, or the first one if there is no match.
+func parse(b []byte, armEndpoint string) (*metadata, error) {
+	var l []metadata
+	if err := json.Unmarshal(b, &l); err != nil {
+		var md metadata
+		if err := json.Unmarshal(b, &md); err != nil {
+			return nil, err
+		}
+		return &md, nil
+	}
+	if len(l) == 0 {
+		return nil, fmt.Errorf("no environment defined")
+	}
+	for _, md := range l {
+		if armEndpoint != "" && strings.EqualFold(strings.TrimSuffix(md.ResourceManager, "/"), armEndpoint) {
+			return &md, nil
+		}
+	}
+	return &l[0], nil
+}
+
+func (md metadata) toEnvironment(defaultARMEndpoint string) (*Environment, error) {
+	var (
+		armEndpoint           = md.ResourceManager
+		storageEndpointSuffix = md.Suffixes.Storage
+		keyVaultDNSSuffix     = md.Suffixes.KeyVaultDNS
+	)
+	if armEndpoint == "" {
+		armEndpoint = defaultARMEndpoint
+	}
+	if md.ResourceManager == "" {
+		// The legacy format of the Azure Stack Hub, whose endpoints are all under the same domain, i.e. "<region>.<external FQDN>".
+		if domain := azureStackDomain(armEndpoint, md.PortalEndpoint); domain != "" {
+			if armEndpoint == "" {
+				armEndpoint = "https://management." + domain + "/"
+			}
+			if storageEndpointSuffix == "" {
+				storageEndpointSuffix = domain
+			}
+			if keyVaultDNSSuffix == "" {
+				keyVaultDNSSuffix = "vault." + domain
+			}
+		}
+	}
+	if armEndpoint == "" {
+		return nil, fmt.Errorf("missing resourceManager (or portalEndpoint)")
+	}
+	if md.Authentication.LoginEndpoint == "" {
+		return nil, fmt.Errorf("missing authentication.loginEndpoint")
+	}
+	if len(md.Authentication.Audiences) == 0 {
+		return nil, fmt.Errorf("missing authentication.audiences")
+	}
+
+	return &Environment{
+		Name: md.Name,
+		Cloud: cloud.Configuration{
+			ActiveDirectoryAuthorityHost: md.Authentication.LoginEndpoint,
+			Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+				cloud.ResourceManager: {
+					Endpoint: armEndpoint,
+					Audience: md.Authentication.Audiences[0],
+				},
+			},
+		},
+		StorageEndpointSuffix: storageEndpointSuffix,
+		KeyVaultDNSSuffix:     keyVaultDNSSuffix,
+	}, nil
+}
+
+// azureStackDomain returns the domain (i.e. "<region>.<external FQDN>") of the Azure Stack Hub from either its ARM endpoint (i.e. "https://management.<domain>")
+// or its portal endpoint (i.e. "https://portal.<domain>"). It returns empty string if neither is of that form.
+func azureStackDomain(armEndpoint, portalEndpoint string) string {
+	for _, ep := range []struct {
+		endpoint string
+		prefix   string
+	}{
+		{armEndpoint, "management."},
+		{portalEndpoint, "portal."},
+	} {
+		if ep.endpoint == "" {
+			continue
+		}
+		u, err := url.Parse(ep.endpoint)
+		if err != nil {
+			continue
+		}
+		if host := strings.ToLower(u.Hostname()); strings.HasPrefix(host, ep.prefix) {
+			return strings.TrimPrefix(host, ep.prefix)
+		}
+	}
+	return ""
+}
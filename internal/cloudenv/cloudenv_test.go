@@ -0,0 +1,177 @@
+package cloudenv
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadFile(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		expect  *Environment
+		err     bool
+	}{
+		{
+			name: "single object",
+			content: `{
+  "name": "MyCloud",
+  "resourceManager": "https://management.mycloud.example/",
+  "authentication": {
+    "loginEndpoint": "https://login.mycloud.example/",
+    "audiences": ["https://management.mycloud.example/"]
+  },
+  "suffixes": {
+    "storage": "core.mycloud.example",
+    "keyVaultDns": "vault.mycloud.example"
+  }
+}`,
+			expect: &Environment{
+				Name: "MyCloud",
+				Cloud: cloud.Configuration{
+					ActiveDirectoryAuthorityHost: "https://login.mycloud.example/",
+					Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+						cloud.ResourceManager: {
+							Endpoint: "https://management.mycloud.example/",
+							Audience: "https://management.mycloud.example/",
+						},
+					},
+				},
+				StorageEndpointSuffix: "core.mycloud.example",
+				KeyVaultDNSSuffix:     "vault.mycloud.example",
+			},
+		},
+		{
+			name: "list takes the first one",
+			content: `[
+  {
+    "name": "Cloud1",
+    "resourceManager": "https://management.cloud1.example/",
+    "authentication": {"loginEndpoint": "https://login.cloud1.example/", "audiences": ["https://management.cloud1.example/"]},
+    "suffixes": {"storage": "core.cloud1.example", "keyVaultDns": "vault.cloud1.example"}
+  },
+  {
+    "name": "Cloud2",
+    "resourceManager": "https://management.cloud2.example/",
+    "authentication": {"loginEndpoint": "https://login.cloud2.example/", "audiences": ["https://management.cloud2.example/"]},
+    "suffixes": {"storage": "core.cloud2.example", "keyVaultDns": "vault.cloud2.example"}
+  }
+]`,
+			expect: &Environment{
+				Name: "Cloud1",
+				Cloud: cloud.Configuration{
+					ActiveDirectoryAuthorityHost: "https://login.cloud1.example/",
+					Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+						cloud.ResourceManager: {
+							Endpoint: "https://management.cloud1.example/",
+							Audience: "https://management.cloud1.example/",
+						},
+					},
+				},
+				StorageEndpointSuffix: "core.cloud1.example",
+				KeyVaultDNSSuffix:     "vault.cloud1.example",
+			},
+		},
+		{
+			name: "azure stack hub",
+			content: `{
+  "galleryEndpoint": "https://providers.local.azurestack.external:30016/",
+  "graphEndpoint": "https://graph.local.azurestack.external/",
+  "portalEndpoint": "https://portal.local.azurestack.external/",
+  "authentication": {
+    "loginEndpoint": "https://adfs.local.azurestack.external/adfs",
+    "audiences": ["https://management.adfs.azurestack.local/00000000-0000-0000-0000-000000000000"]
+  }
+}`,
+			expect: &Environment{
+				Cloud: cloud.Configuration{
+					ActiveDirectoryAuthorityHost: "https://adfs.local.azurestack.external/adfs",
+					Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+						cloud.ResourceManager: {
+							Endpoint: "https://management.local.azurestack.external/",
+							Audience: "https://management.adfs.azurestack.local/00000000-0000-0000-0000-000000000000",
+						},
+					},
+				},
+				StorageEndpointSuffix: "local.azurestack.external",
+				KeyVaultDNSSuffix:     "vault.local.azurestack.external",
+			},
+		},
+		{
+			name: "missing resource manager",
+			content: `{
+  "authentication": {"loginEndpoint": "https://login.mycloud.example/", "audiences": ["https://management.mycloud.example/"]}
+}`,
+			err: true,
+		},
+		{
+			name: "missing audiences",
+			content: `{
+  "resourceManager": "https://management.mycloud.example/",
+  "authentication": {"loginEndpoint": "https://login.mycloud.example/"}
+}`,
+			err: true,
+		},
+		{
+			name:    "empty list",
+			content: `[]`,
+			err:     true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "metadata.json")
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0644))
+			actual, err := Load(path)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestLoadURL(t *testing.T) {
+	var apiVersion string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/metadata/endpoints", r.URL.Path)
+		apiVersion = r.URL.Query().Get("api-version")
+		w.Write([]byte(`[
+  {
+    "name": "Other",
+    "resourceManager": "https://management.other.example/",
+    "authentication": {"loginEndpoint": "https://login.other.example/", "audiences": ["https://management.other.example/"]}
+  },
+  {
+    "name": "Self",
+    "resourceManager": "` + "http://" + r.Host + `/",
+    "authentication": {"loginEndpoint": "https://login.self.example/", "audiences": ["https://management.self.example/"]}
+  }
+]`))
+	}))
+	defer srv.Close()
+
+	env, err := Load(srv.URL)
+	require.NoError(t, err)
+	require.Equal(t, metadataAPIVersion, apiVersion)
+	require.Equal(t, "Self", env.Name)
+
+	_, err = Load(srv.URL + "/metadata/endpoints?api-version=2015-01-01")
+	require.NoError(t, err)
+	require.Equal(t, "2015-01-01", apiVersion)
+}
+
+func TestAzureStackDomain(t *testing.T) {
+	require.Equal(t, "local.azurestack.external", azureStackDomain("https://management.local.azurestack.external", ""))
+	require.Equal(t, "local.azurestack.external", azureStackDomain("", "https://portal.local.azurestack.external/"))
+	require.Equal(t, "", azureStackDomain("https://arm.example", "https://example.com"))
+}
@@ -0,0 +1,111 @@
+// Package exampleid synthesizes example Azure resource ids for the mapped resource types, with the names "randomly" generated.
+package exampleid
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/resmap"
+)
+
+var (
+	SubscriptionId    = armid.SubscriptionId{Id: "sub1"}
+	ManagementGroupId = armid.ManagementGroup{Name: "grp1"}
+	ResourceGroupId   = armid.ResourceGroup{SubscriptionId: "sub1", Name: "rg1"}
+	TenantId          = armid.TenantId{}
+)
+
+// PropertyLikeTypes are the property like resources from map.json that have pesudo Azure resource ID defined, mapped to the resource type of the secondary resource.
+// The last name of the pesudo Azure resource ID is the base64 encoded secondary resource ID.
+// The list is from: tfid.go:StaticBuild()
+var PropertyLikeTypes = map[string]string{
+	"azurerm_app_service_certificate_binding":                                        "azurerm_app_service_certificate",
+	"azurerm_communication_service_email_domain_association":                         "azurerm_email_communication_service_domain",
+	"azurerm_nat_gateway_public_ip_association":                                      "azurerm_public_ip",
+	"azurerm_nat_gateway_public_ip_prefix_association":                               "azurerm_public_ip_prefix",
+	"azurerm_network_interface_application_gateway_backend_address_pool_association": "fake_azurerm_application_gateway_backend_address_pool",
+	"azurerm_network_interface_application_security_group_association":               "azurerm_application_security_group",
+	"azurerm_network_interface_backend_address_pool_association":                     "azurerm_lb_backend_address_pool",
+	"azurerm_network_interface_nat_rule_association":                                 "azurerm_lb_nat_rule",
+	"azurerm_network_interface_security_group_association":                           "azurerm_network_security_group",
+	"azurerm_private_endpoint_application_security_group_association":                "azurerm_application_security_group",
+	"azurerm_virtual_desktop_scaling_plan_host_pool_association":                     "azurerm_virtual_desktop_host_pool",
+	"azurerm_virtual_desktop_workspace_application_group_association":                "azurerm_virtual_desktop_application_group",
+	"azurerm_virtual_machine_gallery_application_assignment":                         "azurerm_gallery_application_version",
+	"azurerm_subnet_nat_gateway_association":                                         "azurerm_nat_gateway",
+	"azurerm_subnet_network_security_group_association":                              "azurerm_network_security_group",
+	"azurerm_subnet_route_table_association":                                         "azurerm_route_table",
+}
+
+// Id builds an example Azure resource id for the resource type, under the parent scope in the form of the route scope string (e.g. "/subscriptions/resourceGroups").
+// An empty scope builds the id at the root scope (e.g. azurerm_resource_group).
+// For the property like resource types, the last name is the base64 encoded example id of the secondary resource.
+func Id(rt, scope string) armid.ResourceId {
+	switch rt {
+	case "azurerm_resource_group":
+		return ResourceGroupId.Clone()
+	case "azurerm_management_group":
+		return ManagementGroupId.Clone()
+	}
+
+	mp := resmap.TF2ARMIdMap[rt].ManagementPlane
+	id := FromRouteScope("/" + strings.Join(append([]string{mp.Provider}, mp.Types...), "/"))
+	rid, ok := id.(*armid.ScopedResourceId)
+	if !ok {
+		return id
+	}
+	if scope != "" {
+		rid.AttrParentScope = FromRouteScope(scope)
+	}
+	if secondaryRt, ok := PropertyLikeTypes[rt]; ok {
+		rid.AttrNames[len(rid.AttrNames)-1] = base64.StdEncoding.EncodeToString([]byte(DefaultId(secondaryRt).String()))
+	}
+	return rid
+}
+
+// DefaultId builds an example Azure resource id for the resource type, in the first parent scope of the mapping.
+// The any scope is replaced by the resource group scope.
+func DefaultId(rt string) armid.ResourceId {
+	var scope string
+	if mp := resmap.TF2ARMIdMap[rt].ManagementPlane; mp != nil && len(mp.ParentScopes) != 0 {
+		scope = mp.ParentScopes[0]
+		if scope == resmap.ScopeAny {
+			scope = ResourceGroupId.ScopeString()
+		}
+	}
+	return Id(rt, scope)
+}
+
+// FromRouteScope turns a route scope string to a resource id, with the names part "randomly" generated.
+func FromRouteScope(input string) armid.ResourceId {
+	upperInput := strings.ToUpper(input)
+
+	var parentScope armid.ResourceId = &TenantId
+	if strings.HasPrefix(upperInput, strings.ToUpper(ResourceGroupId.ScopeString())) {
+		parentScope = &ResourceGroupId
+	} else if strings.HasPrefix(upperInput, strings.ToUpper(SubscriptionId.ScopeString())) {
+		parentScope = &SubscriptionId
+	} else if strings.HasPrefix(upperInput, strings.ToUpper(ManagementGroupId.ScopeString())) {
+		parentScope = &ManagementGroupId
+	}
+	parentScope = parentScope.Clone()
+
+	left := input[len(parentScope.ScopeString()):]
+	if len(left) == 0 {
+		return parentScope
+	}
+
+	segs := strings.Split(strings.Trim(left, "/"), "/")
+	var names []string
+	for _, seg := range segs[1:] {
+		names = append(names, seg+"1")
+	}
+	id := armid.ScopedResourceId{
+		AttrParentScope: parentScope,
+		AttrProvider:    segs[0],
+		AttrTypes:       segs[1:],
+		AttrNames:       names,
+	}
+	return &id
+}
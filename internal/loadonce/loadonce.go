@@ -0,0 +1,42 @@
+// Package loadonce caches the results of loading the files, which are loaded on demand by the library APIs that can be called repeatedly.
+package loadonce
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+)
+
+// Files records the result of each loaded file, keyed by its absolute path. The zero value is ready to use.
+type Files[T any] struct {
+	mu     sync.Mutex
+	loaded map[string]result[T]
+}
+
+type result[T any] struct {
+	v   T
+	err error
+}
+
+// Load calls the load function with the absolute path of the file, unless the file has been loaded, in which case the result of the first load is returned,
+// including the error. The load function is called while holding the lock, so it can safely update the states shared by the loads.
+func (f *Files[T]) Load(path string, load func(path string) (T, error)) (T, error) {
+	key, err := filepath.Abs(path)
+	if err != nil {
+		var zero T
+		return zero, fmt.Errorf("resolving the absolute path of %s: %v", path, err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if res, ok := f.loaded[key]; ok {
+		return res.v, res.err
+	}
+	v, err := load(key)
+	if f.loaded == nil {
+		f.loaded = map[string]result[T]{}
+	}
+	f.loaded[key] = result[T]{v: v, err: err}
+	return v, err
+}
@@ -0,0 +1,126 @@
+package populate
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/client"
+)
+
+// extension is a kind of the extension resources, which can be attached to any scope, e.g. "<scope>/providers/Microsoft.Authorization/locks/<name>".
+type extension struct {
+	// typ is the provider and type of the extension resource, e.g. "Microsoft.Authorization/locks".
+	typ        string
+	apiVersion string
+	// filter is the "$filter" of the list request, if any, to reduce the extension resources that are not at the exact scope.
+	filter string
+}
+
+var extensions = []extension{
+	{typ: "Microsoft.Insights/diagnosticSettings", apiVersion: "2021-05-01-preview"},
+	{typ: "Microsoft.Authorization/locks", apiVersion: "2016-09-01"},
+	{typ: "Microsoft.Authorization/roleAssignments", apiVersion: "2022-04-01", filter: "atScope()"},
+	{typ: "Microsoft.EventGrid/eventSubscriptions", apiVersion: "2022-06-15"},
+	{typ: "Microsoft.Authorization/policyAssignments", apiVersion: "2022-06-01", filter: "atExactScope()"},
+	{typ: "Microsoft.Authorization/policyExemptions", apiVersion: "2022-07-01-preview", filter: "atExactScope()"},
+}
+
+// ExtensionReadActions are the ARM actions that PopulateExtensions calls, which are checked by TestPopulateExtensions.
+var ExtensionReadActions = []string{
+	"Microsoft.Insights/diagnosticSettings/read",
+	"Microsoft.Authorization/locks/read",
+	"Microsoft.Authorization/roleAssignments/read",
+	"Microsoft.EventGrid/eventSubscriptions/read",
+	"Microsoft.Authorization/policyAssignments/read",
+	"Microsoft.Authorization/policyExemptions/read",
+	"Microsoft.Chaos/targets/read",
+	"Microsoft.Chaos/targets/capabilities/read",
+}
+
+// PopulateExtensions populates the extension resources (e.g. the diagnostic settings, the management locks) that are scoped to the specified resource,
+// regardless of its resource type. The extension resources that are inherited from the upper scopes, or that are scoped to its child resources, are excluded.
+// The kinds of extension resources that are not supported by the resource (e.g. the diagnostic settings of a resource group), or that are not allowed to be
+// listed (e.g. the policy assignments for lack of the permission), are skipped.
+// The Chaos Studio targets enabled on the resource are populated as well, together with their capabilities.
+func PopulateExtensions(id armid.ResourceId, b *client.ClientBuilder) ([]armid.ResourceId, error) {
+	c, err := b.NewRawClient(subscriptionIdOf(id))
+	if err != nil {
+		return nil, err
+	}
+
+	var result []armid.ResourceId
+	for _, ext := range extensions {
+		values, err := c.List(b.Context(), id.String()+"/providers/"+ext.typ, ext.apiVersion, ext.filter)
+		if err != nil {
+			if isUnsupported(err) || isUnauthorized(err) {
+				continue
+			}
+			return nil, fmt.Errorf("listing %s of %q: %v", ext.typ, id, err)
+		}
+		for _, v := range values {
+			item, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			extIdStr, _ := item["id"].(string)
+			if extIdStr == "" {
+				continue
+			}
+			extId, err := armid.ParseResourceId(extIdStr)
+			if err != nil {
+				return nil, fmt.Errorf("parsing %s: %v", extIdStr, err)
+			}
+			if extId.ParentScope() == nil || !strings.EqualFold(extId.ParentScope().String(), id.String()) {
+				continue
+			}
+			result = append(result, extId)
+		}
+	}
+
+	chaosIds, err := populateChaosTargets(b.Context(), c, id)
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, chaosIds...)
+
+	return result, nil
+}
+
+func subscriptionIdOf(id armid.ResourceId) string {
+	switch root := id.RootScope().(type) {
+	case *armid.SubscriptionId:
+		return root.Id
+	case *armid.ResourceGroup:
+		return root.SubscriptionId
+	}
+	return ""
+}
+
+// unsupportedErrorCodes are the error codes of the bad requests, which indicate the kind of extension resources is not supported by the scope.
+var unsupportedErrorCodes = map[string]bool{
+	// E.g. the diagnostic settings of a resource group
+	"RESOURCETYPENOTSUPPORTED": true,
+	// The extension resource provider doesn't support the resource type of the scope
+	"INVALIDRESOURCETYPE":       true,
+	"NOREGISTEREDPROVIDERFOUND": true,
+}
+
+// isUnsupported tells whether the error indicates the kind of extension resources is not supported by the scope.
+// The other bad requests (e.g. an invalid filter or API version) are not, which shall be surfaced.
+func isUnsupported(err error) bool {
+	var respErr *azcore.ResponseError
+	if !errors.As(err, &respErr) {
+		return false
+	}
+	switch respErr.StatusCode {
+	case http.StatusNotFound:
+		return true
+	case http.StatusBadRequest:
+		return unsupportedErrorCodes[strings.ToUpper(respErr.ErrorCode)]
+	}
+	return false
+}
@@ -0,0 +1,51 @@
+package populate
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsUnsupported(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    error
+		expect bool
+	}{
+		{
+			name:   "not found",
+			err:    &azcore.ResponseError{StatusCode: http.StatusNotFound, ErrorCode: "ResourceNotFound"},
+			expect: true,
+		},
+		{
+			name:   "resource type not supported",
+			err:    &azcore.ResponseError{StatusCode: http.StatusBadRequest, ErrorCode: "ResourceTypeNotSupported"},
+			expect: true,
+		},
+		{
+			name:   "wrapped invalid resource type",
+			err:    fmt.Errorf("listing: %w", &azcore.ResponseError{StatusCode: http.StatusBadRequest, ErrorCode: "InvalidResourceType"}),
+			expect: true,
+		},
+		{
+			name: "invalid filter",
+			err:  &azcore.ResponseError{StatusCode: http.StatusBadRequest, ErrorCode: "InvalidFilterInQueryString"},
+		},
+		{
+			name: "unsupported error code of other status",
+			err:  &azcore.ResponseError{StatusCode: http.StatusForbidden, ErrorCode: "ResourceTypeNotSupported"},
+		},
+		{
+			name: "not a response error",
+			err:  fmt.Errorf("connection reset"),
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expect, isUnsupported(tt.err))
+		})
+	}
+}
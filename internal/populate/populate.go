@@ -1,8 +1,8 @@
 package populate
 
 import (
-	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
-	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"sort"
+
 	"github.com/magodo/armid"
 	"github.com/magodo/aztft/internal/client"
 )
@@ -10,37 +10,206 @@ import (
 // populateFunc populates the hypothetic azure resource ids that represent the property like resources of the specified resource.
 type populateFunc func(*client.ClientBuilder, armid.ResourceId) ([]armid.ResourceId, error)
 
+// typedPopulateFunc is similar to the populateFunc, except it also returns the resource types of the emitted ids that it already knows
+// (e.g. resolved from the listed properties), so that they don't need to be resolved again.
+type typedPopulateFunc func(*client.ClientBuilder, armid.ResourceId) ([]Result, error)
+
+// Result is a resource id emitted by a populater. The ResourceType is empty if the populater doesn't know it.
+type Result struct {
+	Id           armid.ResourceId
+	ResourceType string
+}
+
 var populaters = map[string]populateFunc{
-	"azurerm_linux_virtual_machine":     populateVirtualMachine,
-	"azurerm_windows_virtual_machine":   populateVirtualMachine,
-	"azurerm_network_interface":         populateNetworkInterface,
-	"azurerm_virtual_desktop_workspace": populateVirtualDesktopWorkspace,
-	"azurerm_nat_gateway":               populateNatGateway,
-	"azurerm_subnet":                    populateSubnet,
-	"azurerm_logic_app_workflow":        populateLogicAppWorkflow,
-	"azurerm_iothub":                    populateIotHub,
-	"azurerm_netapp_account":            populateNetAppAccount,
-	"azurerm_lb":                        populateLoadBalancer,
-	"azurerm_container_app_environment": populateContainerAppEnv,
-	"azurerm_mssql_job":                 populateMssqlJob,
-	"azurerm_stream_analytics_job":      populateStreamAnalyticsJob,
+	"azurerm_linux_virtual_machine":        populateVirtualMachine,
+	"azurerm_windows_virtual_machine":      populateVirtualMachine,
+	"azurerm_network_interface":            populateNetworkInterface,
+	"azurerm_virtual_desktop_workspace":    populateVirtualDesktopWorkspace,
+	"azurerm_virtual_desktop_scaling_plan": populateVirtualDesktopScalingPlan,
+	"azurerm_nat_gateway":                  populateNatGateway,
+	"azurerm_subnet":                       populateSubnet,
+	"azurerm_logic_app_workflow":           populateLogicAppWorkflow,
+	"azurerm_iothub":                       populateIotHub,
+	"azurerm_netapp_account":               populateNetAppAccount,
+	"azurerm_lb":                           populateLoadBalancer,
+	"azurerm_container_app_environment":    populateContainerAppEnv,
+	"azurerm_mssql_job":                    populateMssqlJob,
+	"azurerm_stream_analytics_job":         populateStreamAnalyticsJob,
+	"azurerm_linux_web_app_slot":           populateAppServiceSiteSlot,
+	"azurerm_windows_web_app_slot":         populateAppServiceSiteSlot,
+	"azurerm_linux_function_app_slot":      populateAppServiceSiteSlot,
+	"azurerm_windows_function_app_slot":    populateAppServiceSiteSlot,
+	"azurerm_storage_account":              populateStorageAccount,
+	"azurerm_mssql_server":                 populateMssqlServer,
+	"azurerm_mssql_database":               populateMssqlDatabase,
+	"azurerm_postgresql_server":            populatePostgresqlServer,
+	"azurerm_postgresql_flexible_server":   populatePostgresqlFlexibleServer,
+	"azurerm_private_endpoint":             populatePrivateEndpoint,
+	"azurerm_communication_service":        populateCommunicationService,
+	"azurerm_app_configuration":            populateAppConfiguration,
+}
+
+var typedPopulaters = map[string]typedPopulateFunc{
+	"azurerm_linux_web_app":        populateAppServiceSite,
+	"azurerm_windows_web_app":      populateAppServiceSite,
+	"azurerm_linux_function_app":   populateAppServiceSite,
+	"azurerm_windows_function_app": populateAppServiceSite,
+	"azurerm_logic_app_standard":   populateAppServiceSite,
+}
+
+// PopulatedTypes are the types of the resource ids emitted by each populater, relative to the populated resource's types.
+// E.g. "ipConfigurations/loadBalancerBackendAddressPools" for the network interface means the emitted resource id is of the form:
+// <network interface id>/ipConfigurations/<name>/loadBalancerBackendAddressPools/<base64 encoded id>.
+// They are checked against the populaters by TestPopulaters, and each of them is expected to have a mapping entry.
+var PopulatedTypes = map[string][]string{
+	"azurerm_linux_virtual_machine":   {"dataDisks", "galleryApplications"},
+	"azurerm_windows_virtual_machine": {"dataDisks", "galleryApplications"},
+	"azurerm_network_interface": {
+		"networkSecurityGroups",
+		"ipConfigurations/applicationGatewayBackendAddressPools",
+		"ipConfigurations/applicationSecurityGroups",
+		"ipConfigurations/loadBalancerInboundNatRules",
+		"ipConfigurations/loadBalancerBackendAddressPools",
+	},
+	"azurerm_virtual_desktop_workspace":    {"applicationGroups"},
+	"azurerm_virtual_desktop_scaling_plan": {"hostPools"},
+	"azurerm_nat_gateway":                  {"publicIPAddresses", "publicIPPrefixes"},
+	"azurerm_subnet":                       {"routeTables", "networkSecurityGroups", "natGateways"},
+	"azurerm_logic_app_workflow":           {"actions", "triggers"},
+	"azurerm_iothub": {
+		"endpointsEventhub",
+		"endpointsServicebusQueue",
+		"endpointsServicebusTopic",
+		"endpointsStorageContainer",
+	},
+	"azurerm_netapp_account":             {"encryptions"},
+	"azurerm_lb":                         {"loadBalancingRules", "probes"},
+	"azurerm_container_app_environment":  {"customDomains"},
+	"azurerm_mssql_job":                  {"schedules"},
+	"azurerm_stream_analytics_job":       {"storageAccounts"},
+	"azurerm_linux_web_app":              appServiceSitePopulatedTypes,
+	"azurerm_windows_web_app":            appServiceSitePopulatedTypes,
+	"azurerm_linux_function_app":         appServiceSitePopulatedTypes,
+	"azurerm_windows_function_app":       appServiceSitePopulatedTypes,
+	"azurerm_logic_app_standard":         {"networkConfig", "hostNameBindings", "hostNameBindings/certificates"},
+	"azurerm_linux_web_app_slot":         appServiceSiteSlotPopulatedTypes,
+	"azurerm_windows_web_app_slot":       appServiceSiteSlotPopulatedTypes,
+	"azurerm_linux_function_app_slot":    appServiceSiteSlotPopulatedTypes,
+	"azurerm_windows_function_app_slot":  appServiceSiteSlotPopulatedTypes,
+	"azurerm_storage_account":            {"queueServices", "staticWebsites", "managementPolicies", "inventoryPolicies"},
+	"azurerm_mssql_server":               {"encryptionProtector", "extendedAuditingSettings"},
+	"azurerm_mssql_database":             {"extendedAuditingSettings"},
+	"azurerm_postgresql_server":          {"administrators"},
+	"azurerm_postgresql_flexible_server": {"administrators"},
+	"azurerm_private_endpoint":           {"applicationSecurityGroups"},
+	"azurerm_communication_service":      {"linkedDomains"},
+	"azurerm_app_configuration":          {"AppConfigurationKey/Label", "AppConfigurationFeature/Label"},
+}
+
+var (
+	appServiceSitePopulatedTypes     = []string{"networkConfig", "hostNameBindings", "hostNameBindings/certificates", "hybridConnectionNamespaces/relays", "slots"}
+	appServiceSiteSlotPopulatedTypes = []string{"networkConfig", "hostNameBindings"}
+)
+
+// ReadActions are the ARM actions that each populater calls, which are checked against the populaters by TestPopulaters.
+var ReadActions = map[string][]string{
+	"azurerm_linux_virtual_machine":        {"Microsoft.Compute/virtualMachines/read"},
+	"azurerm_windows_virtual_machine":      {"Microsoft.Compute/virtualMachines/read"},
+	"azurerm_network_interface":            {"Microsoft.Network/networkInterfaces/read"},
+	"azurerm_virtual_desktop_workspace":    {"Microsoft.DesktopVirtualization/workspaces/read"},
+	"azurerm_virtual_desktop_scaling_plan": {"Microsoft.DesktopVirtualization/scalingPlans/read"},
+	"azurerm_nat_gateway":                  {"Microsoft.Network/natGateways/read"},
+	"azurerm_subnet":                       {"Microsoft.Network/virtualNetworks/subnets/read"},
+	"azurerm_logic_app_workflow":           {"Microsoft.Logic/workflows/read"},
+	"azurerm_iothub":                       {"Microsoft.Devices/iotHubs/read"},
+	"azurerm_netapp_account":               {"Microsoft.NetApp/netAppAccounts/read"},
+	"azurerm_lb":                           {"Microsoft.Network/loadBalancers/read"},
+	"azurerm_container_app_environment":    {"Microsoft.App/managedEnvironments/read"},
+	"azurerm_mssql_job":                    {"Microsoft.Sql/servers/jobAgents/jobs/read"},
+	"azurerm_stream_analytics_job":         {"Microsoft.StreamAnalytics/streamingJobs/read"},
+	"azurerm_linux_web_app":                appServiceSiteReadActions,
+	"azurerm_windows_web_app":              appServiceSiteReadActions,
+	"azurerm_linux_function_app":           appServiceSiteReadActions,
+	"azurerm_windows_function_app":         appServiceSiteReadActions,
+	"azurerm_logic_app_standard": {
+		"Microsoft.Web/sites/read",
+		"Microsoft.Web/sites/hostNameBindings/read",
+		"Microsoft.Web/certificates/read",
+	},
+	"azurerm_linux_web_app_slot":        appServiceSiteSlotReadActions,
+	"azurerm_windows_web_app_slot":      appServiceSiteSlotReadActions,
+	"azurerm_linux_function_app_slot":   appServiceSiteSlotReadActions,
+	"azurerm_windows_function_app_slot": appServiceSiteSlotReadActions,
+	"azurerm_storage_account": {
+		"Microsoft.Storage/storageAccounts/read",
+		"Microsoft.Storage/storageAccounts/queueServices/read",
+		"Microsoft.Storage/storageAccounts/blobServices/read",
+		"Microsoft.Storage/storageAccounts/managementPolicies/read",
+		"Microsoft.Storage/storageAccounts/inventoryPolicies/read",
+	},
+	"azurerm_mssql_server": {
+		"Microsoft.Sql/servers/encryptionProtector/read",
+		"Microsoft.Sql/servers/extendedAuditingSettings/read",
+	},
+	"azurerm_mssql_database":             {"Microsoft.Sql/servers/databases/extendedAuditingSettings/read"},
+	"azurerm_postgresql_server":          {"Microsoft.DBforPostgreSQL/servers/administrators/read"},
+	"azurerm_postgresql_flexible_server": {"Microsoft.DBforPostgreSQL/flexibleServers/administrators/read"},
+	"azurerm_private_endpoint":           {"Microsoft.Network/privateEndpoints/read"},
+	"azurerm_communication_service":      {"Microsoft.Communication/communicationServices/read"},
+	"azurerm_app_configuration":          {"Microsoft.AppConfiguration/configurationStores/read"},
+}
+
+var (
+	appServiceSiteReadActions = []string{
+		"Microsoft.Web/sites/read",
+		"Microsoft.Web/sites/hostNameBindings/read",
+		"Microsoft.Web/certificates/read",
+		"Microsoft.Web/sites/hybridConnectionRelays/read",
+		"Microsoft.Web/sites/slots/read",
+	}
+	appServiceSiteSlotReadActions = []string{"Microsoft.Web/sites/slots/read", "Microsoft.Web/sites/slots/hostNameBindings/read"}
+)
+
+// ResourceTypes returns the resource types that have a populater.
+func ResourceTypes() []string {
+	var out []string
+	for rt := range populaters {
+		out = append(out, rt)
+	}
+	for rt := range typedPopulaters {
+		out = append(out, rt)
+	}
+	sort.Strings(out)
+	return out
 }
 
 func NeedsAPI(rt string) bool {
 	_, ok := populaters[rt]
-	return ok
+	_, typedOk := typedPopulaters[rt]
+	return ok || typedOk
 }
 
-func Populate(id armid.ResourceId, rt string, cred azcore.TokenCredential, clientOpt arm.ClientOptions) ([]armid.ResourceId, error) {
+func Populate(id armid.ResourceId, rt string, b *client.ClientBuilder) ([]Result, error) {
+	if populater, ok := typedPopulaters[rt]; ok {
+		return populater(b, id)
+	}
 	populater, ok := populaters[rt]
 	if !ok {
 		return nil, nil
 	}
 
-	b := &client.ClientBuilder{
-		Cred:      cred,
-		ClientOpt: clientOpt,
+	ids, err := populater(b, id)
+	if err != nil {
+		return nil, err
 	}
+	return untypedResults(ids), nil
+}
 
-	return populater(b, id)
+// untypedResults returns the results of the ids, whose resource types are unknown.
+func untypedResults(ids []armid.ResourceId) []Result {
+	var out []Result
+	for _, id := range ids {
+		out = append(out, Result{Id: id})
+	}
+	return out
 }
@@ -0,0 +1,62 @@
+package populate
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/client"
+	"github.com/magodo/aztft/internal/tfid"
+)
+
+// populateAppConfiguration populates the keys and feature flags of the App Configuration store, which are listed via the data plane API. Their pseudo ids are
+// "<store id>/AppConfigurationKey/<key>/Label/<label>" and "<store id>/AppConfigurationFeature/<feature name>/Label/<label>" respectively.
+// Nothing is populated if the key-values are not allowed to be listed.
+func populateAppConfiguration(b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
+	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
+	endpoint, err := tfid.AppConfigurationEndpoint(b, id)
+	if err != nil {
+		return nil, err
+	}
+	client, err := b.NewAppConfigurationDataClient(resourceGroupId.SubscriptionId, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	kvs, err := client.ListKeyValues(b.Context())
+	if err != nil {
+		// Listing the key-values requires the data plane role (e.g. App Configuration Data Reader), which is not granted by the management plane roles
+		// (e.g. Reader). The store is then queried as if it has no key-value, instead of failing the whole query.
+		if isUnauthorized(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("listing key-values of %q: %v", id, err)
+	}
+
+	var result []armid.ResourceId
+	for _, kv := range kvs {
+		var label string
+		if kv.Label != nil {
+			label = *kv.Label
+		}
+		azureId := id.Clone().(*armid.ScopedResourceId)
+		if strings.HasPrefix(kv.Key, tfid.AppConfigurationFeaturePrefix) {
+			azureId.AttrTypes = append(azureId.AttrTypes, "AppConfigurationFeature", "Label")
+			azureId.AttrNames = append(azureId.AttrNames, tfid.EncodeAppConfigurationName(strings.TrimPrefix(kv.Key, tfid.AppConfigurationFeaturePrefix)))
+		} else {
+			azureId.AttrTypes = append(azureId.AttrTypes, "AppConfigurationKey", "Label")
+			azureId.AttrNames = append(azureId.AttrNames, tfid.EncodeAppConfigurationName(kv.Key))
+		}
+		azureId.AttrNames = append(azureId.AttrNames, tfid.EncodeAppConfigurationName(label))
+
+		result = append(result, azureId)
+	}
+	return result, nil
+}
+
+func isUnauthorized(err error) bool {
+	var respErr *azcore.ResponseError
+	return errors.As(err, &respErr) && (respErr.StatusCode == http.StatusUnauthorized || respErr.StatusCode == http.StatusForbidden)
+}
@@ -0,0 +1,300 @@
+package populate
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/appservice/armappservice"
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/client"
+	"github.com/magodo/aztft/internal/resolve"
+)
+
+// populateAppServiceSite populates the property-like resources of the site, together with its slots, whose resource types are resolved by their listed kinds.
+func populateAppServiceSite(b *client.ClientBuilder, id armid.ResourceId) ([]Result, error) {
+	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
+	client, err := b.NewAppServiceWebAppsClient(resourceGroupId.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(b.Context(), resourceGroupId.Name, id.Names()[0], nil)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %q: %v", id, err)
+	}
+	if resp.Kind == nil {
+		return nil, fmt.Errorf("unexpected nil kind in response")
+	}
+	rt, err := resolve.AppServiceSiteTypeByKind(*resp.Kind)
+	if err != nil {
+		return nil, err
+	}
+	props := resp.Site.Properties
+	if props == nil {
+		return nil, nil
+	}
+
+	var result []armid.ResourceId
+
+	result = append(result, appServicePopulateSwiftConnection(id, props.VirtualNetworkSubnetID)...)
+
+	bindings, err := appServiceSiteListHostNameBindings(b.Context(), client, id)
+	if err != nil {
+		return nil, err
+	}
+	bindingIds, err := appServiceHostNameBindingIds(bindings, props.DefaultHostName)
+	if err != nil {
+		return nil, fmt.Errorf("populating for custom hostname bindings: %v", err)
+	}
+	result = append(result, bindingIds...)
+
+	certificateBindings, err := appServiceSitePopulateCertificateBindings(b, id, bindings, props.ServerFarmID)
+	if err != nil {
+		return nil, fmt.Errorf("populating for certificate bindings: %v", err)
+	}
+	result = append(result, certificateBindings...)
+
+	// There is no hybrid connection or slot resource for the logic app standard.
+	if rt == "azurerm_logic_app_standard" {
+		return untypedResults(result), nil
+	}
+
+	hybridConnections, err := appServiceSitePopulateHybridConnections(b, id, rt)
+	if err != nil {
+		return nil, fmt.Errorf("populating for hybrid connections: %v", err)
+	}
+
+	slots, err := appServiceSitePopulateSlots(b.Context(), client, id)
+	if err != nil {
+		return nil, fmt.Errorf("populating for slots: %v", err)
+	}
+
+	typedResult := append(untypedResults(result), hybridConnections...)
+	return append(typedResult, slots...), nil
+}
+
+func populateAppServiceSiteSlot(b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
+	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
+	client, err := b.NewAppServiceWebAppsClient(resourceGroupId.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.GetSlot(b.Context(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %q: %v", id, err)
+	}
+	props := resp.Site.Properties
+	if props == nil {
+		return nil, nil
+	}
+
+	var result []armid.ResourceId
+
+	result = append(result, appServicePopulateSwiftConnection(id, props.VirtualNetworkSubnetID)...)
+
+	pager := client.NewListHostNameBindingsSlotPager(resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	for pager.More() {
+		page, err := pager.NextPage(b.Context())
+		if err != nil {
+			return nil, fmt.Errorf("listing hostname bindings of %q: %v", id, err)
+		}
+		bindings, err := appServiceHostNameBindingIds(page.Value, props.DefaultHostName)
+		if err != nil {
+			return nil, fmt.Errorf("populating for custom hostname bindings: %v", err)
+		}
+		result = append(result, bindings...)
+	}
+
+	return result, nil
+}
+
+// appServicePopulateSwiftConnection populates the VNet swift connection of the site (or slot), whose pseudo id is "<site id>/networkConfig/virtualNetwork".
+func appServicePopulateSwiftConnection(id armid.ResourceId, subnetId *string) []armid.ResourceId {
+	if subnetId == nil || *subnetId == "" {
+		return nil
+	}
+	azureId := id.Clone().(*armid.ScopedResourceId)
+	azureId.AttrTypes = append(azureId.AttrTypes, "networkConfig")
+	azureId.AttrNames = append(azureId.AttrNames, "virtualNetwork")
+	return []armid.ResourceId{azureId}
+}
+
+func appServiceSiteListHostNameBindings(ctx context.Context, client *armappservice.WebAppsClient, id armid.ResourceId) ([]*armappservice.HostNameBinding, error) {
+	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
+	var result []*armappservice.HostNameBinding
+	pager := client.NewListHostNameBindingsPager(resourceGroupId.Name, id.Names()[0], nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing hostname bindings of %q: %v", id, err)
+		}
+		result = append(result, page.Value...)
+	}
+	return result, nil
+}
+
+// appServiceHostNameBindingIds returns the ids of the custom hostname bindings, which excludes the binding of the default hostname.
+func appServiceHostNameBindingIds(bindings []*armappservice.HostNameBinding, defaultHostName *string) ([]armid.ResourceId, error) {
+	var result []armid.ResourceId
+	for _, binding := range bindings {
+		if binding == nil || binding.ID == nil {
+			continue
+		}
+		bindingId, err := armid.ParseResourceId(*binding.ID)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %v", *binding.ID, err)
+		}
+		if defaultHostName != nil && strings.EqualFold(bindingId.Names()[len(bindingId.Names())-1], *defaultHostName) {
+			continue
+		}
+		result = append(result, bindingId)
+	}
+	return result, nil
+}
+
+// appServiceSitePopulateHybridConnections populates the hybrid connections of the site, whose id is "<site id>/hybridConnectionNamespaces/<namespace>/relays/<relay>",
+// together with their resource type resolved by the resource type of the site.
+// The hybrid connection relays are listed via the raw client, as the SDK models the list response as a single hybrid connection.
+func appServiceSitePopulateHybridConnections(b *client.ClientBuilder, id armid.ResourceId, siteRt string) ([]Result, error) {
+	rt := "azurerm_web_app_hybrid_connection"
+	if strings.HasSuffix(siteRt, "_function_app") {
+		rt = "azurerm_function_app_hybrid_connection"
+	}
+
+	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
+	c, err := b.NewRawClient(resourceGroupId.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	values, err := c.List(b.Context(), id.String()+"/hybridConnectionRelays", "2022-03-01", "")
+	if err != nil {
+		return nil, fmt.Errorf("listing hybrid connections of %q: %v", id, err)
+	}
+
+	var result []Result
+	for _, v := range values {
+		item, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		props, ok := item["properties"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		namespace, _ := props["serviceBusNamespace"].(string)
+		relay, _ := props["relayName"].(string)
+		if namespace == "" || relay == "" {
+			continue
+		}
+		azureId := id.Clone().(*armid.ScopedResourceId)
+		azureId.AttrTypes = append(azureId.AttrTypes, "hybridConnectionNamespaces", "relays")
+		azureId.AttrNames = append(azureId.AttrNames, namespace, relay)
+		result = append(result, Result{Id: azureId, ResourceType: rt})
+	}
+	return result, nil
+}
+
+// appServiceSitePopulateSlots populates the slots of the site, together with their resource types resolved by the kinds. The slots of unknown kinds are skipped.
+func appServiceSitePopulateSlots(ctx context.Context, client *armappservice.WebAppsClient, id armid.ResourceId) ([]Result, error) {
+	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
+	var result []Result
+	pager := client.NewListSlotsPager(resourceGroupId.Name, id.Names()[0], nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing slots of %q: %v", id, err)
+		}
+		for _, slot := range page.Value {
+			if slot == nil || slot.ID == nil || slot.Kind == nil {
+				continue
+			}
+			rt, err := resolve.AppServiceSiteSlotTypeByKind(*slot.Kind)
+			if err != nil {
+				continue
+			}
+			slotId, err := armid.ParseResourceId(*slot.ID)
+			if err != nil {
+				return nil, fmt.Errorf("parsing %s: %v", *slot.ID, err)
+			}
+			result = append(result, Result{Id: slotId, ResourceType: rt})
+		}
+	}
+	return result, nil
+}
+
+// appServiceSitePopulateCertificateBindings populates the certificate bindings of the SSL enabled hostname bindings of the site, whose pseudo id is
+// "<hostname binding id>/certificates/<base64 encoded certificate id>".
+// The hostname binding only records the certificate thumbprint, the certificate is looked up by the thumbprint among the certificates in the resource group
+// of the app service plan (or the site, if unknown), which is where the certificates used by the site reside.
+func appServiceSitePopulateCertificateBindings(b *client.ClientBuilder, id armid.ResourceId, bindings []*armappservice.HostNameBinding, serverFarmId *string) ([]armid.ResourceId, error) {
+	type sslBinding struct {
+		id         armid.ResourceId
+		thumbprint string
+	}
+	var sslBindings []sslBinding
+	for _, binding := range bindings {
+		if binding == nil || binding.ID == nil || binding.Properties == nil {
+			continue
+		}
+		props := binding.Properties
+		if props.Thumbprint == nil || *props.Thumbprint == "" {
+			continue
+		}
+		if props.SSLState == nil || *props.SSLState == armappservice.SSLStateDisabled {
+			continue
+		}
+		bindingId, err := armid.ParseResourceId(*binding.ID)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %v", *binding.ID, err)
+		}
+		sslBindings = append(sslBindings, sslBinding{id: bindingId, thumbprint: *props.Thumbprint})
+	}
+	if len(sslBindings) == 0 {
+		return nil, nil
+	}
+
+	certResourceGroupId := id.RootScope().(*armid.ResourceGroup)
+	if serverFarmId != nil {
+		farmId, err := armid.ParseResourceId(*serverFarmId)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %v", *serverFarmId, err)
+		}
+		certResourceGroupId = farmId.RootScope().(*armid.ResourceGroup)
+	}
+	client, err := b.NewAppServiceCertificatesClient(certResourceGroupId.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	certIds := map[string]string{}
+	pager := client.NewListByResourceGroupPager(certResourceGroupId.Name, nil)
+	for pager.More() {
+		page, err := pager.NextPage(b.Context())
+		if err != nil {
+			return nil, fmt.Errorf("listing certificates of %q: %v", certResourceGroupId, err)
+		}
+		for _, cert := range page.Value {
+			if cert == nil || cert.ID == nil || cert.Properties == nil || cert.Properties.Thumbprint == nil {
+				continue
+			}
+			certIds[strings.ToUpper(*cert.Properties.Thumbprint)] = *cert.ID
+		}
+	}
+
+	var result []armid.ResourceId
+	for _, binding := range sslBindings {
+		certId, ok := certIds[strings.ToUpper(binding.thumbprint)]
+		if !ok {
+			continue
+		}
+		certAzureId, err := armid.ParseResourceId(certId)
+		if err != nil {
+			return nil, fmt.Errorf("parsing resource id %q: %v", certId, err)
+		}
+		azureId := binding.id.Clone().(*armid.ScopedResourceId)
+		azureId.AttrTypes = append(azureId.AttrTypes, "certificates")
+		azureId.AttrNames = append(azureId.AttrNames, base64.StdEncoding.EncodeToString([]byte(certAzureId.String())))
+		result = append(result, azureId)
+	}
+	return result, nil
+}
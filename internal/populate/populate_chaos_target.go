@@ -0,0 +1,66 @@
+package populate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/client"
+)
+
+const chaosApiVersion = "2023-11-01"
+
+// populateChaosTargets populates the Chaos Studio targets enabled on the specified resource, together with the capabilities enabled on each target, i.e.
+// "<resource id>/providers/Microsoft.Chaos/targets/<target type>" and "<target id>/capabilities/<capability>".
+// The resources that can't be a Chaos Studio target are skipped, so are the targets and capabilities that are not allowed to be listed.
+func populateChaosTargets(ctx context.Context, c *client.RawClient, id armid.ResourceId) ([]armid.ResourceId, error) {
+	targets, err := c.List(ctx, id.String()+"/providers/Microsoft.Chaos/targets", chaosApiVersion, "")
+	if err != nil {
+		if isUnsupported(err) || isUnauthorized(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("listing Chaos Studio targets of %q: %v", id, err)
+	}
+
+	var result []armid.ResourceId
+	for _, targetId := range idsOfListedValues(targets) {
+		tid, err := armid.ParseResourceId(targetId)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %v", targetId, err)
+		}
+		result = append(result, tid)
+
+		capabilities, err := c.List(ctx, tid.String()+"/capabilities", chaosApiVersion, "")
+		if err != nil {
+			if isUnauthorized(err) {
+				continue
+			}
+			return nil, fmt.Errorf("listing capabilities of %q: %v", tid, err)
+		}
+		for _, capabilityId := range idsOfListedValues(capabilities) {
+			cid, err := armid.ParseResourceId(capabilityId)
+			if err != nil {
+				return nil, fmt.Errorf("parsing %s: %v", capabilityId, err)
+			}
+			result = append(result, cid)
+		}
+	}
+	return result, nil
+}
+
+// idsOfListedValues returns the "id" of each of the values listed by the raw client.
+func idsOfListedValues(values []interface{}) []string {
+	var ids []string
+	for _, v := range values {
+		item, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		id, _ := item["id"].(string)
+		if id == "" {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -0,0 +1,51 @@
+package populate
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/client"
+)
+
+// populateCommunicationService populates the email domain associations of the communication service, whose pseudo id is
+// "<communication service id>/linkedDomains/<base64 encoded email domain id>".
+// The communication service is retrieved via the raw client, as there is no SDK for it in use.
+func populateCommunicationService(b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
+	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
+	c, err := b.NewRawClient(resourceGroupId.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Get(b.Context(), id.String(), "2023-03-31")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %q: %v", id, err)
+	}
+	m, ok := resp.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("retrieving %q: response is not a map: %T", id, resp)
+	}
+	props, ok := m["properties"].(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	domains, _ := props["linkedDomains"].([]interface{})
+
+	var result []armid.ResourceId
+	for _, v := range domains {
+		domainId, ok := v.(string)
+		if !ok || domainId == "" {
+			continue
+		}
+		domainAzureId, err := armid.ParseResourceId(domainId)
+		if err != nil {
+			return nil, fmt.Errorf("parsing resource id %q: %v", domainId, err)
+		}
+		azureId := id.Clone().(*armid.ScopedResourceId)
+		azureId.AttrTypes = append(azureId.AttrTypes, "linkedDomains")
+		azureId.AttrNames = append(azureId.AttrNames, base64.StdEncoding.EncodeToString([]byte(domainAzureId.String())))
+
+		result = append(result, azureId)
+	}
+	return result, nil
+}
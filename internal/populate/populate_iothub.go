@@ -1,7 +1,6 @@
 package populate
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/magodo/armid"
@@ -14,7 +13,7 @@ func populateIotHub(b *client.ClientBuilder, id armid.ResourceId) ([]armid.Resou
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.Get(b.Context(), resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %q: %v", id, err)
 	}
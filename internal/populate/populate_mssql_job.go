@@ -1,7 +1,6 @@
 package populate
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/magodo/armid"
@@ -14,7 +13,7 @@ func populateMssqlJob(b *client.ClientBuilder, id armid.ResourceId) ([]armid.Res
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], id.Names()[2], nil)
+	resp, err := client.Get(b.Context(), resourceGroupId.Name, id.Names()[0], id.Names()[1], id.Names()[2], nil)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %q: %v", id, err)
 	}
@@ -29,6 +28,6 @@ func populateMssqlJob(b *client.ClientBuilder, id armid.ResourceId) ([]armid.Res
 
 	sid := id.Clone().(*armid.ScopedResourceId)
 	sid.AttrTypes = append(sid.AttrTypes, "schedules")
-	sid.AttrNames = append(sid.AttrTypes, "default")
+	sid.AttrNames = append(sid.AttrNames, "default")
 	return []armid.ResourceId{sid}, nil
 }
@@ -0,0 +1,70 @@
+package populate
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/sql/armsql"
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/client"
+)
+
+// populateMssqlServer populates the singleton sub-resources of the server that are configured with non-default settings, which are:
+// - The encryption protector (i.e. the transparent data encryption), if it is customer managed
+// - The extended auditing policy, if it is enabled
+func populateMssqlServer(b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
+	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
+	serverName := id.Names()[0]
+
+	var result []armid.ResourceId
+
+	epClient, err := b.NewSqlEncryptionProtectorsClient(resourceGroupId.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	epResp, err := epClient.Get(b.Context(), resourceGroupId.Name, serverName, armsql.EncryptionProtectorNameCurrent, nil)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the encryption protector of %q: %v", id, err)
+	}
+	if props := epResp.EncryptionProtector.Properties; props != nil && props.ServerKeyType != nil && *props.ServerKeyType == armsql.ServerKeyTypeAzureKeyVault {
+		result = append(result, mssqlSingletonId(id, "encryptionProtector", string(armsql.EncryptionProtectorNameCurrent)))
+	}
+
+	auditingClient, err := b.NewSqlExtendedServerBlobAuditingPoliciesClient(resourceGroupId.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	auditingResp, err := auditingClient.Get(b.Context(), resourceGroupId.Name, serverName, nil)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the extended auditing policy of %q: %v", id, err)
+	}
+	if props := auditingResp.ExtendedServerBlobAuditingPolicy.Properties; props != nil && props.State != nil && *props.State == armsql.BlobAuditingPolicyStateEnabled {
+		result = append(result, mssqlSingletonId(id, "extendedAuditingSettings", "default"))
+	}
+
+	return result, nil
+}
+
+// populateMssqlDatabase populates the extended auditing policy of the database, if it is enabled.
+func populateMssqlDatabase(b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
+	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
+	client, err := b.NewSqlExtendedDatabaseBlobAuditingPoliciesClient(resourceGroupId.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(b.Context(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the extended auditing policy of %q: %v", id, err)
+	}
+	props := resp.ExtendedDatabaseBlobAuditingPolicy.Properties
+	if props == nil || props.State == nil || *props.State != armsql.BlobAuditingPolicyStateEnabled {
+		return nil, nil
+	}
+	return []armid.ResourceId{mssqlSingletonId(id, "extendedAuditingSettings", "default")}, nil
+}
+
+func mssqlSingletonId(id armid.ResourceId, tp, name string) armid.ResourceId {
+	sid := id.Clone().(*armid.ScopedResourceId)
+	sid.AttrTypes = append(sid.AttrTypes, tp)
+	sid.AttrNames = append(sid.AttrNames, name)
+	return sid
+}
@@ -0,0 +1,68 @@
+package populate
+
+import (
+	"fmt"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/client"
+)
+
+// populatePostgresqlServer populates the AAD administrator of the single server, if any.
+// The administrators are listed via the raw client, as there is no SDK of the PostgreSQL single server in use.
+func populatePostgresqlServer(b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
+	values, err := postgresqlListAdministrators(b, id, "2017-12-01")
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	// The single server has at most one AAD administrator, which is named "activeDirectory".
+	azureId := id.Clone().(*armid.ScopedResourceId)
+	azureId.AttrTypes = append(azureId.AttrTypes, "administrators")
+	azureId.AttrNames = append(azureId.AttrNames, "activeDirectory")
+	return []armid.ResourceId{azureId}, nil
+}
+
+// populatePostgresqlFlexibleServer populates the AAD administrators of the flexible server.
+func populatePostgresqlFlexibleServer(b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
+	values, err := postgresqlListAdministrators(b, id, "2022-12-01")
+	if err != nil {
+		return nil, err
+	}
+	var result []armid.ResourceId
+	for _, v := range values {
+		item, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		adminId, _ := item["id"].(string)
+		if adminId == "" {
+			continue
+		}
+		azureId, err := armid.ParseResourceId(adminId)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %v", adminId, err)
+		}
+		result = append(result, azureId)
+	}
+	return result, nil
+}
+
+func postgresqlListAdministrators(b *client.ClientBuilder, id armid.ResourceId, apiVersion string) ([]interface{}, error) {
+	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
+	c, err := b.NewRawClient(resourceGroupId.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Get(b.Context(), id.String()+"/administrators", apiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("listing administrators of %q: %v", id, err)
+	}
+	m, ok := resp.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("listing administrators of %q: response is not a map: %T", id, resp)
+	}
+	values, _ := m["value"].([]interface{})
+	return values, nil
+}
@@ -0,0 +1,45 @@
+package populate
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/client"
+)
+
+func populatePrivateEndpoint(b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
+	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
+	client, err := b.NewNetworkPrivateEndpointsClient(resourceGroupId.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(b.Context(), resourceGroupId.Name, id.Names()[0], nil)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %q: %v", id, err)
+	}
+	props := resp.PrivateEndpoint.Properties
+	if props == nil {
+		return nil, nil
+	}
+
+	var result []armid.ResourceId
+	for _, asg := range props.ApplicationSecurityGroups {
+		if asg == nil {
+			continue
+		}
+		if asg.ID == nil {
+			continue
+		}
+		asgId, err := armid.ParseResourceId(*asg.ID)
+		if err != nil {
+			return nil, fmt.Errorf("parsing resource id %q: %v", *asg.ID, err)
+		}
+		azureId := id.Clone().(*armid.ScopedResourceId)
+		azureId.AttrTypes = append(azureId.AttrTypes, "applicationSecurityGroups")
+		azureId.AttrNames = append(azureId.AttrNames, base64.StdEncoding.EncodeToString([]byte(asgId.String())))
+
+		result = append(result, azureId)
+	}
+	return result, nil
+}
@@ -0,0 +1,171 @@
+package populate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/client"
+)
+
+func populateStorageAccount(b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
+	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
+	accountName := id.Names()[0]
+
+	client, err := b.NewStorageAccountsClient(resourceGroupId.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.GetProperties(b.Context(), resourceGroupId.Name, accountName, nil)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %q: %v", id, err)
+	}
+	props := resp.Account.Properties
+	if props == nil || props.PrimaryEndpoints == nil {
+		return nil, nil
+	}
+	endpoints := props.PrimaryEndpoints
+
+	var result []armid.ResourceId
+
+	if endpoints.Queue != nil {
+		ok, err := storageAccountHasQueueProperties(b, resourceGroupId, *endpoints.Queue)
+		if err != nil {
+			return nil, fmt.Errorf("populating for queue properties: %v", err)
+		}
+		if ok {
+			result = append(result, storageAccountPropertyId(id, "queueServices"))
+		}
+	}
+
+	if endpoints.Web != nil && endpoints.Blob != nil {
+		ok, err := storageAccountHasStaticWebsite(b, resourceGroupId, *endpoints.Blob)
+		if err != nil {
+			return nil, fmt.Errorf("populating for static website: %v", err)
+		}
+		if ok {
+			result = append(result, storageAccountPropertyId(id, "staticWebsites"))
+		}
+	}
+
+	if endpoints.Blob != nil {
+		ok, err := storageAccountHasManagementPolicy(b, resourceGroupId, accountName)
+		if err != nil {
+			return nil, fmt.Errorf("populating for management policy: %v", err)
+		}
+		if ok {
+			result = append(result, storageAccountPropertyId(id, "managementPolicies"))
+		}
+
+		ok, err = storageAccountHasBlobInventoryPolicy(b, resourceGroupId, accountName)
+		if err != nil {
+			return nil, fmt.Errorf("populating for blob inventory policy: %v", err)
+		}
+		if ok {
+			result = append(result, storageAccountPropertyId(id, "inventoryPolicies"))
+		}
+	}
+
+	return result, nil
+}
+
+// storageAccountHasQueueProperties tells whether the queue service has any of the logging, metrics or CORS settings, which are only exposed by the data plane API.
+func storageAccountHasQueueProperties(b *client.ClientBuilder, resourceGroupId *armid.ResourceGroup, endpoint string) (bool, error) {
+	client, err := b.NewStorageDataClient(resourceGroupId.SubscriptionId, endpoint)
+	if err != nil {
+		return false, err
+	}
+	props, err := client.GetServiceProperties(b.Context())
+	if err != nil {
+		if isDataPlaneInaccessible(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("retrieving the queue service properties: %v", err)
+	}
+	if logging := props.Logging; logging != nil && (logging.Delete || logging.Read || logging.Write) {
+		return true, nil
+	}
+	if metrics := props.HourMetrics; metrics != nil && metrics.Enabled {
+		return true, nil
+	}
+	if metrics := props.MinuteMetrics; metrics != nil && metrics.Enabled {
+		return true, nil
+	}
+	return len(props.CorsRules) != 0, nil
+}
+
+// storageAccountHasStaticWebsite tells whether the static website is enabled, by the blob service properties that are only exposed by the data plane API.
+func storageAccountHasStaticWebsite(b *client.ClientBuilder, resourceGroupId *armid.ResourceGroup, endpoint string) (bool, error) {
+	client, err := b.NewStorageDataClient(resourceGroupId.SubscriptionId, endpoint)
+	if err != nil {
+		return false, err
+	}
+	props, err := client.GetServiceProperties(b.Context())
+	if err != nil {
+		if isDataPlaneInaccessible(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("retrieving the blob service properties: %v", err)
+	}
+	return props.StaticWebsite != nil && props.StaticWebsite.Enabled, nil
+}
+
+func storageAccountHasManagementPolicy(b *client.ClientBuilder, resourceGroupId *armid.ResourceGroup, accountName string) (bool, error) {
+	client, err := b.NewStorageManagementPoliciesClient(resourceGroupId.SubscriptionId)
+	if err != nil {
+		return false, err
+	}
+	if _, err := client.Get(b.Context(), resourceGroupId.Name, accountName, armstorage.ManagementPolicyNameDefault, nil); err != nil {
+		if isNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("retrieving the management policy: %v", err)
+	}
+	return true, nil
+}
+
+func storageAccountHasBlobInventoryPolicy(b *client.ClientBuilder, resourceGroupId *armid.ResourceGroup, accountName string) (bool, error) {
+	client, err := b.NewStorageBlobInventoryPoliciesClient(resourceGroupId.SubscriptionId)
+	if err != nil {
+		return false, err
+	}
+	if _, err := client.Get(b.Context(), resourceGroupId.Name, accountName, armstorage.BlobInventoryPolicyNameDefault, nil); err != nil {
+		if isNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("retrieving the blob inventory policy: %v", err)
+	}
+	return true, nil
+}
+
+func storageAccountPropertyId(id armid.ResourceId, tp string) armid.ResourceId {
+	pid := id.Clone().(*armid.ScopedResourceId)
+	pid.AttrTypes = append(pid.AttrTypes, tp)
+	pid.AttrNames = append(pid.AttrNames, "default")
+	return pid
+}
+
+// isDataPlaneInaccessible tells whether the data plane API of the storage account can't be accessed, e.g. denied by the firewall or for lack of the
+// data plane permission, or not reachable as the account is only exposed via private endpoints. The properties that are only exposed by the data plane
+// are then skipped, instead of failing the whole query.
+func isDataPlaneInaccessible(err error) bool {
+	if isUnauthorized(err) {
+		return true
+	}
+	// The cancellation or the deadline of the query itself is not about the data plane.
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+func isNotFound(err error) bool {
+	var respErr *azcore.ResponseError
+	return errors.As(err, &respErr) && respErr.StatusCode == http.StatusNotFound
+}
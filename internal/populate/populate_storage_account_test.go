@@ -0,0 +1,126 @@
+package populate
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/magodo/aztft/internal/client/clienttest"
+)
+
+const (
+	storageQueueHost = "account1.queue.core.windows.net/"
+	storageBlobHost  = "account1.blob.core.windows.net/"
+)
+
+func storageAccountTransport(id, queueProps, blobProps string, withPolicies bool) *clienttest.Transport {
+	fixtures := map[string]string{
+		testEndpoint + id: `{"properties": {"primaryEndpoints": {
+			"blob": "https://account1.blob.core.windows.net/",
+			"queue": "https://account1.queue.core.windows.net/",
+			"web": "https://account1.z1.web.core.windows.net/"
+		}}}`,
+		storageQueueHost: `<?xml version="1.0" encoding="utf-8"?><StorageServiceProperties>` + queueProps + `</StorageServiceProperties>`,
+		storageBlobHost:  `<?xml version="1.0" encoding="utf-8"?><StorageServiceProperties>` + blobProps + `</StorageServiceProperties>`,
+	}
+	if withPolicies {
+		fixtures[testEndpoint+id+"/managementPolicies/default"] = `{}`
+		fixtures[testEndpoint+id+"/inventoryPolicies/default"] = `{}`
+	}
+	return &clienttest.Transport{Fixtures: fixtures}
+}
+
+func storageAccountPolicyIds(id string) []string {
+	return []string{id + "/managementPolicies/default", id + "/inventoryPolicies/default"}
+}
+
+func storageAccountCases() []populaterCase {
+	const (
+		queueLogging   = `<Logging><Version>1.0</Version><Delete>false</Delete><Read>true</Read><Write>false</Write></Logging>`
+		queueUnset     = `<Logging><Version>1.0</Version><Delete>false</Delete><Read>false</Read><Write>false</Write></Logging><HourMetrics><Enabled>false</Enabled></HourMetrics><MinuteMetrics><Enabled>false</Enabled></MinuteMetrics><Cors/>`
+		queueCors      = `<Cors><CorsRule><AllowedOrigins>*</AllowedOrigins></CorsRule></Cors>`
+		websiteEnabled = `<StaticWebsite><Enabled>true</Enabled></StaticWebsite>`
+		websiteUnset   = `<StaticWebsite><Enabled>false</Enabled></StaticWebsite>`
+	)
+	return []populaterCase{
+		{
+			name: "all configured",
+			transport: func(id string) *clienttest.Transport {
+				return storageAccountTransport(id, queueLogging, websiteEnabled, true)
+			},
+			expect: func(id string) []string {
+				return append(storageAccountPolicyIds(id), id+"/queueServices/default", id+"/staticWebsites/default")
+			},
+		},
+		{
+			name: "nothing configured",
+			transport: func(id string) *clienttest.Transport {
+				return storageAccountTransport(id, queueUnset, websiteUnset, false)
+			},
+		},
+		{
+			name: "queue CORS only",
+			transport: func(id string) *clienttest.Transport {
+				return storageAccountTransport(id, queueCors, websiteUnset, false)
+			},
+			expect: func(id string) []string {
+				return []string{id + "/queueServices/default"}
+			},
+		},
+		{
+			name: "no web endpoint",
+			transport: func(id string) *clienttest.Transport {
+				tr := storageAccountTransport(id, queueUnset, websiteEnabled, false)
+				tr.Fixtures[testEndpoint+id] = `{"properties": {"primaryEndpoints": {"blob": "https://account1.blob.core.windows.net/"}}}`
+				return tr
+			},
+		},
+		{
+			name: "data plane forbidden",
+			transport: func(id string) *clienttest.Transport {
+				tr := storageAccountTransport(id, queueLogging, websiteEnabled, true)
+				tr.StatusCodes = map[string]int{storageQueueHost: http.StatusForbidden, storageBlobHost: http.StatusForbidden}
+				return tr
+			},
+			expect: storageAccountPolicyIds,
+		},
+		{
+			name: "data plane unauthorized",
+			transport: func(id string) *clienttest.Transport {
+				tr := storageAccountTransport(id, queueLogging, websiteEnabled, true)
+				tr.StatusCodes = map[string]int{storageBlobHost: http.StatusUnauthorized}
+				return tr
+			},
+			expect: func(id string) []string {
+				return append(storageAccountPolicyIds(id), id+"/queueServices/default")
+			},
+		},
+		{
+			name: "data plane unreachable",
+			transport: func(id string) *clienttest.Transport {
+				tr := storageAccountTransport(id, queueLogging, websiteEnabled, true)
+				dnsErr := &net.DNSError{Err: "no such host", IsNotFound: true}
+				tr.Errors = map[string]error{storageQueueHost: dnsErr, storageBlobHost: dnsErr}
+				return tr
+			},
+			expect: storageAccountPolicyIds,
+		},
+		{
+			name: "data plane server error",
+			transport: func(id string) *clienttest.Transport {
+				tr := storageAccountTransport(id, queueLogging, websiteEnabled, true)
+				tr.StatusCodes = map[string]int{storageQueueHost: http.StatusInternalServerError}
+				return tr
+			},
+			err: "retrieving the queue service properties",
+		},
+		{
+			name: "management policy forbidden",
+			transport: func(id string) *clienttest.Transport {
+				tr := storageAccountTransport(id, queueLogging, websiteEnabled, true)
+				tr.StatusCodes = map[string]int{testEndpoint + id + "/managementPolicies/default": http.StatusForbidden}
+				return tr
+			},
+			err: "retrieving the management policy",
+		},
+	}
+}
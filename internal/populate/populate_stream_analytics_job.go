@@ -1,7 +1,6 @@
 package populate
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/magodo/armid"
@@ -14,7 +13,7 @@ func populateStreamAnalyticsJob(b *client.ClientBuilder, id armid.ResourceId) ([
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.Get(b.Context(), resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %q: %v", id, err)
 	}
@@ -33,6 +32,6 @@ func populateStreamAnalyticsJob(b *client.ClientBuilder, id armid.ResourceId) ([
 
 	sid := id.Clone().(*armid.ScopedResourceId)
 	sid.AttrTypes = append(sid.AttrTypes, "storageAccounts")
-	sid.AttrNames = append(sid.AttrTypes, *props.JobStorageAccount.AccountName)
+	sid.AttrNames = append(sid.AttrNames, *props.JobStorageAccount.AccountName)
 	return []armid.ResourceId{sid}, nil
 }
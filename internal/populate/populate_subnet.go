@@ -1,7 +1,6 @@
 package populate
 
 import (
-	"context"
 	"encoding/base64"
 	"fmt"
 
@@ -15,7 +14,7 @@ func populateSubnet(b *client.ClientBuilder, id armid.ResourceId) ([]armid.Resou
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(b.Context(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %q: %v", id, err)
 	}
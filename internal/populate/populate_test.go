@@ -0,0 +1,723 @@
+package populate
+
+import (
+	"encoding/base64"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/client/clienttest"
+	"github.com/magodo/aztft/internal/exampleid"
+	"github.com/magodo/aztft/internal/resmap"
+	"github.com/magodo/aztft/internal/tfid"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRgId     = "/subscriptions/sub1/resourceGroups/rg1"
+	testNetwork  = testRgId + "/providers/Microsoft.Network"
+	testEndpoint = "management.azure.com"
+)
+
+// dataPlaneReadActions are the ARM actions that are regarded to be called by the data plane requests to the hosts of the suffixes.
+// The App Configuration data plane is absent, as listing its key-values requires a data plane role instead.
+var dataPlaneReadActions = map[string]string{
+	".queue.core.windows.net": "Microsoft.Storage/storageAccounts/queueServices/read",
+	".blob.core.windows.net":  "Microsoft.Storage/storageAccounts/blobServices/read",
+}
+
+func appServiceSiteFixtures(kind string) func(id string) map[string]string {
+	return func(id string) map[string]string {
+		return map[string]string{
+			testEndpoint + id: `{
+				"kind": "` + kind + `",
+				"properties": {
+					"defaultHostName": "sites1.azurewebsites.net",
+					"serverFarmId": "` + testRgId + `/providers/Microsoft.Web/serverFarms/plan1",
+					"virtualNetworkSubnetId": "` + testNetwork + `/virtualNetworks/vnet1/subnets/subnet1"
+				}
+			}`,
+			testEndpoint + id + "/hostNameBindings": `{"value": [
+				{"id": "` + id + `/hostNameBindings/sites1.azurewebsites.net"},
+				{"id": "` + id + `/hostNameBindings/www.example.com", "properties": {"thumbprint": "ABC", "sslState": "SniEnabled"}}
+			]}`,
+			testEndpoint + testRgId + "/providers/Microsoft.Web/certificates": `{"value": [
+				{"id": "` + testRgId + `/providers/Microsoft.Web/certificates/cert1", "properties": {"thumbprint": "abc"}}
+			]}`,
+			// The next link differs in the path, as the fixtures are keyed by the path.
+			testEndpoint + id + "/hybridConnectionRelays": `{
+				"value": [{"properties": {"serviceBusNamespace": "ns1", "relayName": "relay1"}}],
+				"nextLink": "https://` + testEndpoint + id + `/hybridConnectionRelays/page2?api-version=2022-03-01"
+			}`,
+			testEndpoint + id + "/hybridConnectionRelays/page2": `{"value": [
+				{"properties": {"serviceBusNamespace": "ns1", "relayName": "relay2"}}
+			]}`,
+			testEndpoint + id + "/slots": `{"value": [
+				{"id": "` + id + `/slots/slot1", "kind": "` + kind + `"}
+			]}`,
+		}
+	}
+}
+
+func appServiceSiteSlotFixtures(id string) map[string]string {
+	return map[string]string{
+		testEndpoint + id: `{"properties": {
+			"defaultHostName": "sites1-slot1.azurewebsites.net",
+			"virtualNetworkSubnetId": "` + testNetwork + `/virtualNetworks/vnet1/subnets/subnet1"
+		}}`,
+		testEndpoint + id + "/hostNameBindings": `{"value": [
+			{"id": "` + id + `/hostNameBindings/sites1-slot1.azurewebsites.net"},
+			{"id": "` + id + `/hostNameBindings/www.example.com"}
+		]}`,
+	}
+}
+
+func virtualMachineFixtures(id string) map[string]string {
+	return map[string]string{
+		testEndpoint + id: `{"properties": {
+			"storageProfile": {"dataDisks": [{"managedDisk": {"id": "` + testRgId + `/providers/Microsoft.Compute/disks/disk1"}}]},
+			"applicationProfile": {"galleryApplications": [{"packageReferenceId": "` + testRgId + `/providers/Microsoft.Compute/galleries/gallery1/applications/app1/versions/1.0.0"}]}
+		}}`,
+	}
+}
+
+func postgresqlServerFixtures(id string) map[string]string {
+	return map[string]string{
+		testEndpoint + id + "/administrators": `{"value": [{"id": "` + id + `/administrators/00000000-0000-0000-0000-000000000000"}]}`,
+	}
+}
+
+// populaterFixtures are the fixtures of each populater, keyed by the populated resource id, which are expected to make the populater emit all the resource types
+// declared in PopulatedTypes.
+var populaterFixtures = map[string]func(id string) map[string]string{
+	"azurerm_linux_virtual_machine":   virtualMachineFixtures,
+	"azurerm_windows_virtual_machine": virtualMachineFixtures,
+	"azurerm_network_interface": func(id string) map[string]string {
+		return map[string]string{
+			testEndpoint + id: `{"properties": {
+				"networkSecurityGroup": {"id": "` + testNetwork + `/networkSecurityGroups/nsg1"},
+				"ipConfigurations": [{
+					"id": "` + id + `/ipConfigurations/ipconfig1",
+					"properties": {
+						"applicationGatewayBackendAddressPools": [{"id": "` + testNetwork + `/applicationGateways/agw1/backendAddressPools/pool1"}],
+						"applicationSecurityGroups": [{"id": "` + testNetwork + `/applicationSecurityGroups/asg1"}],
+						"loadBalancerInboundNatRules": [{"id": "` + testNetwork + `/loadBalancers/lb1/inboundNatRules/rule1"}],
+						"loadBalancerBackendAddressPools": [{"id": "` + testNetwork + `/loadBalancers/lb1/backendAddressPools/pool1"}]
+					}
+				}]
+			}}`,
+		}
+	},
+	"azurerm_virtual_desktop_workspace": func(id string) map[string]string {
+		return map[string]string{
+			testEndpoint + id: `{"properties": {"applicationGroupReferences": ["` + testRgId + `/providers/Microsoft.DesktopVirtualization/applicationGroups/ag1"]}}`,
+		}
+	},
+	"azurerm_virtual_desktop_scaling_plan": func(id string) map[string]string {
+		return map[string]string{
+			testEndpoint + id: `{"properties": {"hostPoolReferences": [{"hostPoolArmPath": "` + testRgId + `/providers/Microsoft.DesktopVirtualization/hostPools/pool1"}]}}`,
+		}
+	},
+	"azurerm_nat_gateway": func(id string) map[string]string {
+		return map[string]string{
+			testEndpoint + id: `{"properties": {
+				"publicIpAddresses": [{"id": "` + testNetwork + `/publicIPAddresses/pip1"}],
+				"publicIpPrefixes": [{"id": "` + testNetwork + `/publicIPPrefixes/prefix1"}]
+			}}`,
+		}
+	},
+	"azurerm_subnet": func(id string) map[string]string {
+		return map[string]string{
+			testEndpoint + id: `{"properties": {
+				"routeTable": {"id": "` + testNetwork + `/routeTables/rt1"},
+				"networkSecurityGroup": {"id": "` + testNetwork + `/networkSecurityGroups/nsg1"},
+				"natGateway": {"id": "` + testNetwork + `/natGateways/natgw1"}
+			}}`,
+		}
+	},
+	"azurerm_logic_app_workflow": func(id string) map[string]string {
+		return map[string]string{
+			testEndpoint + id: `{"properties": {"definition": {"actions": {"action1": {}}, "triggers": {"trigger1": {}}}}}`,
+		}
+	},
+	"azurerm_iothub": func(id string) map[string]string {
+		return map[string]string{
+			testEndpoint + id: `{"properties": {"routing": {"endpoints": {
+				"eventHubs": [{"name": "eh1"}],
+				"serviceBusQueues": [{"name": "queue1"}],
+				"serviceBusTopics": [{"name": "topic1"}],
+				"storageContainers": [{"name": "container1"}]
+			}}}}`,
+		}
+	},
+	"azurerm_netapp_account": func(id string) map[string]string {
+		return map[string]string{
+			testEndpoint + id: `{"properties": {"encryption": {"keySource": "Microsoft.KeyVault"}}}`,
+		}
+	},
+	"azurerm_lb": func(id string) map[string]string {
+		return map[string]string{
+			testEndpoint + id: `{"properties": {
+				"loadBalancingRules": [{"id": "` + id + `/loadBalancingRules/rule1"}],
+				"probes": [{"id": "` + id + `/probes/probe1"}]
+			}}`,
+		}
+	},
+	"azurerm_container_app_environment": func(id string) map[string]string {
+		return map[string]string{
+			testEndpoint + id: `{"properties": {"customDomainConfiguration": {"dnsSuffix": "example.com"}}}`,
+		}
+	},
+	"azurerm_mssql_job": func(id string) map[string]string {
+		return map[string]string{
+			testEndpoint + id: `{"properties": {"schedule": {"type": "Recurring"}}}`,
+		}
+	},
+	"azurerm_stream_analytics_job": func(id string) map[string]string {
+		return map[string]string{
+			testEndpoint + id: `{"properties": {"jobStorageAccount": {"accountName": "account1"}}}`,
+		}
+	},
+	"azurerm_linux_web_app":             appServiceSiteFixtures("app,linux"),
+	"azurerm_windows_web_app":           appServiceSiteFixtures("app"),
+	"azurerm_linux_function_app":        appServiceSiteFixtures("functionapp,linux"),
+	"azurerm_windows_function_app":      appServiceSiteFixtures("functionapp"),
+	"azurerm_logic_app_standard":        appServiceSiteFixtures("functionapp,workflowapp"),
+	"azurerm_linux_web_app_slot":        appServiceSiteSlotFixtures,
+	"azurerm_windows_web_app_slot":      appServiceSiteSlotFixtures,
+	"azurerm_linux_function_app_slot":   appServiceSiteSlotFixtures,
+	"azurerm_windows_function_app_slot": appServiceSiteSlotFixtures,
+	"azurerm_storage_account": func(id string) map[string]string {
+		return map[string]string{
+			testEndpoint + id: `{"properties": {"primaryEndpoints": {
+				"blob": "https://account1.blob.core.windows.net/",
+				"queue": "https://account1.queue.core.windows.net/",
+				"web": "https://account1.z1.web.core.windows.net/"
+			}}}`,
+			"account1.queue.core.windows.net/":                `<?xml version="1.0" encoding="utf-8"?><StorageServiceProperties><Logging><Delete>true</Delete></Logging></StorageServiceProperties>`,
+			"account1.blob.core.windows.net/":                 `<?xml version="1.0" encoding="utf-8"?><StorageServiceProperties><StaticWebsite><Enabled>true</Enabled></StaticWebsite></StorageServiceProperties>`,
+			testEndpoint + id + "/managementPolicies/default": `{}`,
+			testEndpoint + id + "/inventoryPolicies/default":  `{}`,
+		}
+	},
+	"azurerm_mssql_server": func(id string) map[string]string {
+		return map[string]string{
+			testEndpoint + id + "/encryptionProtector/current":      `{"properties": {"serverKeyType": "AzureKeyVault"}}`,
+			testEndpoint + id + "/extendedAuditingSettings/default": `{"properties": {"state": "Enabled"}}`,
+		}
+	},
+	"azurerm_mssql_database": func(id string) map[string]string {
+		return map[string]string{
+			testEndpoint + id + "/extendedAuditingSettings/default": `{"properties": {"state": "Enabled"}}`,
+		}
+	},
+	"azurerm_postgresql_server":          postgresqlServerFixtures,
+	"azurerm_postgresql_flexible_server": postgresqlServerFixtures,
+	"azurerm_private_endpoint": func(id string) map[string]string {
+		return map[string]string{
+			testEndpoint + id: `{"properties": {"applicationSecurityGroups": [{"id": "` + testNetwork + `/applicationSecurityGroups/asg1"}]}}`,
+		}
+	},
+	"azurerm_communication_service": func(id string) map[string]string {
+		return map[string]string{
+			testEndpoint + id: `{"properties": {"linkedDomains": ["` + testRgId + `/providers/Microsoft.Communication/emailServices/email1/domains/example.com"]}}`,
+		}
+	},
+	"azurerm_app_configuration": func(id string) map[string]string {
+		return map[string]string{
+			testEndpoint + id: `{"properties": {"endpoint": "https://store1.azconfig.io"}}`,
+			"store1.azconfig.io/kv": `{"items": [
+				{"key": "key1", "label": null},
+				{"key": ".appconfig.featureflag/feature1", "label": "label1"}
+			]}`,
+		}
+	},
+}
+
+// requestedReadActions returns the ARM actions of the requests, including the ones that are regarded to be called by the data plane requests.
+func requestedReadActions(reqs []*http.Request) []string {
+	m := map[string]bool{}
+	for _, req := range reqs {
+		if action := clienttest.ReadAction(req); action != "" {
+			m[strings.ToLower(action)] = true
+			continue
+		}
+		for suffix, action := range dataPlaneReadActions {
+			if strings.HasSuffix(req.URL.Host, suffix) {
+				m[strings.ToLower(action)] = true
+			}
+		}
+	}
+	return sortedKeys(m)
+}
+
+func lowerSet(l []string) []string {
+	m := map[string]bool{}
+	for _, v := range l {
+		m[strings.ToLower(v)] = true
+	}
+	return sortedKeys(m)
+}
+
+func sortedKeys(m map[string]bool) []string {
+	out := []string{}
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// populaterCase is a case of running a populater against the transport, whose fixtures and expected results are built from the populated resource id.
+type populaterCase struct {
+	name      string
+	transport func(id string) *clienttest.Transport
+	// expect returns the expected results, each of which is formatted by resultString.
+	expect func(id string) []string
+	// err is the expected error message, if any.
+	err string
+}
+
+// resultString formats the result as "<id>", or "<id> as <resource type>" if the resource type is known.
+func resultString(result Result) string {
+	if result.ResourceType == "" {
+		return result.Id.String()
+	}
+	return result.Id.String() + " as " + result.ResourceType
+}
+
+func b64(s string) string {
+	return base64.StdEncoding.EncodeToString([]byte(s))
+}
+
+func runPopulaterCases(t *testing.T, rt string, cases []populaterCase) {
+	resmap.Init()
+	id := exampleid.DefaultId(rt)
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			results, err := Populate(id, rt, clienttest.NewClientBuilder(c.transport(id.String())))
+			if c.err != "" {
+				require.ErrorContains(t, err, c.err)
+				return
+			}
+			require.NoError(t, err)
+			var actual, expect []string
+			for _, result := range results {
+				actual = append(actual, resultString(result))
+			}
+			if c.expect != nil {
+				expect = c.expect(id.String())
+			}
+			require.ElementsMatch(t, expect, actual)
+		})
+	}
+}
+
+// TestPopulaters runs each populater against its fixtures, to check the resource types it emits and the ARM actions it calls match the declared ones.
+func TestPopulaters(t *testing.T) {
+	resmap.Init()
+	for _, rt := range ResourceTypes() {
+		rt := rt
+		t.Run(rt, func(t *testing.T) {
+			fixtures, ok := populaterFixtures[rt]
+			require.True(t, ok, "no fixture for the populater")
+
+			id := exampleid.DefaultId(rt)
+			transport := &clienttest.Transport{Fixtures: fixtures(id.String())}
+			results, err := Populate(id, rt, clienttest.NewClientBuilder(transport))
+			require.NoError(t, err)
+
+			types := map[string]bool{}
+			for _, result := range results {
+				rid, ok := result.Id.(*armid.ScopedResourceId)
+				require.True(t, ok, "%s is not a scoped resource id", result.Id)
+				require.Len(t, rid.AttrNames, len(rid.AttrTypes), "mismatched types and names: %v", rid.AttrTypes)
+				require.True(t, strings.HasPrefix(strings.ToUpper(rid.String()), strings.ToUpper(id.String()+"/")), "%s is not under %s", result.Id, id)
+				types[strings.Join(rid.AttrTypes[len(id.Types()):], "/")] = true
+			}
+			require.Equal(t, lowerSet(PopulatedTypes[rt]), lowerSet(sortedKeys(types)), "emitted resource types")
+			require.Equal(t, lowerSet(ReadActions[rt]), requestedReadActions(transport.Requests()), "called ARM actions")
+		})
+	}
+}
+
+func TestPopulateExtensions(t *testing.T) {
+	id := testNetwork + "/virtualNetworks/vnet1"
+	childId := id + "/subnets/subnet1"
+	targetId := id + "/providers/Microsoft.Chaos/targets/Microsoft-VirtualNetwork"
+	fixtures := map[string]string{
+		testEndpoint + targetId[:strings.LastIndex(targetId, "/")]: `{"value": [{"id": "` + targetId + `"}]}`,
+		testEndpoint + targetId + "/capabilities":                  `{"value": [{"id": "` + targetId + `/capabilities/capability1"}]}`,
+	}
+	var expected []string
+	for _, ext := range extensions {
+		extId := id + "/providers/" + ext.typ + "/ext1"
+		fixtures[testEndpoint+id+"/providers/"+ext.typ] = `{"value": [{"id": "` + extId + `"}, {"id": "` + childId + "/providers/" + ext.typ + `/ext2"}]}`
+		expected = append(expected, extId)
+	}
+	expected = append(expected, targetId, targetId+"/capabilities/capability1")
+
+	azureId, err := armid.ParseResourceId(id)
+	require.NoError(t, err)
+	transport := &clienttest.Transport{Fixtures: fixtures}
+	ids, err := PopulateExtensions(azureId, clienttest.NewClientBuilder(transport))
+	require.NoError(t, err)
+
+	var actual []string
+	for _, id := range ids {
+		actual = append(actual, id.String())
+	}
+	require.Equal(t, expected, actual)
+	require.Equal(t, lowerSet(ExtensionReadActions), requestedReadActions(transport.Requests()), "called ARM actions")
+}
+
+// fixturesCase is a populaterCase that responds the fixtures, and 404 for the other requests.
+func fixturesCase(name string, fixtures func(id string) map[string]string, expect func(id string) []string) populaterCase {
+	return populaterCase{
+		name: name,
+		transport: func(id string) *clienttest.Transport {
+			return &clienttest.Transport{Fixtures: fixtures(id)}
+		},
+		expect: expect,
+	}
+}
+
+// allConfiguredCase is the case of the populaterFixtures of the resource type.
+func allConfiguredCase(rt string, expect func(id string) []string) populaterCase {
+	return fixturesCase("all configured", populaterFixtures[rt], expect)
+}
+
+// propertiesFixtures returns the fixtures that only respond the populated resource, with the properties.
+func propertiesFixtures(props string) func(id string) map[string]string {
+	return func(id string) map[string]string {
+		return map[string]string{testEndpoint + id: `{"properties": ` + props + `}`}
+	}
+}
+
+// childIds returns the ids of the children of the populated resource, which are the populated resource id followed by each of the suffixes.
+func childIds(suffixes ...string) func(id string) []string {
+	return func(id string) []string {
+		var out []string
+		for _, suffix := range suffixes {
+			out = append(out, id+suffix)
+		}
+		return out
+	}
+}
+
+func virtualMachineCases() []populaterCase {
+	return []populaterCase{
+		allConfiguredCase("azurerm_linux_virtual_machine", childIds(
+			"/dataDisks/disk1",
+			"/galleryApplications/"+b64(testRgId+"/providers/Microsoft.Compute/galleries/gallery1/applications/app1/versions/1.0.0"),
+		)),
+		fixturesCase("unmanaged data disk", propertiesFixtures(`{"storageProfile": {"dataDisks": [{"name": "disk1", "vhd": {"uri": "https://account1.blob.core.windows.net/vhds/disk1.vhd"}}]}}`), nil),
+		fixturesCase("no profile", propertiesFixtures(`{}`), nil),
+	}
+}
+
+func postgresqlServerCases(adminIds func(id string) []string) []populaterCase {
+	return []populaterCase{
+		fixturesCase("all configured", postgresqlServerFixtures, adminIds),
+		fixturesCase("no administrator", func(id string) map[string]string {
+			return map[string]string{testEndpoint + id + "/administrators": `{"value": []}`}
+		}, nil),
+	}
+}
+
+// appServiceSiteCases returns the cases of the site of the kind, whose hybrid connections and slot are expected to be of the resource types. The hybrid connections
+// and the slots are not populated if the resource types are empty, i.e. for the logic app standard.
+func appServiceSiteCases(kind, hybridConnectionRt, slotRt string) []populaterCase {
+	full := func(id string) []string {
+		bindingId := id + "/hostNameBindings/www.example.com"
+		out := []string{
+			id + "/networkConfig/virtualNetwork",
+			bindingId,
+			bindingId + "/certificates/" + b64(testRgId+"/providers/Microsoft.Web/certificates/cert1"),
+		}
+		if slotRt != "" {
+			out = append(out,
+				id+"/hybridConnectionNamespaces/ns1/relays/relay1 as "+hybridConnectionRt,
+				id+"/hybridConnectionNamespaces/ns1/relays/relay2 as "+hybridConnectionRt,
+				id+"/slots/slot1 as "+slotRt,
+			)
+		}
+		return out
+	}
+	return []populaterCase{
+		fixturesCase("all configured", appServiceSiteFixtures(kind), full),
+		fixturesCase("default hostname only", func(id string) map[string]string {
+			return map[string]string{
+				testEndpoint + id:                             `{"kind": "` + kind + `", "properties": {"defaultHostName": "sites1.azurewebsites.net"}}`,
+				testEndpoint + id + "/hostNameBindings":       `{"value": [{"id": "` + id + `/hostNameBindings/sites1.azurewebsites.net"}]}`,
+				testEndpoint + id + "/hybridConnectionRelays": `{"value": []}`,
+				testEndpoint + id + "/slots":                  `{"value": []}`,
+			}
+		}, nil),
+		fixturesCase("no certificate binding or slot of known kind", func(id string) map[string]string {
+			return map[string]string{
+				testEndpoint + id: `{"kind": "` + kind + `", "properties": {
+					"defaultHostName": "sites1.azurewebsites.net",
+					"serverFarmId": "` + testRgId + `/providers/Microsoft.Web/serverFarms/plan1"
+				}}`,
+				testEndpoint + id + "/hostNameBindings": `{"value": [
+					{"id": "` + id + `/hostNameBindings/www.example.com", "properties": {"thumbprint": "ABC", "sslState": "Disabled"}},
+					{"id": "` + id + `/hostNameBindings/api.example.com", "properties": {"thumbprint": "DEF", "sslState": "SniEnabled"}}
+				]}`,
+				testEndpoint + testRgId + "/providers/Microsoft.Web/certificates": `{"value": [
+					{"id": "` + testRgId + `/providers/Microsoft.Web/certificates/cert1", "properties": {"thumbprint": "ABC"}}
+				]}`,
+				testEndpoint + id + "/hybridConnectionRelays": `{"value": []}`,
+				testEndpoint + id + "/slots":                  `{"value": [{"id": "` + id + `/slots/slot1", "kind": "unknown"}]}`,
+			}
+		}, childIds("/hostNameBindings/www.example.com", "/hostNameBindings/api.example.com")),
+	}
+}
+
+func appServiceSiteSlotCases() []populaterCase {
+	return []populaterCase{
+		fixturesCase("all configured", appServiceSiteSlotFixtures, childIds("/networkConfig/virtualNetwork", "/hostNameBindings/www.example.com")),
+		fixturesCase("default hostname only", func(id string) map[string]string {
+			return map[string]string{
+				testEndpoint + id:                       `{"properties": {"defaultHostName": "sites1-slot1.azurewebsites.net"}}`,
+				testEndpoint + id + "/hostNameBindings": `{"value": [{"id": "` + id + `/hostNameBindings/sites1-slot1.azurewebsites.net"}]}`,
+			}
+		}, nil),
+	}
+}
+
+// populaterCases are the cases of each populater, which check the exact results of both the configured and the unconfigured resources.
+var populaterCases = map[string][]populaterCase{
+	"azurerm_linux_virtual_machine":   virtualMachineCases(),
+	"azurerm_windows_virtual_machine": virtualMachineCases(),
+	"azurerm_network_interface": {
+		allConfiguredCase("azurerm_network_interface", func(id string) []string {
+			ipConfigId := id + "/ipConfigurations/ipconfig1"
+			return []string{
+				id + "/networkSecurityGroups/" + b64(testNetwork+"/networkSecurityGroups/nsg1"),
+				ipConfigId + "/applicationGatewayBackendAddressPools/" + b64(testNetwork+"/applicationGateways/agw1/backendAddressPools/pool1"),
+				ipConfigId + "/applicationSecurityGroups/" + b64(testNetwork+"/applicationSecurityGroups/asg1"),
+				ipConfigId + "/loadBalancerInboundNatRules/" + b64(testNetwork+"/loadBalancers/lb1/inboundNatRules/rule1"),
+				ipConfigId + "/loadBalancerBackendAddressPools/" + b64(testNetwork+"/loadBalancers/lb1/backendAddressPools/pool1"),
+			}
+		}),
+		fixturesCase("no association", func(id string) map[string]string {
+			return propertiesFixtures(`{"ipConfigurations": [{"id": "` + id + `/ipConfigurations/ipconfig1", "properties": {}}]}`)(id)
+		}, nil),
+	},
+	"azurerm_virtual_desktop_workspace": {
+		allConfiguredCase("azurerm_virtual_desktop_workspace", childIds("/applicationGroups/"+b64(testRgId+"/providers/Microsoft.DesktopVirtualization/applicationGroups/ag1"))),
+		fixturesCase("no application group", propertiesFixtures(`{"applicationGroupReferences": []}`), nil),
+	},
+	"azurerm_virtual_desktop_scaling_plan": {
+		allConfiguredCase("azurerm_virtual_desktop_scaling_plan", childIds("/hostPools/"+b64(testRgId+"/providers/Microsoft.DesktopVirtualization/hostPools/pool1"))),
+		fixturesCase("no host pool", propertiesFixtures(`{"hostPoolReferences": []}`), nil),
+	},
+	"azurerm_nat_gateway": {
+		allConfiguredCase("azurerm_nat_gateway", childIds(
+			"/publicIPAddresses/"+b64(testNetwork+"/publicIPAddresses/pip1"),
+			"/publicIPPrefixes/"+b64(testNetwork+"/publicIPPrefixes/prefix1"),
+		)),
+		fixturesCase("no public ip", propertiesFixtures(`{}`), nil),
+	},
+	"azurerm_subnet": {
+		allConfiguredCase("azurerm_subnet", childIds(
+			"/routeTables/"+b64(testNetwork+"/routeTables/rt1"),
+			"/networkSecurityGroups/"+b64(testNetwork+"/networkSecurityGroups/nsg1"),
+			"/natGateways/"+b64(testNetwork+"/natGateways/natgw1"),
+		)),
+		fixturesCase("route table only", propertiesFixtures(`{"routeTable": {"id": "`+testNetwork+`/routeTables/rt1"}}`), childIds("/routeTables/"+b64(testNetwork+"/routeTables/rt1"))),
+		fixturesCase("no association", propertiesFixtures(`{}`), nil),
+	},
+	"azurerm_logic_app_workflow": {
+		allConfiguredCase("azurerm_logic_app_workflow", childIds("/actions/action1", "/triggers/trigger1")),
+		fixturesCase("no action or trigger", propertiesFixtures(`{"definition": {}}`), nil),
+		fixturesCase("no definition", propertiesFixtures(`{}`), nil),
+	},
+	"azurerm_iothub": {
+		allConfiguredCase("azurerm_iothub", childIds(
+			"/endpointsEventhub/eh1",
+			"/endpointsServicebusQueue/queue1",
+			"/endpointsServicebusTopic/topic1",
+			"/endpointsStorageContainer/container1",
+		)),
+		fixturesCase("no routing", propertiesFixtures(`{}`), nil),
+	},
+	"azurerm_netapp_account": {
+		allConfiguredCase("azurerm_netapp_account", childIds("/encryptions/enc1")),
+		fixturesCase("service managed key", propertiesFixtures(`{"encryption": {"keySource": "Microsoft.NetApp"}}`), nil),
+		fixturesCase("no encryption", propertiesFixtures(`{}`), nil),
+	},
+	"azurerm_lb": {
+		allConfiguredCase("azurerm_lb", childIds("/loadBalancingRules/rule1", "/probes/probe1")),
+		fixturesCase("no rule or probe", propertiesFixtures(`{}`), nil),
+	},
+	"azurerm_container_app_environment": {
+		allConfiguredCase("azurerm_container_app_environment", childIds("/customDomains/default")),
+		fixturesCase("no custom domain", propertiesFixtures(`{"customDomainConfiguration": {}}`), nil),
+	},
+	"azurerm_mssql_job": {
+		allConfiguredCase("azurerm_mssql_job", childIds("/schedules/default")),
+		fixturesCase("no schedule", propertiesFixtures(`{}`), nil),
+	},
+	"azurerm_stream_analytics_job": {
+		allConfiguredCase("azurerm_stream_analytics_job", childIds("/storageAccounts/account1")),
+		fixturesCase("no job storage account", propertiesFixtures(`{}`), nil),
+	},
+	"azurerm_linux_web_app":             appServiceSiteCases("app,linux", "azurerm_web_app_hybrid_connection", "azurerm_linux_web_app_slot"),
+	"azurerm_windows_web_app":           appServiceSiteCases("app", "azurerm_web_app_hybrid_connection", "azurerm_windows_web_app_slot"),
+	"azurerm_linux_function_app":        appServiceSiteCases("functionapp,linux", "azurerm_function_app_hybrid_connection", "azurerm_linux_function_app_slot"),
+	"azurerm_windows_function_app":      appServiceSiteCases("functionapp", "azurerm_function_app_hybrid_connection", "azurerm_windows_function_app_slot"),
+	"azurerm_logic_app_standard":        appServiceSiteCases("functionapp,workflowapp", "", ""),
+	"azurerm_linux_web_app_slot":        appServiceSiteSlotCases(),
+	"azurerm_windows_web_app_slot":      appServiceSiteSlotCases(),
+	"azurerm_linux_function_app_slot":   appServiceSiteSlotCases(),
+	"azurerm_windows_function_app_slot": appServiceSiteSlotCases(),
+	"azurerm_storage_account":           storageAccountCases(),
+	"azurerm_mssql_server": {
+		allConfiguredCase("azurerm_mssql_server", childIds("/encryptionProtector/current", "/extendedAuditingSettings/default")),
+		fixturesCase("service managed key and auditing disabled", func(id string) map[string]string {
+			return map[string]string{
+				testEndpoint + id + "/encryptionProtector/current":      `{"properties": {"serverKeyType": "ServiceManaged"}}`,
+				testEndpoint + id + "/extendedAuditingSettings/default": `{"properties": {"state": "Disabled"}}`,
+			}
+		}, nil),
+		fixturesCase("customer managed key only", func(id string) map[string]string {
+			return map[string]string{
+				testEndpoint + id + "/encryptionProtector/current":      `{"properties": {"serverKeyType": "AzureKeyVault"}}`,
+				testEndpoint + id + "/extendedAuditingSettings/default": `{"properties": {"state": "Disabled"}}`,
+			}
+		}, childIds("/encryptionProtector/current")),
+	},
+	"azurerm_mssql_database": {
+		allConfiguredCase("azurerm_mssql_database", childIds("/extendedAuditingSettings/default")),
+		fixturesCase("auditing disabled", func(id string) map[string]string {
+			return map[string]string{testEndpoint + id + "/extendedAuditingSettings/default": `{"properties": {"state": "Disabled"}}`}
+		}, nil),
+	},
+	"azurerm_postgresql_server":          postgresqlServerCases(childIds("/administrators/activeDirectory")),
+	"azurerm_postgresql_flexible_server": postgresqlServerCases(childIds("/administrators/00000000-0000-0000-0000-000000000000")),
+	"azurerm_private_endpoint": {
+		allConfiguredCase("azurerm_private_endpoint", childIds("/applicationSecurityGroups/"+b64(testNetwork+"/applicationSecurityGroups/asg1"))),
+		fixturesCase("no application security group", propertiesFixtures(`{}`), nil),
+	},
+	"azurerm_communication_service": {
+		allConfiguredCase("azurerm_communication_service", childIds("/linkedDomains/"+b64(testRgId+"/providers/Microsoft.Communication/emailServices/email1/domains/example.com"))),
+		fixturesCase("no linked domain", propertiesFixtures(`{"linkedDomains": []}`), nil),
+	},
+	"azurerm_app_configuration": {
+		allConfiguredCase("azurerm_app_configuration", childIds(
+			"/AppConfigurationKey/"+tfid.EncodeAppConfigurationName("key1")+"/Label/"+tfid.EncodeAppConfigurationName(""),
+			"/AppConfigurationFeature/"+tfid.EncodeAppConfigurationName("feature1")+"/Label/"+tfid.EncodeAppConfigurationName("label1"),
+		)),
+		fixturesCase("no key-value", func(id string) map[string]string {
+			return map[string]string{
+				testEndpoint + id:       `{"properties": {"endpoint": "https://store1.azconfig.io"}}`,
+				"store1.azconfig.io/kv": `{"items": []}`,
+			}
+		}, nil),
+		{
+			name: "key-values forbidden",
+			transport: func(id string) *clienttest.Transport {
+				return &clienttest.Transport{
+					Fixtures:    populaterFixtures["azurerm_app_configuration"](id),
+					StatusCodes: map[string]int{"store1.azconfig.io/kv": http.StatusForbidden},
+				}
+			},
+		},
+	},
+}
+
+// TestPopulaterResults runs each populater against its cases, to check the exact results.
+func TestPopulaterResults(t *testing.T) {
+	resmap.Init()
+	for _, rt := range ResourceTypes() {
+		rt := rt
+		t.Run(rt, func(t *testing.T) {
+			cases, ok := populaterCases[rt]
+			require.True(t, ok, "no case for the populater")
+			runPopulaterCases(t, rt, cases)
+		})
+	}
+}
+
+func TestPopulateExtensionsSkipped(t *testing.T) {
+	id := testNetwork + "/virtualNetworks/vnet1"
+	targetId := id + "/providers/Microsoft.Chaos/targets/Microsoft-VirtualNetwork"
+	extId := func(typ string) string {
+		return id + "/providers/" + typ + "/ext1"
+	}
+	fixtures := map[string]string{
+		testEndpoint + targetId[:strings.LastIndex(targetId, "/")]: `{"value": [{"id": "` + targetId + `"}]}`,
+		testEndpoint + targetId + "/capabilities":                  `{"value": [{"id": "` + targetId + `/capabilities/capability1"}]}`,
+	}
+	for _, ext := range extensions {
+		fixtures[testEndpoint+id+"/providers/"+ext.typ] = `{"value": [{"id": "` + extId(ext.typ) + `"}]}`
+	}
+
+	cases := []struct {
+		name        string
+		statusCodes map[string]int
+		expect      []string
+		err         string
+	}{
+		{
+			name: "listings not allowed",
+			statusCodes: map[string]int{
+				testEndpoint + id + "/providers/Microsoft.Authorization/locks":             http.StatusForbidden,
+				testEndpoint + id + "/providers/Microsoft.Authorization/policyAssignments": http.StatusUnauthorized,
+				testEndpoint + id + "/providers/Microsoft.Chaos/targets":                   http.StatusForbidden,
+			},
+			expect: []string{
+				extId("Microsoft.Insights/diagnosticSettings"),
+				extId("Microsoft.Authorization/roleAssignments"),
+				extId("Microsoft.EventGrid/eventSubscriptions"),
+				extId("Microsoft.Authorization/policyExemptions"),
+			},
+		},
+		{
+			name: "capabilities not allowed",
+			statusCodes: map[string]int{
+				testEndpoint + targetId + "/capabilities": http.StatusForbidden,
+			},
+			expect: []string{
+				extId("Microsoft.Insights/diagnosticSettings"),
+				extId("Microsoft.Authorization/locks"),
+				extId("Microsoft.Authorization/roleAssignments"),
+				extId("Microsoft.EventGrid/eventSubscriptions"),
+				extId("Microsoft.Authorization/policyAssignments"),
+				extId("Microsoft.Authorization/policyExemptions"),
+				targetId,
+			},
+		},
+		{
+			name: "listing failed",
+			statusCodes: map[string]int{
+				testEndpoint + id + "/providers/Microsoft.Authorization/locks": http.StatusInternalServerError,
+			},
+			err: "listing Microsoft.Authorization/locks",
+		},
+	}
+
+	azureId, err := armid.ParseResourceId(id)
+	require.NoError(t, err)
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := &clienttest.Transport{Fixtures: fixtures, StatusCodes: tt.statusCodes}
+			ids, err := PopulateExtensions(azureId, clienttest.NewClientBuilder(transport))
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			var actual []string
+			for _, id := range ids {
+				actual = append(actual, id.String())
+			}
+			require.Equal(t, tt.expect, actual)
+		})
+	}
+}
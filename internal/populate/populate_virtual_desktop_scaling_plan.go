@@ -0,0 +1,49 @@
+package populate
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/client"
+)
+
+func populateVirtualDesktopScalingPlan(b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
+	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
+	client, err := b.NewDesktopVirtualizationScalingPlansClient(resourceGroupId.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(b.Context(), resourceGroupId.Name, id.Names()[0], nil)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %q: %v", id, err)
+	}
+	props := resp.ScalingPlan.Properties
+	if props == nil {
+		return nil, nil
+	}
+	var hostPoolIds []string
+	for _, ref := range props.HostPoolReferences {
+		if ref == nil {
+			continue
+		}
+		if ref.HostPoolArmPath == nil {
+			continue
+		}
+		hostPoolIds = append(hostPoolIds, *ref.HostPoolArmPath)
+	}
+
+	var result []armid.ResourceId
+	for _, hostPoolId := range hostPoolIds {
+		hostPoolAzureId, err := armid.ParseResourceId(hostPoolId)
+		if err != nil {
+			return nil, fmt.Errorf("parsing resource id %q: %v", hostPoolId, err)
+		}
+		azureId := id.Clone().(*armid.ScopedResourceId)
+		azureId.AttrTypes = append(azureId.AttrTypes, "hostPools")
+		azureId.AttrNames = append(azureId.AttrNames, base64.StdEncoding.EncodeToString([]byte(hostPoolAzureId.String())))
+
+		result = append(result, azureId)
+	}
+	return result, nil
+}
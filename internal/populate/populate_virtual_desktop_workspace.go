@@ -1,7 +1,6 @@
 package populate
 
 import (
-	"context"
 	"encoding/base64"
 	"fmt"
 
@@ -15,7 +14,7 @@ func populateVirtualDesktopWorkspace(b *client.ClientBuilder, id armid.ResourceI
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.Get(b.Context(), resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %q: %v", id, err)
 	}
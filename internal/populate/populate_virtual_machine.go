@@ -1,9 +1,10 @@
 package populate
 
 import (
-	"context"
+	"encoding/base64"
 	"fmt"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/magodo/armid"
 	"github.com/magodo/aztft/internal/client"
 )
@@ -14,7 +15,7 @@ func populateVirtualMachine(b *client.ClientBuilder, id armid.ResourceId) ([]arm
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.Get(b.Context(), resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %q: %v", id, err)
 	}
@@ -22,6 +23,24 @@ func populateVirtualMachine(b *client.ClientBuilder, id armid.ResourceId) ([]arm
 	if props == nil {
 		return nil, nil
 	}
+
+	dataDisks, err := virtualMachinePopulateDataDisks(id, props)
+	if err != nil {
+		return nil, fmt.Errorf("populating for data disks: %v", err)
+	}
+	galleryApplications, err := virtualMachinePopulateGalleryApplications(id, props)
+	if err != nil {
+		return nil, fmt.Errorf("populating for gallery applications: %v", err)
+	}
+
+	var result []armid.ResourceId
+	result = append(result, dataDisks...)
+	result = append(result, galleryApplications...)
+
+	return result, nil
+}
+
+func virtualMachinePopulateDataDisks(id armid.ResourceId, props *armcompute.VirtualMachineProperties) ([]armid.ResourceId, error) {
 	storageProfile := props.StorageProfile
 	if storageProfile == nil {
 		return nil, nil
@@ -58,3 +77,30 @@ func populateVirtualMachine(b *client.ClientBuilder, id armid.ResourceId) ([]arm
 	}
 	return result, nil
 }
+
+func virtualMachinePopulateGalleryApplications(id armid.ResourceId, props *armcompute.VirtualMachineProperties) ([]armid.ResourceId, error) {
+	applicationProfile := props.ApplicationProfile
+	if applicationProfile == nil {
+		return nil, nil
+	}
+
+	var result []armid.ResourceId
+	for _, app := range applicationProfile.GalleryApplications {
+		if app == nil {
+			continue
+		}
+		if app.PackageReferenceID == nil {
+			continue
+		}
+		versionId, err := armid.ParseResourceId(*app.PackageReferenceID)
+		if err != nil {
+			return nil, fmt.Errorf("parsing resource id %q: %v", *app.PackageReferenceID, err)
+		}
+		azureId := id.Clone().(*armid.ScopedResourceId)
+		azureId.AttrTypes = append(azureId.AttrTypes, "galleryApplications")
+		azureId.AttrNames = append(azureId.AttrNames, base64.StdEncoding.EncodeToString([]byte(versionId.String())))
+
+		result = append(result, azureId)
+	}
+	return result, nil
+}
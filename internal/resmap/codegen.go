@@ -0,0 +1,96 @@
+package resmap
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"sort"
+)
+
+// GenerateSource generates the Go source of the mapping_gen.go from the content of the map.json, which builds both the TF2ARMIdMap and
+// the ARMId2TFMap (keyed by the upper cased routing scope keys) as Go literals, so that Init doesn't need to unmarshal and index the JSON.
+func GenerateSource(mappingJSON []byte) ([]byte, error) {
+	var m TF2ARMIdMapType
+	if err := json.Unmarshal(mappingJSON, &m); err != nil {
+		return nil, fmt.Errorf("unmarshalling the mapping: %v", err)
+	}
+	armMap, err := m.toARM2TFMap()
+	if err != nil {
+		return nil, fmt.Errorf("building the ARM id to TF map: %v", err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by tool/aztft-map-codegen from map.json. DO NOT EDIT.\n\n")
+	buf.WriteString("package resmap\n\n")
+
+	buf.WriteString("func genTF2ARMIdMap() TF2ARMIdMapType {\n")
+	buf.WriteString("return TF2ARMIdMapType{\n")
+	for _, rt := range sortedMapKeys(m) {
+		item := m[rt]
+		fmt.Fprintf(&buf, "%q: {\n", rt)
+		if item.IsRemoved {
+			buf.WriteString("IsRemoved: true,\n")
+		}
+		if item.RemoveReason != "" {
+			fmt.Fprintf(&buf, "RemoveReason: %q,\n", item.RemoveReason)
+		}
+		if mp := item.ManagementPlane; mp != nil {
+			buf.WriteString("ManagementPlane: &MapManagementPlane{\n")
+			writeStringSliceField(&buf, "ParentScopes", mp.ParentScopes)
+			fmt.Fprintf(&buf, "Provider: %q,\n", mp.Provider)
+			writeStringSliceField(&buf, "Types", mp.Types)
+			writeStringSliceField(&buf, "ImportSpecs", mp.ImportSpecs)
+			buf.WriteString("},\n")
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n}\n\n")
+
+	buf.WriteString("func genARMId2TFMap() ARMId2TFMapType {\n")
+	buf.WriteString("return ARMId2TFMapType{\n")
+	for _, k1 := range sortedMapKeys(armMap) {
+		b := armMap[k1]
+		fmt.Fprintf(&buf, "%q: {\n", k1)
+		for _, k2 := range sortedMapKeys(b) {
+			items := b[k2]
+			sort.Slice(items, func(i, j int) bool { return items[i].ResourceType < items[j].ResourceType })
+			fmt.Fprintf(&buf, "%q: {\n", k2)
+			for _, item := range items {
+				if item.ImportSpec == "" {
+					fmt.Fprintf(&buf, "{ResourceType: %q},\n", item.ResourceType)
+					continue
+				}
+				fmt.Fprintf(&buf, "{ResourceType: %q, ImportSpec: %q},\n", item.ResourceType, item.ImportSpec)
+			}
+			buf.WriteString("},\n")
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n}\n")
+
+	return format.Source(buf.Bytes())
+}
+
+func writeStringSliceField(buf *bytes.Buffer, name string, l []string) {
+	if l == nil {
+		return
+	}
+	fmt.Fprintf(buf, "%s: []string{", name)
+	for i, s := range l {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(buf, "%q", s)
+	}
+	buf.WriteString("},\n")
+}
+
+func sortedMapKeys[T any](m map[string]T) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
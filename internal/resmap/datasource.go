@@ -0,0 +1,137 @@
+package resmap
+
+// DataSourceArgSource is where the value of a data source argument comes from.
+type DataSourceArgSource int
+
+const (
+	// DataSourceArgFromName takes the name at the DataSourceArg.Index of the names of the resource id (i.e. armid.ResourceId.Names()).
+	DataSourceArgFromName DataSourceArgSource = iota
+	// DataSourceArgFromResourceGroup takes the name of the resource group that the resource belongs to.
+	DataSourceArgFromResourceGroup
+	// DataSourceArgFromParentId takes the TF resource id of the ancestor resource, whose TF resource type is DataSourceArg.ParentType,
+	// and whose resource id has the first DataSourceArg.Index names of the resource id.
+	DataSourceArgFromParentId
+)
+
+// DataSourceArg is a required argument of the data source.
+type DataSourceArg struct {
+	Name       string
+	From       DataSourceArgSource
+	Index      int
+	ParentType string
+}
+
+// DataSourceMapItem is the data source that reads the same Azure resource as the TF resource type.
+type DataSourceMapItem struct {
+	DataSourceType string
+	Args           []DataSourceArg
+}
+
+func nameArg(name string, idx int) DataSourceArg {
+	return DataSourceArg{Name: name, From: DataSourceArgFromName, Index: idx}
+}
+
+func resourceGroupArg() DataSourceArg {
+	return DataSourceArg{Name: "resource_group_name", From: DataSourceArgFromResourceGroup}
+}
+
+func parentIdArg(name string, idx int, rt string) DataSourceArg {
+	return DataSourceArg{Name: name, From: DataSourceArgFromParentId, Index: idx, ParentType: rt}
+}
+
+// resourceGroupDataSource is the data source of the most common form, which only requires the name and the resource group name.
+func resourceGroupDataSource(dt string) DataSourceMapItem {
+	return DataSourceMapItem{
+		DataSourceType: dt,
+		Args:           []DataSourceArg{nameArg("name", 0), resourceGroupArg()},
+	}
+}
+
+// TF2DataSourceMap maps from the TF resource type to the data source that reads the same Azure resource. It is hand-maintained,
+// as the data sources are not always named after the resource types (e.g. both the Linux and Windows VMs are read by "azurerm_virtual_machine"),
+// and the arguments that refer to the parent resources are either names or ids.
+var TF2DataSourceMap = map[string]DataSourceMapItem{
+	"azurerm_resource_group": {
+		DataSourceType: "azurerm_resource_group",
+		Args:           []DataSourceArg{{Name: "name", From: DataSourceArgFromResourceGroup}},
+	},
+	"azurerm_management_group": {
+		DataSourceType: "azurerm_management_group",
+		Args:           []DataSourceArg{nameArg("name", 0)},
+	},
+
+	"azurerm_api_management":             resourceGroupDataSource("azurerm_api_management"),
+	"azurerm_app_configuration":          resourceGroupDataSource("azurerm_app_configuration"),
+	"azurerm_application_insights":       resourceGroupDataSource("azurerm_application_insights"),
+	"azurerm_application_security_group": resourceGroupDataSource("azurerm_application_security_group"),
+	"azurerm_container_app":              resourceGroupDataSource("azurerm_container_app"),
+	"azurerm_container_app_environment":  resourceGroupDataSource("azurerm_container_app_environment"),
+	"azurerm_container_registry":         resourceGroupDataSource("azurerm_container_registry"),
+	"azurerm_cosmosdb_account":           resourceGroupDataSource("azurerm_cosmosdb_account"),
+	"azurerm_dns_zone":                   resourceGroupDataSource("azurerm_dns_zone"),
+	"azurerm_eventhub_namespace":         resourceGroupDataSource("azurerm_eventhub_namespace"),
+	"azurerm_firewall":                   resourceGroupDataSource("azurerm_firewall"),
+	"azurerm_key_vault":                  resourceGroupDataSource("azurerm_key_vault"),
+	"azurerm_kubernetes_cluster":         resourceGroupDataSource("azurerm_kubernetes_cluster"),
+	"azurerm_lb":                         resourceGroupDataSource("azurerm_lb"),
+	"azurerm_linux_function_app":         resourceGroupDataSource("azurerm_linux_function_app"),
+	"azurerm_linux_virtual_machine":      resourceGroupDataSource("azurerm_virtual_machine"),
+	"azurerm_linux_web_app":              resourceGroupDataSource("azurerm_linux_web_app"),
+	"azurerm_log_analytics_workspace":    resourceGroupDataSource("azurerm_log_analytics_workspace"),
+	"azurerm_managed_disk":               resourceGroupDataSource("azurerm_managed_disk"),
+	"azurerm_mssql_server":               resourceGroupDataSource("azurerm_mssql_server"),
+	"azurerm_nat_gateway":                resourceGroupDataSource("azurerm_nat_gateway"),
+	"azurerm_network_interface":          resourceGroupDataSource("azurerm_network_interface"),
+	"azurerm_network_security_group":     resourceGroupDataSource("azurerm_network_security_group"),
+	"azurerm_postgresql_flexible_server": resourceGroupDataSource("azurerm_postgresql_flexible_server"),
+	"azurerm_private_dns_zone":           resourceGroupDataSource("azurerm_private_dns_zone"),
+	"azurerm_public_ip":                  resourceGroupDataSource("azurerm_public_ip"),
+	"azurerm_redis_cache":                resourceGroupDataSource("azurerm_redis_cache"),
+	"azurerm_route_table":                resourceGroupDataSource("azurerm_route_table"),
+	"azurerm_service_plan":               resourceGroupDataSource("azurerm_service_plan"),
+	"azurerm_servicebus_namespace":       resourceGroupDataSource("azurerm_servicebus_namespace"),
+	"azurerm_storage_account":            resourceGroupDataSource("azurerm_storage_account"),
+	"azurerm_user_assigned_identity":     resourceGroupDataSource("azurerm_user_assigned_identity"),
+	"azurerm_virtual_network":            resourceGroupDataSource("azurerm_virtual_network"),
+	"azurerm_virtual_network_gateway":    resourceGroupDataSource("azurerm_virtual_network_gateway"),
+	"azurerm_windows_function_app":       resourceGroupDataSource("azurerm_windows_function_app"),
+	"azurerm_windows_virtual_machine":    resourceGroupDataSource("azurerm_virtual_machine"),
+	"azurerm_windows_web_app":            resourceGroupDataSource("azurerm_windows_web_app"),
+
+	"azurerm_cosmosdb_sql_database": {
+		DataSourceType: "azurerm_cosmosdb_sql_database",
+		Args:           []DataSourceArg{nameArg("name", 1), resourceGroupArg(), nameArg("account_name", 0)},
+	},
+	"azurerm_eventhub": {
+		DataSourceType: "azurerm_eventhub",
+		Args:           []DataSourceArg{nameArg("name", 1), resourceGroupArg(), nameArg("namespace_name", 0)},
+	},
+	"azurerm_key_vault_key": {
+		DataSourceType: "azurerm_key_vault_key",
+		Args:           []DataSourceArg{nameArg("name", 1), parentIdArg("key_vault_id", 1, "azurerm_key_vault")},
+	},
+	"azurerm_key_vault_secret": {
+		DataSourceType: "azurerm_key_vault_secret",
+		Args:           []DataSourceArg{nameArg("name", 1), parentIdArg("key_vault_id", 1, "azurerm_key_vault")},
+	},
+	"azurerm_mssql_database": {
+		DataSourceType: "azurerm_mssql_database",
+		Args:           []DataSourceArg{nameArg("name", 1), parentIdArg("server_id", 1, "azurerm_mssql_server")},
+	},
+	"azurerm_servicebus_queue": {
+		DataSourceType: "azurerm_servicebus_queue",
+		Args:           []DataSourceArg{nameArg("name", 1), parentIdArg("namespace_id", 1, "azurerm_servicebus_namespace")},
+	},
+	"azurerm_servicebus_topic": {
+		DataSourceType: "azurerm_servicebus_topic",
+		Args:           []DataSourceArg{nameArg("name", 1), parentIdArg("namespace_id", 1, "azurerm_servicebus_namespace")},
+	},
+	"azurerm_storage_container": {
+		DataSourceType: "azurerm_storage_container",
+		Args:           []DataSourceArg{nameArg("name", 2), nameArg("storage_account_name", 0)},
+	},
+	"azurerm_subnet": {
+		DataSourceType: "azurerm_subnet",
+		Args:           []DataSourceArg{nameArg("name", 1), resourceGroupArg(), nameArg("virtual_network_name", 0)},
+	},
+}
@@ -0,0 +1,27 @@
+package resmap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTF2DataSourceMap(t *testing.T) {
+	Init()
+	for rt, item := range TF2DataSourceMap {
+		mapItem, ok := TF2ARMIdMap[rt]
+		require.True(t, ok, "%s is not in the mapping", rt)
+		require.NotNil(t, mapItem.ManagementPlane, rt)
+		nameCount := len(mapItem.ManagementPlane.Types)
+		for _, arg := range item.Args {
+			switch arg.From {
+			case DataSourceArgFromName:
+				require.Less(t, arg.Index, nameCount, "%s: the name index of %s is out of range", rt, arg.Name)
+			case DataSourceArgFromParentId:
+				require.Less(t, arg.Index, nameCount, "%s: the parent of %s is not an ancestor", rt, arg.Name)
+				_, ok := TF2ARMIdMap[arg.ParentType]
+				require.True(t, ok, "%s: the parent type %s of %s is not in the mapping", rt, arg.ParentType, arg.Name)
+			}
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package resmap
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/magodo/aztft/internal/loadonce"
+)
+
+// ExtensionItem is an entry of the extension mapping file, which is the same as the TF2ARMIdMapItem, except it can opt in to overlap with the existing resource types.
+type ExtensionItem struct {
+	TF2ARMIdMapItem
+
+	// AllowOverlap allows the resource type to share the routing scope and parent scope with the existing resource types, in which case the queries
+	// on that resource id return all of them (e.g. a private provider fork that manages the same Azure resource in a different way).
+	AllowOverlap bool `json:"allow_overlap,omitempty"`
+}
+
+var (
+	// extensionFiles records the loaded extension files, whose loads update the extensionTypes and the mapping.
+	extensionFiles loadonce.Files[struct{}]
+	// extensionTypes records the extension file that defines each of the extension resource types.
+	extensionTypes = map[string]string{}
+)
+
+// LoadExtensionFile loads the extra mapping entries (e.g. for the resources of a private provider fork) from the file, which has the same format as the map.json,
+// and adds them to the TF2ARMIdMap and ARMId2TFMap. Loading a file again returns the error of its first load, without adding anything.
+// The maps are locked for writing while adding, so the readers that can run concurrently shall read them via RLock.
+func LoadExtensionFile(path string) error {
+	Init()
+
+	_, err := extensionFiles.Load(path, func(path string) (struct{}, error) {
+		return struct{}{}, loadExtensionFile(path)
+	})
+	return err
+}
+
+func loadExtensionFile(path string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading mapping file %s: %v", path, err)
+	}
+	var m map[string]ExtensionItem
+	if err := json.Unmarshal(b, &m); err != nil {
+		return fmt.Errorf("unmarshalling mapping file %s: %v", path, err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if err := addExtension(path, m); err != nil {
+		return fmt.Errorf("loading mapping file %s: %v", path, err)
+	}
+	return nil
+}
+
+// addExtension validates the extension mapping from the file, and adds it to the mapping. Nothing is added if any entry is invalid, collides with the existing resource types,
+// or overlaps with the routing scope of the existing resource types without opting in.
+func addExtension(path string, m map[string]ExtensionItem) error {
+	var errs []string
+	tfMap := TF2ARMIdMapType{}
+	for rt, item := range m {
+		if p, ok := extensionTypes[rt]; ok {
+			errs = append(errs, fmt.Sprintf("%s: already defined in mapping file %s", rt, p))
+			continue
+		}
+		if _, ok := TF2ARMIdMap[rt]; ok {
+			errs = append(errs, fmt.Sprintf("%s: collides with the built-in resource type", rt))
+			continue
+		}
+		if err := item.validate(); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", rt, err))
+			continue
+		}
+		tfMap[rt] = item.TF2ARMIdMapItem
+	}
+	if len(errs) != 0 {
+		sort.Strings(errs)
+		return fmt.Errorf("invalid entries:\n%s", strings.Join(errs, "\n"))
+	}
+
+	armMap, err := tfMap.toARM2TFMap()
+	if err != nil {
+		return err
+	}
+	for k1, b := range armMap {
+		for k2, items := range b {
+			for i, item := range items {
+				if m[item.ResourceType].AllowOverlap {
+					continue
+				}
+				var overlaps []string
+				for _, other := range overlappedItems(k1, k2) {
+					overlaps = append(overlaps, describeType(other.ResourceType))
+				}
+				for j, other := range items {
+					if j != i {
+						overlaps = append(overlaps, other.ResourceType)
+					}
+				}
+				if len(overlaps) != 0 {
+					sort.Strings(overlaps)
+					errs = append(errs, fmt.Sprintf("%s: the routing scope %s under the scope %s overlaps with %s (set allow_overlap to opt in)", item.ResourceType, k1, k2, strings.Join(overlaps, ", ")))
+				}
+			}
+		}
+	}
+	if len(errs) != 0 {
+		sort.Strings(errs)
+		return fmt.Errorf("invalid entries:\n%s", strings.Join(errs, "\n"))
+	}
+
+	for rt, item := range tfMap {
+		TF2ARMIdMap[rt] = item
+		extensionTypes[rt] = path
+	}
+	for k1, b := range armMap {
+		if _, ok := ARMId2TFMap[k1]; !ok {
+			ARMId2TFMap[k1] = map[string][]ARMId2TFMapItem{}
+		}
+		for k2, items := range b {
+			ARMId2TFMap[k1][k2] = append(ARMId2TFMap[k1][k2], items...)
+		}
+	}
+	return nil
+}
+
+// overlappedItems returns the existing items that are matched by the resource ids of the routing scope (k1) under the parent scope (k2).
+// The items under a specific scope also shadow the ones under any scope.
+func overlappedItems(k1, k2 string) []ARMId2TFMapItem {
+	b, ok := ARMId2TFMap[k1]
+	if !ok {
+		return nil
+	}
+	out := append([]ARMId2TFMapItem{}, b[k2]...)
+	if anyKey := strings.ToUpper(ScopeAny); k2 != anyKey {
+		out = append(out, b[anyKey]...)
+	}
+	return out
+}
+
+func describeType(rt string) string {
+	if p, ok := extensionTypes[rt]; ok {
+		return fmt.Sprintf("%s (from mapping file %s)", rt, p)
+	}
+	return fmt.Sprintf("%s (built-in)", rt)
+}
+
+func (item TF2ARMIdMapItem) validate() error {
+	mm := item.ManagementPlane
+	if mm == nil {
+		return fmt.Errorf("missing management_plane")
+	}
+	if mm.Provider == "" {
+		return fmt.Errorf("missing management_plane.provider")
+	}
+	if len(mm.Types) == 0 {
+		return fmt.Errorf("missing management_plane.types")
+	}
+	for _, scope := range mm.ParentScopes {
+		if scope != ScopeAny && !strings.HasPrefix(scope, "/") {
+			return fmt.Errorf("invalid scope %q: must be %q or start with %q", scope, ScopeAny, "/")
+		}
+	}
+	for _, spec := range mm.ImportSpecs {
+		if !strings.HasPrefix(spec, "/") {
+			return fmt.Errorf("invalid import spec %q: must start with %q", spec, "/")
+		}
+	}
+	// The root scope resource id has no parent scope, but may still have an import spec.
+	if len(mm.ImportSpecs) != 0 && len(mm.ParentScopes) != 0 && len(mm.ImportSpecs) != len(mm.ParentScopes) {
+		return fmt.Errorf("the number of import_specs (%d) doesn't match the number of scopes (%d)", len(mm.ImportSpecs), len(mm.ParentScopes))
+	}
+	return nil
+}
@@ -0,0 +1,13048 @@
+// Code generated by tool/aztft-map-codegen from map.json. DO NOT EDIT.
+
+package resmap
+
+func genTF2ARMIdMap() TF2ARMIdMapType {
+	return TF2ARMIdMapType{
+		"azurerm_aadb2c_directory": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AzureActiveDirectory",
+				Types:        []string{"b2cDirectories"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AzureActiveDirectory/b2cDirectories"},
+			},
+		},
+		"azurerm_active_directory_domain_service": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AAD",
+				Types:        []string{"domainServices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AAD/domainServices/initialReplicaSetId"},
+			},
+		},
+		"azurerm_active_directory_domain_service_replica_set": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AAD",
+				Types:        []string{"domainServices", "replicaSets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AAD/domainServices/replicaSets"},
+			},
+		},
+		"azurerm_active_directory_domain_service_trust": {
+			IsRemoved:    true,
+			RemoveReason: "This is a property rather than a resource",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AAD",
+				Types:        []string{"domainServices", "trusts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AAD/domainServices/trusts"},
+			},
+		},
+		"azurerm_advanced_threat_protection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"},
+				Provider:     "Microsoft.Security",
+				Types:        []string{"advancedThreatProtectionSettings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/Microsoft.Security/advancedThreatProtectionSettings"},
+			},
+		},
+		"azurerm_advisor_suppression": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions"},
+				Provider:     "Microsoft.Advisor",
+				Types:        []string{"recommendations", "suppressions"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.Advisor/recommendations/suppressions"},
+			},
+		},
+		"azurerm_ai_foundry": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MachineLearningServices",
+				Types:        []string{"workspaces"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces"},
+			},
+		},
+		"azurerm_ai_foundry_project": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MachineLearningServices",
+				Types:        []string{"workspaces"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces"},
+			},
+		},
+		"azurerm_ai_services": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.CognitiveServices",
+				Types:        []string{"accounts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts"},
+			},
+		},
+		"azurerm_analysis_services_server": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AnalysisServices",
+				Types:        []string{"servers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AnalysisServices/servers"},
+			},
+		},
+		"azurerm_api_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"connections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/connections"},
+			},
+		},
+		"azurerm_api_management": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service"},
+			},
+		},
+		"azurerm_api_management_api": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "apis"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis"},
+			},
+		},
+		"azurerm_api_management_api_diagnostic": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "apis", "diagnostics"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/diagnostics"},
+			},
+		},
+		"azurerm_api_management_api_operation": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "apis", "operations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/operations"},
+			},
+		},
+		"azurerm_api_management_api_operation_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "apis", "operations", "policies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/operations"},
+			},
+		},
+		"azurerm_api_management_api_operation_tag": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "apis", "operations", "tags"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/operations/tags"},
+			},
+		},
+		"azurerm_api_management_api_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "apis", "policies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis"},
+			},
+		},
+		"azurerm_api_management_api_release": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "apis", "releases"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/releases"},
+			},
+		},
+		"azurerm_api_management_api_schema": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "apis", "schemas"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/schemas"},
+			},
+		},
+		"azurerm_api_management_api_tag": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "apis", "tags"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/tags"},
+			},
+		},
+		"azurerm_api_management_api_tag_description": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "apis", "tagDescriptions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/tagDescriptions"},
+			},
+		},
+		"azurerm_api_management_api_version_set": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "apiVersionSets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apiVersionSets"},
+			},
+		},
+		"azurerm_api_management_authorization_server": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "authorizationServers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/authorizationServers"},
+			},
+		},
+		"azurerm_api_management_backend": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "backends"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/backends"},
+			},
+		},
+		"azurerm_api_management_certificate": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "certificates"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/certificates"},
+			},
+		},
+		"azurerm_api_management_custom_domain": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "customDomains"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/customDomains"},
+			},
+		},
+		"azurerm_api_management_diagnostic": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "diagnostics"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/diagnostics"},
+			},
+		},
+		"azurerm_api_management_email_template": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "templates"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/templates"},
+			},
+		},
+		"azurerm_api_management_gateway": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "gateways"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/gateways"},
+			},
+		},
+		"azurerm_api_management_gateway_api": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "gateways", "apis"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/gateways/apis"},
+			},
+		},
+		"azurerm_api_management_gateway_certificate_authority": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "gateways", "certificateAuthorities"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/gateways/certificateAuthorities"},
+			},
+		},
+		"azurerm_api_management_gateway_host_name_configuration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "gateways", "hostnameConfigurations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/gateways/hostnameConfigurations"},
+			},
+		},
+		"azurerm_api_management_global_schema": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "schemas"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/schemas"},
+			},
+		},
+		"azurerm_api_management_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "groups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/groups"},
+			},
+		},
+		"azurerm_api_management_group_user": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "groups", "users"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/groups/users"},
+			},
+		},
+		"azurerm_api_management_identity_provider_aad": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "identityProviders"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"},
+			},
+		},
+		"azurerm_api_management_identity_provider_aadb2c": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "identityProviders"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"},
+			},
+		},
+		"azurerm_api_management_identity_provider_facebook": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "identityProviders"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"},
+			},
+		},
+		"azurerm_api_management_identity_provider_google": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "identityProviders"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"},
+			},
+		},
+		"azurerm_api_management_identity_provider_microsoft": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "identityProviders"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"},
+			},
+		},
+		"azurerm_api_management_identity_provider_twitter": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "identityProviders"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"},
+			},
+		},
+		"azurerm_api_management_logger": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "loggers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/loggers"},
+			},
+		},
+		"azurerm_api_management_named_value": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "namedValues"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/namedValues"},
+			},
+		},
+		"azurerm_api_management_notification_recipient_email": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "notifications", "recipientEmails"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/notifications/recipientEmails"},
+			},
+		},
+		"azurerm_api_management_notification_recipient_user": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "notifications", "recipientUsers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/notifications/recipientUsers"},
+			},
+		},
+		"azurerm_api_management_openid_connect_provider": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "openidConnectProviders"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/openidConnectProviders"},
+			},
+		},
+		"azurerm_api_management_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "policies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service"},
+			},
+		},
+		"azurerm_api_management_policy_fragment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "policyFragments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/policyFragments"},
+			},
+		},
+		"azurerm_api_management_product": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "products"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/products"},
+			},
+		},
+		"azurerm_api_management_product_api": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "products", "apis"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/products/apis"},
+			},
+		},
+		"azurerm_api_management_product_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "products", "groups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/products/groups"},
+			},
+		},
+		"azurerm_api_management_product_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "products", "policies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/products"},
+			},
+		},
+		"azurerm_api_management_product_tag": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "products", "tags"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/products/tags"},
+			},
+		},
+		"azurerm_api_management_redis_cache": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "caches"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/caches"},
+			},
+		},
+		"azurerm_api_management_subscription": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "subscriptions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/subscriptions"},
+			},
+		},
+		"azurerm_api_management_tag": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "tags"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/tags"},
+			},
+		},
+		"azurerm_api_management_user": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ApiManagement",
+				Types:        []string{"service", "users"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/users"},
+			},
+		},
+		"azurerm_app_configuration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppConfiguration",
+				Types:        []string{"configurationStores"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppConfiguration/configurationStores"},
+			},
+		},
+		"azurerm_app_configuration_feature": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppConfiguration",
+				Types:        []string{"configurationStores", "AppConfigurationFeature", "Label"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppConfiguration/configurationStores/AppConfigurationFeature/Label"},
+			},
+		},
+		"azurerm_app_configuration_key": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppConfiguration",
+				Types:        []string{"configurationStores", "AppConfigurationKey", "Label"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppConfiguration/configurationStores/AppConfigurationKey/Label"},
+			},
+		},
+		"azurerm_app_service": {
+			IsRemoved:    true,
+			RemoveReason: "This is deprecated in favor of `azurerm_linux_web_app` and `azurerm_windows_web_app`",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"},
+			},
+		},
+		"azurerm_app_service_certificate": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"certificates"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/certificates"},
+			},
+		},
+		"azurerm_app_service_certificate_binding": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites", "hostNameBindings", "certificates"},
+			},
+		},
+		"azurerm_app_service_certificate_order": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.CertificateRegistration",
+				Types:        []string{"certificateOrders"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.CertificateRegistration/certificateOrders"},
+			},
+		},
+		"azurerm_app_service_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"},
+				Provider:     "Microsoft.ServiceLinker",
+				Types:        []string{"linkers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/Microsoft.ServiceLinker/linkers"},
+			},
+		},
+		"azurerm_app_service_custom_hostname_binding": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites", "hostNameBindings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/hostNameBindings"},
+			},
+		},
+		"azurerm_app_service_environment_v3": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"hostingEnvironments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/hostingEnvironments"},
+			},
+		},
+		"azurerm_app_service_hybrid_connection": {
+			IsRemoved:    true,
+			RemoveReason: "This is deprecated in favor of `azurerm_function_app_hybrid_connection` and `azurerm_web_app_hybrid_connection`",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites", "hybridConnectionNamespaces", "relays"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/hybridConnectionNamespaces/relays"},
+			},
+		},
+		"azurerm_app_service_managed_certificate": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"certificates"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/certificates"},
+			},
+		},
+		"azurerm_app_service_plan": {
+			IsRemoved:    true,
+			RemoveReason: "This is deprecated in favor of `azurerm_service_plan`",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"serverFarms"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/serverFarms"},
+			},
+		},
+		"azurerm_app_service_public_certificate": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites", "publicCertificates"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/publicCertificates"},
+			},
+		},
+		"azurerm_app_service_slot": {
+			IsRemoved:    true,
+			RemoveReason: "This is deprecated in favor of `azurerm_linux_web_app_slot` and `azurerm_windows_web_app_slot`",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites", "slots"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/slots"},
+			},
+		},
+		"azurerm_app_service_slot_custom_hostname_binding": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites", "slots", "hostNameBindings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/slots/hostNameBindings"},
+			},
+		},
+		"azurerm_app_service_slot_virtual_network_swift_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites", "slots", "networkConfig"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/slots/config"},
+			},
+		},
+		"azurerm_app_service_source_control": {
+			IsRemoved:    true,
+			RemoveReason: "This is a property rather than a resource",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"},
+			},
+		},
+		"azurerm_app_service_source_control_slot": {
+			IsRemoved:    true,
+			RemoveReason: "This is a property rather than a resource",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites", "slots"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/slots"},
+			},
+		},
+		"azurerm_app_service_virtual_network_swift_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites", "networkConfig"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/config"},
+			},
+		},
+		"azurerm_application_gateway": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"applicationGateways"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/applicationGateways"},
+			},
+		},
+		"azurerm_application_insights": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Insights",
+				Types:        []string{"components"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Insights/components"},
+			},
+		},
+		"azurerm_application_insights_analytics_item": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Insights",
+				Types:        []string{"components", "analyticsItems"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Insights/components/analyticsItems"},
+			},
+		},
+		"azurerm_application_insights_api_key": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Insights",
+				Types:        []string{"components", "apiKeys"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Insights/components/apiKeys"},
+			},
+		},
+		"azurerm_application_insights_smart_detection_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Insights",
+				Types:        []string{"components", "proactiveDetectionConfigs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Insights/components/proactiveDetectionConfigs"},
+			},
+		},
+		"azurerm_application_insights_standard_web_test": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Insights",
+				Types:        []string{"webTests"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Insights/webTests"},
+			},
+		},
+		"azurerm_application_insights_web_test": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Insights",
+				Types:        []string{"webTests"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Insights/webTests"},
+			},
+		},
+		"azurerm_application_insights_workbook": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Insights",
+				Types:        []string{"workbooks"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Insights/workbooks"},
+			},
+		},
+		"azurerm_application_insights_workbook_template": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Insights",
+				Types:        []string{"workbookTemplates"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Insights/workbookTemplates"},
+			},
+		},
+		"azurerm_application_load_balancer": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ServiceNetworking",
+				Types:        []string{"trafficControllers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ServiceNetworking/trafficControllers"},
+			},
+		},
+		"azurerm_application_load_balancer_frontend": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ServiceNetworking",
+				Types:        []string{"trafficControllers", "frontends"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ServiceNetworking/trafficControllers/frontends"},
+			},
+		},
+		"azurerm_application_load_balancer_subnet_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ServiceNetworking",
+				Types:        []string{"trafficControllers", "associations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ServiceNetworking/trafficControllers/associations"},
+			},
+		},
+		"azurerm_application_security_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"applicationSecurityGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/applicationSecurityGroups"},
+			},
+		},
+		"azurerm_arc_kubernetes_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Kubernetes",
+				Types:        []string{"connectedClusters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Kubernetes/connectedClusters"},
+			},
+		},
+		"azurerm_arc_kubernetes_cluster_extension": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Kubernetes/connectedClusters"},
+				Provider:     "Microsoft.KubernetesConfiguration",
+				Types:        []string{"extensions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Kubernetes/connectedClusters/Microsoft.KubernetesConfiguration/extensions"},
+			},
+		},
+		"azurerm_arc_kubernetes_flux_configuration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Kubernetes/connectedClusters"},
+				Provider:     "Microsoft.KubernetesConfiguration",
+				Types:        []string{"fluxConfigurations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Kubernetes/connectedClusters/Microsoft.KubernetesConfiguration/fluxConfigurations"},
+			},
+		},
+		"azurerm_arc_kubernetes_provisioned_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Kubernetes",
+				Types:        []string{"connectedClusters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Kubernetes/connectedClusters"},
+			},
+		},
+		"azurerm_arc_machine": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.HybridCompute",
+				Types:        []string{"machines"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.HybridCompute/machines"},
+			},
+		},
+		"azurerm_arc_machine_automanage_configuration_assignment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.HybridCompute/machines"},
+				Provider:     "Microsoft.AutoManage",
+				Types:        []string{"configurationProfileAssignments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.HybridCompute/machines/Microsoft.AutoManage/configurationProfileAssignments"},
+			},
+		},
+		"azurerm_arc_machine_extension": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.HybridCompute",
+				Types:        []string{"machines", "extensions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.HybridCompute/machines/extensions"},
+			},
+		},
+		"azurerm_arc_private_link_scope": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.HybridCompute",
+				Types:        []string{"privateLinkScopes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.HybridCompute/privateLinkScopes"},
+			},
+		},
+		"azurerm_arc_resource_bridge_appliance": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ResourceConnector",
+				Types:        []string{"appliances"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ResourceConnector/appliances"},
+			},
+		},
+		"azurerm_attestation_provider": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Attestation",
+				Types:        []string{"attestationProviders"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Attestation/attestationProviders"},
+			},
+		},
+		"azurerm_automanage_configuration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AutoManage",
+				Types:        []string{"configurationProfiles"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AutoManage/configurationProfiles"},
+			},
+		},
+		"azurerm_automation_account": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts"},
+			},
+		},
+		"azurerm_automation_certificate": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "certificates"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/certificates"},
+			},
+		},
+		"azurerm_automation_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "connections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/connections"},
+			},
+		},
+		"azurerm_automation_connection_certificate": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "connections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/connections"},
+			},
+		},
+		"azurerm_automation_connection_classic_certificate": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "connections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/connections"},
+			},
+		},
+		"azurerm_automation_connection_service_principal": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "connections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/connections"},
+			},
+		},
+		"azurerm_automation_connection_type": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "connectionTypes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/connectionTypes"},
+			},
+		},
+		"azurerm_automation_credential": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "credentials"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/credentials"},
+			},
+		},
+		"azurerm_automation_dsc_configuration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "configurations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/configurations"},
+			},
+		},
+		"azurerm_automation_dsc_nodeconfiguration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "nodeConfigurations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/nodeConfigurations"},
+			},
+		},
+		"azurerm_automation_hybrid_runbook_worker": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "hybridRunbookWorkerGroups", "hybridRunbookWorkers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/hybridRunbookWorkerGroups/hybridRunbookWorkers"},
+			},
+		},
+		"azurerm_automation_hybrid_runbook_worker_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "hybridRunbookWorkerGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/hybridRunbookWorkerGroups"},
+			},
+		},
+		"azurerm_automation_job_schedule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "jobSchedules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/jobSchedules"},
+			},
+		},
+		"azurerm_automation_module": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "modules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/modules"},
+			},
+		},
+		"azurerm_automation_powershell72_module": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "powerShell72Modules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/powerShell72Modules"},
+			},
+		},
+		"azurerm_automation_python3_package": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "python3Packages"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/python3Packages"},
+			},
+		},
+		"azurerm_automation_runbook": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "runbooks"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/runbooks"},
+			},
+		},
+		"azurerm_automation_schedule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "schedules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/schedules"},
+			},
+		},
+		"azurerm_automation_software_update_configuration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "softwareUpdateConfigurations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/softwareUpdateConfigurations"},
+			},
+		},
+		"azurerm_automation_source_control": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "sourceControls"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/sourceControls"},
+			},
+		},
+		"azurerm_automation_variable_bool": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "variables"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/variables"},
+			},
+		},
+		"azurerm_automation_variable_datetime": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "variables"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/variables"},
+			},
+		},
+		"azurerm_automation_variable_int": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "variables"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/variables"},
+			},
+		},
+		"azurerm_automation_variable_object": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "variables"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/variables"},
+			},
+		},
+		"azurerm_automation_variable_string": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "variables"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/variables"},
+			},
+		},
+		"azurerm_automation_watcher": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "watchers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/watchers"},
+			},
+		},
+		"azurerm_automation_webhook": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Automation",
+				Types:        []string{"automationAccounts", "webHooks"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/webHooks"},
+			},
+		},
+		"azurerm_availability_set": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"availabilitySets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/availabilitySets"},
+			},
+		},
+		"azurerm_backup_container_storage_account": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.RecoveryServices",
+				Types:        []string{"vaults", "backupFabrics", "protectionContainers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupFabrics/protectionContainers"},
+			},
+		},
+		"azurerm_backup_policy_file_share": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.RecoveryServices",
+				Types:        []string{"vaults", "backupPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupPolicies"},
+			},
+		},
+		"azurerm_backup_policy_vm": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.RecoveryServices",
+				Types:        []string{"vaults", "backupPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupPolicies"},
+			},
+		},
+		"azurerm_backup_policy_vm_workload": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.RecoveryServices",
+				Types:        []string{"vaults", "backupPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupPolicies"},
+			},
+		},
+		"azurerm_backup_protected_file_share": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.RecoveryServices",
+				Types:        []string{"vaults", "backupFabrics", "protectionContainers", "protectedItems"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupFabrics/protectionContainers/protectedItems"},
+			},
+		},
+		"azurerm_backup_protected_vm": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.RecoveryServices",
+				Types:        []string{"vaults", "backupFabrics", "protectionContainers", "protectedItems"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupFabrics/protectionContainers/protectedItems"},
+			},
+		},
+		"azurerm_bastion_host": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"bastionHosts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/bastionHosts"},
+			},
+		},
+		"azurerm_batch_account": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Batch",
+				Types:        []string{"batchAccounts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Batch/batchAccounts"},
+			},
+		},
+		"azurerm_batch_application": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Batch",
+				Types:        []string{"batchAccounts", "applications"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Batch/batchAccounts/applications"},
+			},
+		},
+		"azurerm_batch_certificate": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Batch",
+				Types:        []string{"batchAccounts", "certificates"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Batch/batchAccounts/certificates"},
+			},
+		},
+		"azurerm_batch_job": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Batch",
+				Types:        []string{"batchAccounts", "pools", "jobs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Batch/batchAccounts/pools/jobs"},
+			},
+		},
+		"azurerm_batch_pool": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Batch",
+				Types:        []string{"batchAccounts", "pools"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Batch/batchAccounts/pools"},
+			},
+		},
+		"azurerm_billing_account_cost_management_export": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/Microsoft.Billing/billingAccounts"},
+				Provider:     "Microsoft.CostManagement",
+				Types:        []string{"exports"},
+				ImportSpecs:  []string{"/Microsoft.Billing/billingAccounts/Microsoft.CostManagement/exports"},
+			},
+		},
+		"azurerm_blueprint_assignment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions", "/Microsoft.Management/managementGroups"},
+				Provider:     "Microsoft.Blueprint",
+				Types:        []string{"blueprintAssignments"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.Blueprint/blueprintAssignments", "/Microsoft.Management/managementGroups/Microsoft.Blueprint/blueprintAssignments"},
+			},
+		},
+		"azurerm_bot_channel_alexa": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.BotService",
+				Types:        []string{"botServices", "channels"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"},
+			},
+		},
+		"azurerm_bot_channel_direct_line_speech": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.BotService",
+				Types:        []string{"botServices", "channels"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"},
+			},
+		},
+		"azurerm_bot_channel_directline": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.BotService",
+				Types:        []string{"botServices", "channels"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"},
+			},
+		},
+		"azurerm_bot_channel_email": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.BotService",
+				Types:        []string{"botServices", "channels"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"},
+			},
+		},
+		"azurerm_bot_channel_facebook": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.BotService",
+				Types:        []string{"botServices", "channels"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"},
+			},
+		},
+		"azurerm_bot_channel_line": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.BotService",
+				Types:        []string{"botServices", "channels"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"},
+			},
+		},
+		"azurerm_bot_channel_ms_teams": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.BotService",
+				Types:        []string{"botServices", "channels"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"},
+			},
+		},
+		"azurerm_bot_channel_slack": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.BotService",
+				Types:        []string{"botServices", "channels"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"},
+			},
+		},
+		"azurerm_bot_channel_sms": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.BotService",
+				Types:        []string{"botServices", "channels"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"},
+			},
+		},
+		"azurerm_bot_channel_web_chat": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.BotService",
+				Types:        []string{"botServices", "channels"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"},
+			},
+		},
+		"azurerm_bot_channels_registration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.BotService",
+				Types:        []string{"botServices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices"},
+			},
+		},
+		"azurerm_bot_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.BotService",
+				Types:        []string{"botServices", "connections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices/connections"},
+			},
+		},
+		"azurerm_bot_service_azure_bot": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.BotService",
+				Types:        []string{"botServices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices"},
+			},
+		},
+		"azurerm_bot_web_app": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.BotService",
+				Types:        []string{"botServices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices"},
+			},
+		},
+		"azurerm_capacity_reservation": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"capacityReservationGroups", "capacityReservations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/capacityReservationGroups/capacityReservations"},
+			},
+		},
+		"azurerm_capacity_reservation_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"capacityReservationGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/capacityReservationGroups"},
+			},
+		},
+		"azurerm_cdn_endpoint": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Cdn",
+				Types:        []string{"profiles", "endpoints"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/endpoints"},
+			},
+		},
+		"azurerm_cdn_endpoint_custom_domain": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Cdn",
+				Types:        []string{"profiles", "endpoints", "customDomains"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/endpoints/customDomains"},
+			},
+		},
+		"azurerm_cdn_frontdoor_custom_domain": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Cdn",
+				Types:        []string{"profiles", "customDomains"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/customDomains"},
+			},
+		},
+		"azurerm_cdn_frontdoor_custom_domain_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Cdn",
+				Types:        []string{"profiles", "associations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/associations"},
+			},
+		},
+		"azurerm_cdn_frontdoor_endpoint": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Cdn",
+				Types:        []string{"profiles", "afdEndpoints"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/afdEndpoints"},
+			},
+		},
+		"azurerm_cdn_frontdoor_firewall_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"frontDoorWebApplicationFirewallPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/frontDoorWebApplicationFirewallPolicies"},
+			},
+		},
+		"azurerm_cdn_frontdoor_origin": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Cdn",
+				Types:        []string{"profiles", "originGroups", "origins"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/originGroups/origins"},
+			},
+		},
+		"azurerm_cdn_frontdoor_origin_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Cdn",
+				Types:        []string{"profiles", "originGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/originGroups"},
+			},
+		},
+		"azurerm_cdn_frontdoor_profile": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Cdn",
+				Types:        []string{"profiles"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles"},
+			},
+		},
+		"azurerm_cdn_frontdoor_route": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Cdn",
+				Types:        []string{"profiles", "afdEndpoints", "routes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/afdEndpoints/routes"},
+			},
+		},
+		"azurerm_cdn_frontdoor_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Cdn",
+				Types:        []string{"profiles", "ruleSets", "rules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/ruleSets/rules"},
+			},
+		},
+		"azurerm_cdn_frontdoor_rule_set": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Cdn",
+				Types:        []string{"profiles", "ruleSets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/ruleSets"},
+			},
+		},
+		"azurerm_cdn_frontdoor_secret": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Cdn",
+				Types:        []string{"profiles", "secrets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/secrets"},
+			},
+		},
+		"azurerm_cdn_frontdoor_security_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Cdn",
+				Types:        []string{"profiles", "securityPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/securityPolicies"},
+			},
+		},
+		"azurerm_cdn_profile": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Cdn",
+				Types:        []string{"profiles"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles"},
+			},
+		},
+		"azurerm_chaos_studio_capability": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"any"},
+				Provider:     "Microsoft.Chaos",
+				Types:        []string{"targets", "capabilities"},
+			},
+		},
+		"azurerm_chaos_studio_experiment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Chaos",
+				Types:        []string{"experiments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Chaos/experiments"},
+			},
+		},
+		"azurerm_chaos_studio_target": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"any"},
+				Provider:     "Microsoft.Chaos",
+				Types:        []string{"targets"},
+			},
+		},
+		"azurerm_cognitive_account": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.CognitiveServices",
+				Types:        []string{"accounts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts"},
+			},
+		},
+		"azurerm_cognitive_account_customer_managed_key": {
+			IsRemoved:    true,
+			RemoveReason: "This is a property rather than a resource",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.CognitiveServices",
+				Types:        []string{"accounts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts"},
+			},
+		},
+		"azurerm_cognitive_account_rai_blocklist": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.CognitiveServices",
+				Types:        []string{"accounts", "raiBlocklists"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/raiBlocklists"},
+			},
+		},
+		"azurerm_cognitive_account_rai_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.CognitiveServices",
+				Types:        []string{"accounts", "raiPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/raiPolicies"},
+			},
+		},
+		"azurerm_cognitive_deployment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.CognitiveServices",
+				Types:        []string{"accounts", "deployments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/deployments"},
+			},
+		},
+		"azurerm_communication_service": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Communication",
+				Types:        []string{"communicationServices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Communication/communicationServices"},
+			},
+		},
+		"azurerm_communication_service_email_domain_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Communication",
+				Types:        []string{"communicationServices", "linkedDomains"},
+			},
+		},
+		"azurerm_confidential_ledger": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ConfidentialLedger",
+				Types:        []string{"ledgers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ConfidentialLedger/ledgers"},
+			},
+		},
+		"azurerm_consumption_budget_management_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/Microsoft.Management/managementGroups"},
+				Provider:     "Microsoft.Consumption",
+				Types:        []string{"budgets"},
+				ImportSpecs:  []string{"/Microsoft.Management/managementGroups/Microsoft.Consumption/budgets"},
+			},
+		},
+		"azurerm_consumption_budget_resource_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Consumption",
+				Types:        []string{"budgets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Consumption/budgets"},
+			},
+		},
+		"azurerm_consumption_budget_subscription": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions"},
+				Provider:     "Microsoft.Consumption",
+				Types:        []string{"budgets"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.Consumption/budgets"},
+			},
+		},
+		"azurerm_container_app": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.App",
+				Types:        []string{"containerApps"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.App/containerApps"},
+			},
+		},
+		"azurerm_container_app_custom_domain": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.App",
+				Types:        []string{"containerApps", "customDomainName"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.App/containerApps/customDomainName"},
+			},
+		},
+		"azurerm_container_app_environment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.App",
+				Types:        []string{"managedEnvironments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.App/managedEnvironments"},
+			},
+		},
+		"azurerm_container_app_environment_certificate": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.App",
+				Types:        []string{"managedEnvironments", "certificates"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.App/managedEnvironments/certificates"},
+			},
+		},
+		"azurerm_container_app_environment_custom_domain": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.App",
+				Types:        []string{"managedEnvironments", "customDomains"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.App/managedEnvironments"},
+			},
+		},
+		"azurerm_container_app_environment_dapr_component": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.App",
+				Types:        []string{"managedEnvironments", "daprComponents"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.App/managedEnvironments/daprComponents"},
+			},
+		},
+		"azurerm_container_app_environment_storage": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.App",
+				Types:        []string{"managedEnvironments", "storages"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.App/managedEnvironments/storages"},
+			},
+		},
+		"azurerm_container_app_job": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.App",
+				Types:        []string{"jobs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.App/jobs"},
+			},
+		},
+		"azurerm_container_connected_registry": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ContainerRegistry",
+				Types:        []string{"registries", "connectedRegistries"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/connectedRegistries"},
+			},
+		},
+		"azurerm_container_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ContainerInstance",
+				Types:        []string{"containerGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ContainerInstance/containerGroups"},
+			},
+		},
+		"azurerm_container_registry": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ContainerRegistry",
+				Types:        []string{"registries"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries"},
+			},
+		},
+		"azurerm_container_registry_agent_pool": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ContainerRegistry",
+				Types:        []string{"registries", "agentPools"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/agentPools"},
+			},
+		},
+		"azurerm_container_registry_cache_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ContainerRegistry",
+				Types:        []string{"registries", "cacheRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/cacheRules"},
+			},
+		},
+		"azurerm_container_registry_credential_set": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ContainerRegistry",
+				Types:        []string{"registries", "credentialSets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/credentialSets"},
+			},
+		},
+		"azurerm_container_registry_scope_map": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ContainerRegistry",
+				Types:        []string{"registries", "scopeMaps"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/scopeMaps"},
+			},
+		},
+		"azurerm_container_registry_task": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ContainerRegistry",
+				Types:        []string{"registries", "tasks"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/tasks"},
+			},
+		},
+		"azurerm_container_registry_token": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ContainerRegistry",
+				Types:        []string{"registries", "tokens"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/tokens"},
+			},
+		},
+		"azurerm_container_registry_token_password": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ContainerRegistry",
+				Types:        []string{"registries", "tokens", "passwords"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/tokens/passwords"},
+			},
+		},
+		"azurerm_container_registry_webhook": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ContainerRegistry",
+				Types:        []string{"registries", "webHooks"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/webHooks"},
+			},
+		},
+		"azurerm_cosmosdb_account": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DocumentDB",
+				Types:        []string{"databaseAccounts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts"},
+			},
+		},
+		"azurerm_cosmosdb_cassandra_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DocumentDB",
+				Types:        []string{"cassandraClusters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/cassandraClusters"},
+			},
+		},
+		"azurerm_cosmosdb_cassandra_datacenter": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DocumentDB",
+				Types:        []string{"cassandraClusters", "dataCenters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/cassandraClusters/dataCenters"},
+			},
+		},
+		"azurerm_cosmosdb_cassandra_keyspace": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DocumentDB",
+				Types:        []string{"databaseAccounts", "cassandraKeyspaces"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/cassandraKeyspaces"},
+			},
+		},
+		"azurerm_cosmosdb_cassandra_table": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DocumentDB",
+				Types:        []string{"databaseAccounts", "cassandraKeyspaces", "tables"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/cassandraKeyspaces/tables"},
+			},
+		},
+		"azurerm_cosmosdb_gremlin_database": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DocumentDB",
+				Types:        []string{"databaseAccounts", "gremlinDatabases"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/gremlinDatabases"},
+			},
+		},
+		"azurerm_cosmosdb_gremlin_graph": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DocumentDB",
+				Types:        []string{"databaseAccounts", "gremlinDatabases", "graphs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/gremlinDatabases/graphs"},
+			},
+		},
+		"azurerm_cosmosdb_mongo_collection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DocumentDB",
+				Types:        []string{"databaseAccounts", "mongodbDatabases", "collections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/mongodbDatabases/collections"},
+			},
+		},
+		"azurerm_cosmosdb_mongo_database": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DocumentDB",
+				Types:        []string{"databaseAccounts", "mongodbDatabases"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/mongodbDatabases"},
+			},
+		},
+		"azurerm_cosmosdb_mongo_role_definition": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DocumentDB",
+				Types:        []string{"databaseAccounts", "mongodbRoleDefinitions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/mongodbRoleDefinitions"},
+			},
+		},
+		"azurerm_cosmosdb_mongo_user_definition": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DocumentDB",
+				Types:        []string{"databaseAccounts", "mongodbUserDefinitions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/mongodbUserDefinitions"},
+			},
+		},
+		"azurerm_cosmosdb_postgresql_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DBforPostgreSQL",
+				Types:        []string{"serverGroupsv2"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/serverGroupsv2"},
+			},
+		},
+		"azurerm_cosmosdb_postgresql_coordinator_configuration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DBforPostgreSQL",
+				Types:        []string{"serverGroupsv2", "coordinatorConfigurations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/serverGroupsv2/coordinatorConfigurations"},
+			},
+		},
+		"azurerm_cosmosdb_postgresql_firewall_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DBforPostgreSQL",
+				Types:        []string{"serverGroupsv2", "firewallRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/serverGroupsv2/firewallRules"},
+			},
+		},
+		"azurerm_cosmosdb_postgresql_node_configuration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DBforPostgreSQL",
+				Types:        []string{"serverGroupsv2", "nodeConfigurations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/serverGroupsv2/nodeConfigurations"},
+			},
+		},
+		"azurerm_cosmosdb_postgresql_role": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DBforPostgreSQL",
+				Types:        []string{"serverGroupsv2", "roles"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/serverGroupsv2/roles"},
+			},
+		},
+		"azurerm_cosmosdb_sql_container": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DocumentDB",
+				Types:        []string{"databaseAccounts", "sqlDatabases", "containers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers"},
+			},
+		},
+		"azurerm_cosmosdb_sql_database": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DocumentDB",
+				Types:        []string{"databaseAccounts", "sqlDatabases"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlDatabases"},
+			},
+		},
+		"azurerm_cosmosdb_sql_dedicated_gateway": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DocumentDB",
+				Types:        []string{"databaseAccounts", "services"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/services"},
+			},
+		},
+		"azurerm_cosmosdb_sql_function": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DocumentDB",
+				Types:        []string{"databaseAccounts", "sqlDatabases", "containers", "userDefinedFunctions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers/userDefinedFunctions"},
+			},
+		},
+		"azurerm_cosmosdb_sql_role_assignment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DocumentDB",
+				Types:        []string{"databaseAccounts", "sqlRoleAssignments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlRoleAssignments"},
+			},
+		},
+		"azurerm_cosmosdb_sql_role_definition": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DocumentDB",
+				Types:        []string{"databaseAccounts", "sqlRoleDefinitions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlRoleDefinitions"},
+			},
+		},
+		"azurerm_cosmosdb_sql_stored_procedure": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DocumentDB",
+				Types:        []string{"databaseAccounts", "sqlDatabases", "containers", "storedProcedures"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers/storedProcedures"},
+			},
+		},
+		"azurerm_cosmosdb_sql_trigger": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DocumentDB",
+				Types:        []string{"databaseAccounts", "sqlDatabases", "containers", "triggers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers/triggers"},
+			},
+		},
+		"azurerm_cosmosdb_table": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DocumentDB",
+				Types:        []string{"databaseAccounts", "tables"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/tables"},
+			},
+		},
+		"azurerm_cost_anomaly_alert": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions"},
+				Provider:     "Microsoft.CostManagement",
+				Types:        []string{"scheduledActions"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.CostManagement/scheduledActions"},
+			},
+		},
+		"azurerm_cost_management_scheduled_action": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions"},
+				Provider:     "Microsoft.CostManagement",
+				Types:        []string{"scheduledActions"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.CostManagement/scheduledActions"},
+			},
+		},
+		"azurerm_custom_ip_prefix": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"customIPPrefixes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/customIPPrefixes"},
+			},
+		},
+		"azurerm_custom_provider": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.CustomProviders",
+				Types:        []string{"resourceProviders"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.CustomProviders/resourceProviders"},
+			},
+		},
+		"azurerm_dashboard_grafana": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Dashboard",
+				Types:        []string{"grafana"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Dashboard/grafana"},
+			},
+		},
+		"azurerm_dashboard_grafana_managed_private_endpoint": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Dashboard",
+				Types:        []string{"grafana", "managedPrivateEndpoints"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Dashboard/grafana/managedPrivateEndpoints"},
+			},
+		},
+		"azurerm_data_factory": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories"},
+			},
+		},
+		"azurerm_data_factory_credential_service_principal": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "credentials"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/credentials"},
+			},
+		},
+		"azurerm_data_factory_credential_user_managed_identity": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "credentials"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/credentials"},
+			},
+		},
+		"azurerm_data_factory_custom_dataset": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "datasets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+			},
+		},
+		"azurerm_data_factory_data_flow": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "dataflows"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/dataflows"},
+			},
+		},
+		"azurerm_data_factory_dataset_azure_blob": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "datasets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+			},
+		},
+		"azurerm_data_factory_dataset_azure_sql_table": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "datasets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+			},
+		},
+		"azurerm_data_factory_dataset_binary": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "datasets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+			},
+		},
+		"azurerm_data_factory_dataset_cosmosdb_sqlapi": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "datasets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+			},
+		},
+		"azurerm_data_factory_dataset_delimited_text": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "datasets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+			},
+		},
+		"azurerm_data_factory_dataset_http": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "datasets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+			},
+		},
+		"azurerm_data_factory_dataset_json": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "datasets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+			},
+		},
+		"azurerm_data_factory_dataset_mysql": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "datasets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+			},
+		},
+		"azurerm_data_factory_dataset_parquet": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "datasets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+			},
+		},
+		"azurerm_data_factory_dataset_postgresql": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "datasets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+			},
+		},
+		"azurerm_data_factory_dataset_snowflake": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "datasets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+			},
+		},
+		"azurerm_data_factory_dataset_sql_server_table": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "datasets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+			},
+		},
+		"azurerm_data_factory_flowlet_data_flow": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "dataflows"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/dataflows"},
+			},
+		},
+		"azurerm_data_factory_integration_runtime_azure": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "integrationruntimes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/integrationruntimes"},
+			},
+		},
+		"azurerm_data_factory_integration_runtime_azure_ssis": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "integrationruntimes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/integrationruntimes"},
+			},
+		},
+		"azurerm_data_factory_integration_runtime_self_hosted": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "integrationruntimes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/integrationruntimes"},
+			},
+		},
+		"azurerm_data_factory_linked_custom_service": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "linkedservices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+			},
+		},
+		"azurerm_data_factory_linked_service_azure_blob_storage": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "linkedservices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+			},
+		},
+		"azurerm_data_factory_linked_service_azure_databricks": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "linkedservices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+			},
+		},
+		"azurerm_data_factory_linked_service_azure_file_storage": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "linkedservices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+			},
+		},
+		"azurerm_data_factory_linked_service_azure_function": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "linkedservices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+			},
+		},
+		"azurerm_data_factory_linked_service_azure_search": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "linkedservices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+			},
+		},
+		"azurerm_data_factory_linked_service_azure_sql_database": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "linkedservices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+			},
+		},
+		"azurerm_data_factory_linked_service_azure_table_storage": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "linkedservices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+			},
+		},
+		"azurerm_data_factory_linked_service_cosmosdb": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "linkedservices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+			},
+		},
+		"azurerm_data_factory_linked_service_cosmosdb_mongoapi": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "linkedservices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+			},
+		},
+		"azurerm_data_factory_linked_service_data_lake_storage_gen2": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "linkedservices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+			},
+		},
+		"azurerm_data_factory_linked_service_key_vault": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "linkedservices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+			},
+		},
+		"azurerm_data_factory_linked_service_kusto": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "linkedservices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+			},
+		},
+		"azurerm_data_factory_linked_service_mysql": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "linkedservices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+			},
+		},
+		"azurerm_data_factory_linked_service_odata": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "linkedservices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+			},
+		},
+		"azurerm_data_factory_linked_service_odbc": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "linkedservices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+			},
+		},
+		"azurerm_data_factory_linked_service_postgresql": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "linkedservices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+			},
+		},
+		"azurerm_data_factory_linked_service_sftp": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "linkedservices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+			},
+		},
+		"azurerm_data_factory_linked_service_snowflake": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "linkedservices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+			},
+		},
+		"azurerm_data_factory_linked_service_sql_server": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "linkedservices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+			},
+		},
+		"azurerm_data_factory_linked_service_synapse": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "linkedservices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+			},
+		},
+		"azurerm_data_factory_linked_service_web": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "linkedservices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+			},
+		},
+		"azurerm_data_factory_managed_private_endpoint": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "managedVirtualNetworks", "managedPrivateEndpoints"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/managedVirtualNetworks/managedPrivateEndpoints"},
+			},
+		},
+		"azurerm_data_factory_pipeline": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "pipelines"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/pipelines"},
+			},
+		},
+		"azurerm_data_factory_trigger_blob_event": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "triggers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/triggers"},
+			},
+		},
+		"azurerm_data_factory_trigger_custom_event": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "triggers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/triggers"},
+			},
+		},
+		"azurerm_data_factory_trigger_schedule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "triggers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/triggers"},
+			},
+		},
+		"azurerm_data_factory_trigger_tumbling_window": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataFactory",
+				Types:        []string{"factories", "triggers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/triggers"},
+			},
+		},
+		"azurerm_data_protection_backup_instance_blob_storage": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataProtection",
+				Types:        []string{"backupVaults", "backupInstances"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"},
+			},
+		},
+		"azurerm_data_protection_backup_instance_disk": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataProtection",
+				Types:        []string{"backupVaults", "backupInstances"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"},
+			},
+		},
+		"azurerm_data_protection_backup_instance_kubernetes_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataProtection",
+				Types:        []string{"backupVaults", "backupInstances"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"},
+			},
+		},
+		"azurerm_data_protection_backup_instance_mysql_flexible_server": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataProtection",
+				Types:        []string{"backupVaults", "backupInstances"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"},
+			},
+		},
+		"azurerm_data_protection_backup_instance_postgresql": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataProtection",
+				Types:        []string{"backupVaults", "backupInstances"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"},
+			},
+		},
+		"azurerm_data_protection_backup_instance_postgresql_flexible_server": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataProtection",
+				Types:        []string{"backupVaults", "backupInstances"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"},
+			},
+		},
+		"azurerm_data_protection_backup_policy_blob_storage": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataProtection",
+				Types:        []string{"backupVaults", "backupPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"},
+			},
+		},
+		"azurerm_data_protection_backup_policy_disk": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataProtection",
+				Types:        []string{"backupVaults", "backupPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"},
+			},
+		},
+		"azurerm_data_protection_backup_policy_kubernetes_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataProtection",
+				Types:        []string{"backupVaults", "backupPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"},
+			},
+		},
+		"azurerm_data_protection_backup_policy_mysql_flexible_server": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataProtection",
+				Types:        []string{"backupVaults", "backupPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"},
+			},
+		},
+		"azurerm_data_protection_backup_policy_postgresql": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataProtection",
+				Types:        []string{"backupVaults", "backupPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"},
+			},
+		},
+		"azurerm_data_protection_backup_policy_postgresql_flexible_server": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataProtection",
+				Types:        []string{"backupVaults", "backupPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"},
+			},
+		},
+		"azurerm_data_protection_backup_vault": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataProtection",
+				Types:        []string{"backupVaults"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults"},
+			},
+		},
+		"azurerm_data_protection_backup_vault_customer_managed_key": {
+			IsRemoved:    true,
+			RemoveReason: "This is a property rather than a resource",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataProtection",
+				Types:        []string{"backupVaults"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults"},
+			},
+		},
+		"azurerm_data_protection_resource_guard": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataProtection",
+				Types:        []string{"resourceGuards"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/resourceGuards"},
+			},
+		},
+		"azurerm_data_share": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataShare",
+				Types:        []string{"accounts", "shares"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataShare/accounts/shares"},
+			},
+		},
+		"azurerm_data_share_account": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataShare",
+				Types:        []string{"accounts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataShare/accounts"},
+			},
+		},
+		"azurerm_data_share_dataset_blob_storage": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataShare",
+				Types:        []string{"accounts", "shares", "dataSets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataShare/accounts/shares/dataSets"},
+			},
+		},
+		"azurerm_data_share_dataset_data_lake_gen2": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataShare",
+				Types:        []string{"accounts", "shares", "dataSets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataShare/accounts/shares/dataSets"},
+			},
+		},
+		"azurerm_data_share_dataset_kusto_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataShare",
+				Types:        []string{"accounts", "shares", "dataSets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataShare/accounts/shares/dataSets"},
+			},
+		},
+		"azurerm_data_share_dataset_kusto_database": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataShare",
+				Types:        []string{"accounts", "shares", "dataSets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataShare/accounts/shares/dataSets"},
+			},
+		},
+		"azurerm_database_migration_project": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataMigration",
+				Types:        []string{"services", "projects"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataMigration/services/projects"},
+			},
+		},
+		"azurerm_database_migration_service": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataMigration",
+				Types:        []string{"services"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataMigration/services"},
+			},
+		},
+		"azurerm_databox_edge_device": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DataBoxEdge",
+				Types:        []string{"dataBoxEdgeDevices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DataBoxEdge/dataBoxEdgeDevices"},
+			},
+		},
+		"azurerm_databricks_access_connector": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Databricks",
+				Types:        []string{"accessConnectors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Databricks/accessConnectors"},
+			},
+		},
+		"azurerm_databricks_virtual_network_peering": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Databricks",
+				Types:        []string{"workspaces", "virtualNetworkPeerings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Databricks/workspaces/virtualNetworkPeerings"},
+			},
+		},
+		"azurerm_databricks_workspace": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Databricks",
+				Types:        []string{"workspaces"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Databricks/workspaces"},
+			},
+		},
+		"azurerm_databricks_workspace_root_dbfs_customer_managed_key": {
+			IsRemoved:    true,
+			RemoveReason: "This is a property rather than a resource",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Databricks",
+				Types:        []string{"workspaces"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Databricks/workspaces"},
+			},
+		},
+		"azurerm_datadog_monitor": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Datadog",
+				Types:        []string{"monitors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Datadog/monitors"},
+			},
+		},
+		"azurerm_datadog_monitor_sso_configuration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Datadog",
+				Types:        []string{"monitors", "singleSignOnConfigurations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Datadog/monitors/singleSignOnConfigurations"},
+			},
+		},
+		"azurerm_datadog_monitor_tag_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Datadog",
+				Types:        []string{"monitors", "tagRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Datadog/monitors/tagRules"},
+			},
+		},
+		"azurerm_dedicated_hardware_security_module": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.HardwareSecurityModules",
+				Types:        []string{"dedicatedHSMs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.HardwareSecurityModules/dedicatedHSMs"},
+			},
+		},
+		"azurerm_dedicated_host": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"hostGroups", "hosts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/hostGroups/hosts"},
+			},
+		},
+		"azurerm_dedicated_host_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"hostGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/hostGroups"},
+			},
+		},
+		"azurerm_dev_center": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DevCenter",
+				Types:        []string{"devCenters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters"},
+			},
+		},
+		"azurerm_dev_center_attached_network": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DevCenter",
+				Types:        []string{"devCenters", "attachedNetworks"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters/attachedNetworks"},
+			},
+		},
+		"azurerm_dev_center_catalog": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DevCenter",
+				Types:        []string{"devCenters", "catalogs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters/catalogs"},
+			},
+		},
+		"azurerm_dev_center_dev_box_definition": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DevCenter",
+				Types:        []string{"devCenters", "devBoxDefinitions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters/devBoxDefinitions"},
+			},
+		},
+		"azurerm_dev_center_environment_type": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DevCenter",
+				Types:        []string{"devCenters", "environmentTypes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters/environmentTypes"},
+			},
+		},
+		"azurerm_dev_center_gallery": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DevCenter",
+				Types:        []string{"devCenters", "galleries"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters/galleries"},
+			},
+		},
+		"azurerm_dev_center_network_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DevCenter",
+				Types:        []string{"networkConnections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DevCenter/networkConnections"},
+			},
+		},
+		"azurerm_dev_center_project": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DevCenter",
+				Types:        []string{"projects"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DevCenter/projects"},
+			},
+		},
+		"azurerm_dev_center_project_environment_type": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DevCenter",
+				Types:        []string{"projects", "environmentTypes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DevCenter/projects/environmentTypes"},
+			},
+		},
+		"azurerm_dev_center_project_pool": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DevCenter",
+				Types:        []string{"projects", "pools"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DevCenter/projects/pools"},
+			},
+		},
+		"azurerm_dev_test_global_vm_shutdown_schedule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DevTestLab",
+				Types:        []string{"schedules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DevTestLab/schedules"},
+			},
+		},
+		"azurerm_dev_test_lab": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DevTestLab",
+				Types:        []string{"labs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DevTestLab/labs"},
+			},
+		},
+		"azurerm_dev_test_linux_virtual_machine": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DevTestLab",
+				Types:        []string{"labs", "virtualMachines"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DevTestLab/labs/virtualMachines"},
+			},
+		},
+		"azurerm_dev_test_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DevTestLab",
+				Types:        []string{"labs", "policySets", "policies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DevTestLab/labs/policySets/policies"},
+			},
+		},
+		"azurerm_dev_test_schedule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DevTestLab",
+				Types:        []string{"labs", "schedules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DevTestLab/labs/schedules"},
+			},
+		},
+		"azurerm_dev_test_virtual_network": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DevTestLab",
+				Types:        []string{"labs", "virtualNetworks"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DevTestLab/labs/virtualNetworks"},
+			},
+		},
+		"azurerm_dev_test_windows_virtual_machine": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DevTestLab",
+				Types:        []string{"labs", "virtualMachines"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DevTestLab/labs/virtualMachines"},
+			},
+		},
+		"azurerm_digital_twins_endpoint_eventgrid": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DigitalTwins",
+				Types:        []string{"digitalTwinsInstances", "endpoints"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DigitalTwins/digitalTwinsInstances/endpoints"},
+			},
+		},
+		"azurerm_digital_twins_endpoint_eventhub": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DigitalTwins",
+				Types:        []string{"digitalTwinsInstances", "endpoints"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DigitalTwins/digitalTwinsInstances/endpoints"},
+			},
+		},
+		"azurerm_digital_twins_endpoint_servicebus": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DigitalTwins",
+				Types:        []string{"digitalTwinsInstances", "endpoints"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DigitalTwins/digitalTwinsInstances/endpoints"},
+			},
+		},
+		"azurerm_digital_twins_instance": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DigitalTwins",
+				Types:        []string{"digitalTwinsInstances"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DigitalTwins/digitalTwinsInstances"},
+			},
+		},
+		"azurerm_digital_twins_time_series_database_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DigitalTwins",
+				Types:        []string{"digitalTwinsInstances", "timeSeriesDatabaseConnections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DigitalTwins/digitalTwinsInstances/timeSeriesDatabaseConnections"},
+			},
+		},
+		"azurerm_disk_access": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"diskAccesses"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/diskAccesses"},
+			},
+		},
+		"azurerm_disk_encryption_set": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"diskEncryptionSets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/diskEncryptionSets"},
+			},
+		},
+		"azurerm_dns_a_record": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"dnszones", "A"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsZones/A"},
+			},
+		},
+		"azurerm_dns_aaaa_record": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"dnszones", "AAAA"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsZones/AAAA"},
+			},
+		},
+		"azurerm_dns_caa_record": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"dnszones", "CAA"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsZones/CAA"},
+			},
+		},
+		"azurerm_dns_cname_record": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"dnszones", "CNAME"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsZones/CNAME"},
+			},
+		},
+		"azurerm_dns_mx_record": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"dnszones", "MX"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsZones/MX"},
+			},
+		},
+		"azurerm_dns_ns_record": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"dnszones", "NS"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsZones/NS"},
+			},
+		},
+		"azurerm_dns_ptr_record": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"dnszones", "PTR"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsZones/PTR"},
+			},
+		},
+		"azurerm_dns_srv_record": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"dnszones", "SRV"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsZones/SRV"},
+			},
+		},
+		"azurerm_dns_txt_record": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"dnszones", "TXT"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsZones/TXT"},
+			},
+		},
+		"azurerm_dns_zone": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"dnszones"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsZones"},
+			},
+		},
+		"azurerm_dynatrace_tag_rules": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Dynatrace.Observability",
+				Types:        []string{"monitors", "tagRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Dynatrace.Observability/monitors/tagRules"},
+			},
+		},
+		"azurerm_elastic_cloud_elasticsearch": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Elastic",
+				Types:        []string{"monitors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Elastic/monitors"},
+			},
+		},
+		"azurerm_elastic_san": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ElasticSan",
+				Types:        []string{"elasticSans"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ElasticSan/elasticSans"},
+			},
+		},
+		"azurerm_elastic_san_volume": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ElasticSan",
+				Types:        []string{"elasticSans", "volumeGroups", "volumes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ElasticSan/elasticSans/volumeGroups/volumes"},
+			},
+		},
+		"azurerm_elastic_san_volume_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ElasticSan",
+				Types:        []string{"elasticSans", "volumeGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ElasticSan/elasticSans/volumeGroups"},
+			},
+		},
+		"azurerm_email_communication_service": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Communication",
+				Types:        []string{"emailServices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Communication/emailServices"},
+			},
+		},
+		"azurerm_email_communication_service_domain": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Communication",
+				Types:        []string{"emailServices", "domains"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Communication/emailServices/domains"},
+			},
+		},
+		"azurerm_eventgrid_domain": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.EventGrid",
+				Types:        []string{"domains"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.EventGrid/domains"},
+			},
+		},
+		"azurerm_eventgrid_domain_topic": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.EventGrid",
+				Types:        []string{"domains", "topics"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.EventGrid/domains/topics"},
+			},
+		},
+		"azurerm_eventgrid_event_subscription": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"any"},
+				Provider:     "Microsoft.EventGrid",
+				Types:        []string{"eventSubscriptions"},
+			},
+		},
+		"azurerm_eventgrid_namespace": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.EventGrid",
+				Types:        []string{"namespaces"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.EventGrid/namespaces"},
+			},
+		},
+		"azurerm_eventgrid_system_topic": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.EventGrid",
+				Types:        []string{"systemTopics"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.EventGrid/systemTopics"},
+			},
+		},
+		"azurerm_eventgrid_system_topic_event_subscription": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.EventGrid",
+				Types:        []string{"systemTopics", "eventSubscriptions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.EventGrid/systemTopics/eventSubscriptions"},
+			},
+		},
+		"azurerm_eventgrid_topic": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.EventGrid",
+				Types:        []string{"topics"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.EventGrid/topics"},
+			},
+		},
+		"azurerm_eventhub": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.EventHub",
+				Types:        []string{"namespaces", "eventhubs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/eventhubs"},
+			},
+		},
+		"azurerm_eventhub_authorization_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.EventHub",
+				Types:        []string{"namespaces", "eventhubs", "authorizationRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/eventhubs/authorizationRules"},
+			},
+		},
+		"azurerm_eventhub_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.EventHub",
+				Types:        []string{"clusters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.EventHub/clusters"},
+			},
+		},
+		"azurerm_eventhub_consumer_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.EventHub",
+				Types:        []string{"namespaces", "eventhubs", "consumerGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/eventhubs/consumerGroups"},
+			},
+		},
+		"azurerm_eventhub_namespace": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.EventHub",
+				Types:        []string{"namespaces"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.EventHub/namespaces"},
+			},
+		},
+		"azurerm_eventhub_namespace_authorization_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.EventHub",
+				Types:        []string{"namespaces", "authorizationRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/authorizationRules"},
+			},
+		},
+		"azurerm_eventhub_namespace_customer_managed_key": {
+			IsRemoved:    true,
+			RemoveReason: "This is a property rather than a resource",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.EventHub",
+				Types:        []string{"namespaces"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.EventHub/namespaces"},
+			},
+		},
+		"azurerm_eventhub_namespace_disaster_recovery_config": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.EventHub",
+				Types:        []string{"namespaces", "disasterRecoveryConfigs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/disasterRecoveryConfigs"},
+			},
+		},
+		"azurerm_eventhub_namespace_schema_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.EventHub",
+				Types:        []string{"namespaces", "schemaGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/schemaGroups"},
+			},
+		},
+		"azurerm_express_route_circuit": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"expressRouteCircuits"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/expressRouteCircuits"},
+			},
+		},
+		"azurerm_express_route_circuit_authorization": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"expressRouteCircuits", "authorizations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/expressRouteCircuits/authorizations"},
+			},
+		},
+		"azurerm_express_route_circuit_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"expressRouteCircuits", "peerings", "connections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/expressRouteCircuits/peerings/connections"},
+			},
+		},
+		"azurerm_express_route_circuit_peering": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"expressRouteCircuits", "peerings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/expressRouteCircuits/peerings"},
+			},
+		},
+		"azurerm_express_route_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"expressRouteGateways", "expressRouteConnections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/expressRouteGateways/expressRouteConnections"},
+			},
+		},
+		"azurerm_express_route_gateway": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"expressRouteGateways"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/expressRouteGateways"},
+			},
+		},
+		"azurerm_express_route_port": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"expressRoutePorts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/expressRoutePorts"},
+			},
+		},
+		"azurerm_express_route_port_authorization": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"expressRoutePorts", "authorizations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/expressRoutePorts/authorizations"},
+			},
+		},
+		"azurerm_extended_location_custom_location": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ExtendedLocation",
+				Types:        []string{"customLocations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ExtendedLocation/customLocations"},
+			},
+		},
+		"azurerm_fabric_capacity": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Fabric",
+				Types:        []string{"capacities"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Fabric/capacities"},
+			},
+		},
+		"azurerm_federated_identity_credential": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ManagedIdentity",
+				Types:        []string{"userAssignedIdentities", "federatedIdentityCredentials"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ManagedIdentity/userAssignedIdentities/federatedIdentityCredentials"},
+			},
+		},
+		"azurerm_firewall": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"azureFirewalls"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/azureFirewalls"},
+			},
+		},
+		"azurerm_firewall_application_rule_collection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"azureFirewalls", "applicationRuleCollections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/azureFirewalls/applicationRuleCollections"},
+			},
+		},
+		"azurerm_firewall_nat_rule_collection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"azureFirewalls", "natRuleCollections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/azureFirewalls/natRuleCollections"},
+			},
+		},
+		"azurerm_firewall_network_rule_collection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"azureFirewalls", "networkRuleCollections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/azureFirewalls/networkRuleCollections"},
+			},
+		},
+		"azurerm_firewall_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"firewallPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/firewallPolicies"},
+			},
+		},
+		"azurerm_firewall_policy_rule_collection_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"firewallPolicies", "ruleCollectionGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/firewallPolicies/ruleCollectionGroups"},
+			},
+		},
+		"azurerm_fluid_relay_server": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.FluidRelay",
+				Types:        []string{"fluidRelayServers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.FluidRelay/fluidRelayServers"},
+			},
+		},
+		"azurerm_frontdoor": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"frontDoors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/frontDoors"},
+			},
+		},
+		"azurerm_frontdoor_custom_https_configuration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"frontDoors", "customHttpsConfiguration"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/frontDoors/customHttpsConfiguration"},
+			},
+		},
+		"azurerm_frontdoor_firewall_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"frontDoorWebApplicationFirewallPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/frontDoorWebApplicationFirewallPolicies"},
+			},
+		},
+		"azurerm_frontdoor_rules_engine": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"frontdoors", "rulesEngines"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/frontdoors/rulesEngines"},
+			},
+		},
+		"azurerm_function_app": {
+			IsRemoved:    true,
+			RemoveReason: "This is deprecated in favor of `azurerm_linux_function_app` and `azurerm_windows_function_app`",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"},
+			},
+		},
+		"azurerm_function_app_active_slot": {
+			IsRemoved:    true,
+			RemoveReason: "This is a property rather than a resource",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"},
+			},
+		},
+		"azurerm_function_app_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"},
+				Provider:     "Microsoft.ServiceLinker",
+				Types:        []string{"linkers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/Microsoft.ServiceLinker/linkers"},
+			},
+		},
+		"azurerm_function_app_flex_consumption": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"},
+			},
+		},
+		"azurerm_function_app_function": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites", "functions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/functions"},
+			},
+		},
+		"azurerm_function_app_hybrid_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites", "hybridConnectionNamespaces", "relays"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/hybridConnectionNamespaces/relays"},
+			},
+		},
+		"azurerm_function_app_slot": {
+			IsRemoved:    true,
+			RemoveReason: "This is deprecated in favor of `azurerm_linux_function_app_slot` and `azurerm_windows_function_app_slot`",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites", "slots"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/slots"},
+			},
+		},
+		"azurerm_gallery_application": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"galleries", "applications"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/galleries/applications"},
+			},
+		},
+		"azurerm_gallery_application_version": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"galleries", "applications", "versions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/galleries/applications/versions"},
+			},
+		},
+		"azurerm_graph_services_account": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.GraphServices",
+				Types:        []string{"accounts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.GraphServices/accounts"},
+			},
+		},
+		"azurerm_hdinsight_hadoop_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.HDInsight",
+				Types:        []string{"clusters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.HDInsight/clusters"},
+			},
+		},
+		"azurerm_hdinsight_hbase_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.HDInsight",
+				Types:        []string{"clusters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.HDInsight/clusters"},
+			},
+		},
+		"azurerm_hdinsight_interactive_query_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.HDInsight",
+				Types:        []string{"clusters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.HDInsight/clusters"},
+			},
+		},
+		"azurerm_hdinsight_kafka_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.HDInsight",
+				Types:        []string{"clusters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.HDInsight/clusters"},
+			},
+		},
+		"azurerm_hdinsight_spark_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.HDInsight",
+				Types:        []string{"clusters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.HDInsight/clusters"},
+			},
+		},
+		"azurerm_healthbot": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.HealthBot",
+				Types:        []string{"healthBots"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.HealthBot/healthBots"},
+			},
+		},
+		"azurerm_healthcare_dicom_service": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.HealthcareApis",
+				Types:        []string{"workspaces", "dicomServices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.HealthcareApis/workspaces/dicomServices"},
+			},
+		},
+		"azurerm_healthcare_fhir_service": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.HealthcareApis",
+				Types:        []string{"workspaces", "fhirServices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.HealthcareApis/workspaces/fhirServices"},
+			},
+		},
+		"azurerm_healthcare_medtech_service": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.HealthcareApis",
+				Types:        []string{"workspaces", "iotConnectors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.HealthcareApis/workspaces/iotConnectors"},
+			},
+		},
+		"azurerm_healthcare_medtech_service_fhir_destination": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.HealthcareApis",
+				Types:        []string{"workspaces", "iotConnectors", "fhirDestinations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.HealthcareApis/workspaces/iotConnectors/fhirDestinations"},
+			},
+		},
+		"azurerm_healthcare_service": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.HealthcareApis",
+				Types:        []string{"services"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.HealthcareApis/services"},
+			},
+		},
+		"azurerm_healthcare_workspace": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.HealthcareApis",
+				Types:        []string{"workspaces"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.HealthcareApis/workspaces"},
+			},
+		},
+		"azurerm_hpc_cache": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StorageCache",
+				Types:        []string{"caches"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StorageCache/caches"},
+			},
+		},
+		"azurerm_hpc_cache_access_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StorageCache",
+				Types:        []string{"caches", "cacheAccessPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StorageCache/caches/cacheAccessPolicies"},
+			},
+		},
+		"azurerm_hpc_cache_blob_nfs_target": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StorageCache",
+				Types:        []string{"caches", "storageTargets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StorageCache/caches/storageTargets"},
+			},
+		},
+		"azurerm_hpc_cache_blob_target": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StorageCache",
+				Types:        []string{"caches", "storageTargets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StorageCache/caches/storageTargets"},
+			},
+		},
+		"azurerm_hpc_cache_nfs_target": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StorageCache",
+				Types:        []string{"caches", "storageTargets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StorageCache/caches/storageTargets"},
+			},
+		},
+		"azurerm_image": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"images"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/images"},
+			},
+		},
+		"azurerm_iot_security_device_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"any"},
+				Provider:     "Microsoft.Security",
+				Types:        []string{"deviceSecurityGroups"},
+			},
+		},
+		"azurerm_iot_security_solution": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Security",
+				Types:        []string{"iotSecuritySolutions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Security/iotSecuritySolutions"},
+			},
+		},
+		"azurerm_iotcentral_application": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.IoTCentral",
+				Types:        []string{"iotApps"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.IoTCentral/iotApps"},
+			},
+		},
+		"azurerm_iotcentral_application_network_rule_set": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.IoTCentral",
+				Types:        []string{"iotApps", "networkRuleSets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.IoTCentral/iotApps"},
+			},
+		},
+		"azurerm_iotcentral_organization": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.IoTCentral",
+				Types:        []string{"iotApps", "organizations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.IoTCentral/iotApps/organizations"},
+			},
+		},
+		"azurerm_iothub": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Devices",
+				Types:        []string{"iotHubs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs"},
+			},
+		},
+		"azurerm_iothub_certificate": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Devices",
+				Types:        []string{"iotHubs", "certificates"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/certificates"},
+			},
+		},
+		"azurerm_iothub_consumer_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Devices",
+				Types:        []string{"iotHubs", "eventHubEndpoints", "consumerGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/eventHubEndpoints/consumerGroups"},
+			},
+		},
+		"azurerm_iothub_device_update_account": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DeviceUpdate",
+				Types:        []string{"accounts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DeviceUpdate/accounts"},
+			},
+		},
+		"azurerm_iothub_device_update_instance": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DeviceUpdate",
+				Types:        []string{"accounts", "instances"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DeviceUpdate/accounts/instances"},
+			},
+		},
+		"azurerm_iothub_dps": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Devices",
+				Types:        []string{"provisioningServices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Devices/provisioningServices"},
+			},
+		},
+		"azurerm_iothub_dps_certificate": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Devices",
+				Types:        []string{"provisioningServices", "certificates"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Devices/provisioningServices/certificates"},
+			},
+		},
+		"azurerm_iothub_dps_shared_access_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Devices",
+				Types:        []string{"provisioningServices", "keys"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Devices/provisioningServices/keys"},
+			},
+		},
+		"azurerm_iothub_endpoint_cosmosdb_account": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Devices",
+				Types:        []string{"iotHubs", "endpointsCosmosdbAccount"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/endpoints"},
+			},
+		},
+		"azurerm_iothub_endpoint_eventhub": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Devices",
+				Types:        []string{"iotHubs", "endpointsEventhub"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/endpoints"},
+			},
+		},
+		"azurerm_iothub_endpoint_servicebus_queue": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Devices",
+				Types:        []string{"iotHubs", "endpointsServicebusQueue"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/endpoints"},
+			},
+		},
+		"azurerm_iothub_endpoint_servicebus_topic": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Devices",
+				Types:        []string{"iotHubs", "endpointsServicebusTopic"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/endpoints"},
+			},
+		},
+		"azurerm_iothub_endpoint_storage_container": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Devices",
+				Types:        []string{"iotHubs", "endpointsStorageContainer"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/endpoints"},
+			},
+		},
+		"azurerm_iothub_enrichment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Devices",
+				Types:        []string{"iotHubs", "enrichments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/enrichments"},
+			},
+		},
+		"azurerm_iothub_fallback_route": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Devices",
+				Types:        []string{"iotHubs", "fallbackRoute"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/fallbackRoute"},
+			},
+		},
+		"azurerm_iothub_file_upload": {
+			IsRemoved:    true,
+			RemoveReason: "This is a property rather than a resource",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Devices",
+				Types:        []string{"iotHubs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs"},
+			},
+		},
+		"azurerm_iothub_route": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Devices",
+				Types:        []string{"iotHubs", "routes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/routes"},
+			},
+		},
+		"azurerm_iothub_shared_access_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Devices",
+				Types:        []string{"iotHubs", "iotHubKeys"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/iotHubKeys"},
+			},
+		},
+		"azurerm_ip_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"ipGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/ipGroups"},
+			},
+		},
+		"azurerm_ip_group_cidr": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"ipGroups", "cidrs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/ipGroups/cidrs"},
+			},
+		},
+		"azurerm_key_vault": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.KeyVault",
+				Types:        []string{"vaults"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.KeyVault/vaults"},
+			},
+		},
+		"azurerm_key_vault_access_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.KeyVault",
+				Types:        []string{"vaults", "objectId"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.KeyVault/vaults/objectId"},
+			},
+		},
+		"azurerm_key_vault_certificate": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.KeyVault",
+				Types:        []string{"vaults", "certificates"},
+			},
+		},
+		"azurerm_key_vault_certificate_contacts": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.KeyVault",
+				Types:        []string{"vaults", "certificates", "contacts"},
+			},
+		},
+		"azurerm_key_vault_certificate_issuer": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.KeyVault",
+				Types:        []string{"vaults", "certificates", "issuers"},
+			},
+		},
+		"azurerm_key_vault_key": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.KeyVault",
+				Types:        []string{"vaults", "keys"},
+			},
+		},
+		"azurerm_key_vault_managed_hardware_security_module": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.KeyVault",
+				Types:        []string{"managedHSMs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.KeyVault/managedHSMs"},
+			},
+		},
+		"azurerm_key_vault_managed_storage_account": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.KeyVault",
+				Types:        []string{"vaults", "storage"},
+			},
+		},
+		"azurerm_key_vault_managed_storage_account_sas_token_definition": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.KeyVault",
+				Types:        []string{"vaults", "storage", "sas"},
+			},
+		},
+		"azurerm_key_vault_secret": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.KeyVault",
+				Types:        []string{"vaults", "secrets"},
+			},
+		},
+		"azurerm_kubernetes_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ContainerService",
+				Types:        []string{"managedClusters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters"},
+			},
+		},
+		"azurerm_kubernetes_cluster_extension": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters"},
+				Provider:     "Microsoft.KubernetesConfiguration",
+				Types:        []string{"extensions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters/Microsoft.KubernetesConfiguration/extensions"},
+			},
+		},
+		"azurerm_kubernetes_cluster_node_pool": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ContainerService",
+				Types:        []string{"managedClusters", "agentPools"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters/agentPools"},
+			},
+		},
+		"azurerm_kubernetes_cluster_trusted_access_role_binding": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ContainerService",
+				Types:        []string{"managedClusters", "trustedAccessRoleBindings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters/trustedAccessRoleBindings"},
+			},
+		},
+		"azurerm_kubernetes_fleet_manager": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ContainerService",
+				Types:        []string{"fleets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/fleets"},
+			},
+		},
+		"azurerm_kubernetes_fleet_member": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ContainerService",
+				Types:        []string{"fleets", "members"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/fleets/members"},
+			},
+		},
+		"azurerm_kubernetes_fleet_update_run": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ContainerService",
+				Types:        []string{"fleets", "updateRuns"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/fleets/updateRuns"},
+			},
+		},
+		"azurerm_kubernetes_fleet_update_strategy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ContainerService",
+				Types:        []string{"fleets", "updateStrategies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/fleets/updateStrategies"},
+			},
+		},
+		"azurerm_kubernetes_flux_configuration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters"},
+				Provider:     "Microsoft.KubernetesConfiguration",
+				Types:        []string{"fluxConfigurations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters/Microsoft.KubernetesConfiguration/fluxConfigurations"},
+			},
+		},
+		"azurerm_kusto_attached_database_configuration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Kusto",
+				Types:        []string{"clusters", "attachedDatabaseConfigurations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters/attachedDatabaseConfigurations"},
+			},
+		},
+		"azurerm_kusto_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Kusto",
+				Types:        []string{"clusters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters"},
+			},
+		},
+		"azurerm_kusto_cluster_customer_managed_key": {
+			IsRemoved:    true,
+			RemoveReason: "This is a property rather than a resource",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Kusto",
+				Types:        []string{"clusters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters"},
+			},
+		},
+		"azurerm_kusto_cluster_managed_private_endpoint": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Kusto",
+				Types:        []string{"clusters", "managedPrivateEndpoints"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters/managedPrivateEndpoints"},
+			},
+		},
+		"azurerm_kusto_cluster_principal_assignment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Kusto",
+				Types:        []string{"clusters", "principalAssignments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters/principalAssignments"},
+			},
+		},
+		"azurerm_kusto_cosmosdb_data_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Kusto",
+				Types:        []string{"clusters", "databases", "dataConnections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/dataConnections"},
+			},
+		},
+		"azurerm_kusto_database": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Kusto",
+				Types:        []string{"clusters", "databases"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases"},
+			},
+		},
+		"azurerm_kusto_database_principal_assignment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Kusto",
+				Types:        []string{"clusters", "databases", "principalAssignments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/principalAssignments"},
+			},
+		},
+		"azurerm_kusto_eventgrid_data_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Kusto",
+				Types:        []string{"clusters", "databases", "dataConnections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/dataConnections"},
+			},
+		},
+		"azurerm_kusto_eventhub_data_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Kusto",
+				Types:        []string{"clusters", "databases", "dataConnections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/dataConnections"},
+			},
+		},
+		"azurerm_kusto_iothub_data_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Kusto",
+				Types:        []string{"clusters", "databases", "dataConnections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/dataConnections"},
+			},
+		},
+		"azurerm_kusto_script": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Kusto",
+				Types:        []string{"clusters", "databases", "scripts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/scripts"},
+			},
+		},
+		"azurerm_lb": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"loadBalancers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/loadBalancers"},
+			},
+		},
+		"azurerm_lb_backend_address_pool": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"loadBalancers", "backendAddressPools"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/backendAddressPools"},
+			},
+		},
+		"azurerm_lb_backend_address_pool_address": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"loadBalancers", "backendAddressPools", "addresses"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/backendAddressPools/addresses"},
+			},
+		},
+		"azurerm_lb_nat_pool": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"loadBalancers", "inboundNatPools"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/inboundNatPools"},
+			},
+		},
+		"azurerm_lb_nat_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"loadBalancers", "inboundNatRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/inboundNatRules"},
+			},
+		},
+		"azurerm_lb_outbound_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"loadBalancers", "outboundRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/outboundRules"},
+			},
+		},
+		"azurerm_lb_probe": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"loadBalancers", "probes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/probes"},
+			},
+		},
+		"azurerm_lb_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"loadBalancers", "loadBalancingRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/loadBalancingRules"},
+			},
+		},
+		"azurerm_lighthouse_assignment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions", "/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ManagedServices",
+				Types:        []string{"registrationAssignments"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.ManagedServices/registrationAssignments", "/subscriptions/resourceGroups/Microsoft.ManagedServices/registrationAssignments"},
+			},
+		},
+		"azurerm_lighthouse_definition": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions"},
+				Provider:     "Microsoft.ManagedServices",
+				Types:        []string{"registrationDefinitions"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.ManagedServices/registrationDefinitions"},
+			},
+		},
+		"azurerm_linux_function_app": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"},
+			},
+		},
+		"azurerm_linux_function_app_slot": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites", "slots"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/slots"},
+			},
+		},
+		"azurerm_linux_virtual_machine": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"virtualMachines"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines"},
+			},
+		},
+		"azurerm_linux_virtual_machine_scale_set": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"virtualMachineScaleSets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets"},
+			},
+		},
+		"azurerm_linux_web_app": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"},
+			},
+		},
+		"azurerm_linux_web_app_slot": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites", "slots"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/slots"},
+			},
+		},
+		"azurerm_load_test": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.LoadTestService",
+				Types:        []string{"loadTests"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.LoadTestService/loadTests"},
+			},
+		},
+		"azurerm_local_network_gateway": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"localNetworkGateways"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/localNetworkGateways"},
+			},
+		},
+		"azurerm_log_analytics_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.OperationalInsights",
+				Types:        []string{"clusters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/clusters"},
+			},
+		},
+		"azurerm_log_analytics_cluster_customer_managed_key": {
+			IsRemoved:    true,
+			RemoveReason: "This is a property rather than a resource",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.OperationalInsights",
+				Types:        []string{"clusters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/clusters"},
+			},
+		},
+		"azurerm_log_analytics_data_export_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.OperationalInsights",
+				Types:        []string{"workspaces", "dataExports"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/dataExports"},
+			},
+		},
+		"azurerm_log_analytics_datasource_windows_event": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.OperationalInsights",
+				Types:        []string{"workspaces", "dataSources"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/dataSources"},
+			},
+		},
+		"azurerm_log_analytics_datasource_windows_performance_counter": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.OperationalInsights",
+				Types:        []string{"workspaces", "dataSources"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/dataSources"},
+			},
+		},
+		"azurerm_log_analytics_linked_service": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.OperationalInsights",
+				Types:        []string{"workspaces", "linkedServices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/linkedServices"},
+			},
+		},
+		"azurerm_log_analytics_linked_storage_account": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.OperationalInsights",
+				Types:        []string{"workspaces", "linkedStorageAccounts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/linkedStorageAccounts"},
+			},
+		},
+		"azurerm_log_analytics_query_pack": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.OperationalInsights",
+				Types:        []string{"queryPacks"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/queryPacks"},
+			},
+		},
+		"azurerm_log_analytics_query_pack_query": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.OperationalInsights",
+				Types:        []string{"queryPacks", "queries"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/queryPacks/queries"},
+			},
+		},
+		"azurerm_log_analytics_saved_search": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.OperationalInsights",
+				Types:        []string{"workspaces", "savedSearches"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/savedSearches"},
+			},
+		},
+		"azurerm_log_analytics_solution": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.OperationsManagement",
+				Types:        []string{"solutions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationsManagement/solutions"},
+			},
+		},
+		"azurerm_log_analytics_storage_insights": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.OperationalInsights",
+				Types:        []string{"workspaces", "storageInsightConfigs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/storageInsightConfigs"},
+			},
+		},
+		"azurerm_log_analytics_workspace": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.OperationalInsights",
+				Types:        []string{"workspaces"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+			},
+		},
+		"azurerm_logic_app_action_custom": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Logic",
+				Types:        []string{"workflows", "actions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Logic/workflows/actions"},
+			},
+		},
+		"azurerm_logic_app_action_http": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Logic",
+				Types:        []string{"workflows", "actions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Logic/workflows/actions"},
+			},
+		},
+		"azurerm_logic_app_integration_account": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Logic",
+				Types:        []string{"integrationAccounts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts"},
+			},
+		},
+		"azurerm_logic_app_integration_account_agreement": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Logic",
+				Types:        []string{"integrationAccounts", "agreements"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/agreements"},
+			},
+		},
+		"azurerm_logic_app_integration_account_assembly": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Logic",
+				Types:        []string{"integrationAccounts", "assemblies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/assemblies"},
+			},
+		},
+		"azurerm_logic_app_integration_account_batch_configuration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Logic",
+				Types:        []string{"integrationAccounts", "batchConfigurations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/batchConfigurations"},
+			},
+		},
+		"azurerm_logic_app_integration_account_certificate": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Logic",
+				Types:        []string{"integrationAccounts", "certificates"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/certificates"},
+			},
+		},
+		"azurerm_logic_app_integration_account_map": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Logic",
+				Types:        []string{"integrationAccounts", "maps"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/maps"},
+			},
+		},
+		"azurerm_logic_app_integration_account_partner": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Logic",
+				Types:        []string{"integrationAccounts", "partners"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/partners"},
+			},
+		},
+		"azurerm_logic_app_integration_account_schema": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Logic",
+				Types:        []string{"integrationAccounts", "schemas"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/schemas"},
+			},
+		},
+		"azurerm_logic_app_integration_account_session": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Logic",
+				Types:        []string{"integrationAccounts", "sessions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/sessions"},
+			},
+		},
+		"azurerm_logic_app_standard": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"},
+			},
+		},
+		"azurerm_logic_app_trigger_custom": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Logic",
+				Types:        []string{"workflows", "triggers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Logic/workflows/triggers"},
+			},
+		},
+		"azurerm_logic_app_trigger_http_request": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Logic",
+				Types:        []string{"workflows", "triggers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Logic/workflows/triggers"},
+			},
+		},
+		"azurerm_logic_app_trigger_recurrence": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Logic",
+				Types:        []string{"workflows", "triggers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Logic/workflows/triggers"},
+			},
+		},
+		"azurerm_logic_app_workflow": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Logic",
+				Types:        []string{"workflows"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Logic/workflows"},
+			},
+		},
+		"azurerm_machine_learning_compute_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MachineLearningServices",
+				Types:        []string{"workspaces", "computes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/computes"},
+			},
+		},
+		"azurerm_machine_learning_compute_instance": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MachineLearningServices",
+				Types:        []string{"workspaces", "computes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/computes"},
+			},
+		},
+		"azurerm_machine_learning_datastore_blobstorage": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MachineLearningServices",
+				Types:        []string{"workspaces", "dataStores"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/dataStores"},
+			},
+		},
+		"azurerm_machine_learning_datastore_datalake_gen2": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MachineLearningServices",
+				Types:        []string{"workspaces", "dataStores"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/dataStores"},
+			},
+		},
+		"azurerm_machine_learning_datastore_fileshare": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MachineLearningServices",
+				Types:        []string{"workspaces", "dataStores"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/dataStores"},
+			},
+		},
+		"azurerm_machine_learning_inference_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MachineLearningServices",
+				Types:        []string{"workspaces", "computes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/computes"},
+			},
+		},
+		"azurerm_machine_learning_synapse_spark": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MachineLearningServices",
+				Types:        []string{"workspaces", "computes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/computes"},
+			},
+		},
+		"azurerm_machine_learning_workspace": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MachineLearningServices",
+				Types:        []string{"workspaces"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces"},
+			},
+		},
+		"azurerm_machine_learning_workspace_network_outbound_rule_fqdn": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MachineLearningServices",
+				Types:        []string{"workspaces", "outboundRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/outboundRules"},
+			},
+		},
+		"azurerm_machine_learning_workspace_network_outbound_rule_private_endpoint": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MachineLearningServices",
+				Types:        []string{"workspaces", "outboundRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/outboundRules"},
+			},
+		},
+		"azurerm_machine_learning_workspace_network_outbound_rule_service_tag": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MachineLearningServices",
+				Types:        []string{"workspaces", "outboundRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/outboundRules"},
+			},
+		},
+		"azurerm_maintenance_assignment_dedicated_host": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Compute/hostGroups/hosts"},
+				Provider:     "Microsoft.Maintenance",
+				Types:        []string{"configurationAssignments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/hostGroups/hosts/Microsoft.Maintenance/configurationAssignments"},
+			},
+		},
+		"azurerm_maintenance_assignment_dynamic_scope": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions"},
+				Provider:     "Microsoft.Maintenance",
+				Types:        []string{"configurationAssignments"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.Maintenance/configurationAssignments"},
+			},
+		},
+		"azurerm_maintenance_assignment_virtual_machine": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines"},
+				Provider:     "Microsoft.Maintenance",
+				Types:        []string{"configurationAssignments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/Microsoft.Maintenance/configurationAssignments"},
+			},
+		},
+		"azurerm_maintenance_assignment_virtual_machine_scale_set": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets"},
+				Provider:     "Microsoft.Maintenance",
+				Types:        []string{"configurationAssignments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets/Microsoft.Maintenance/configurationAssignments"},
+			},
+		},
+		"azurerm_maintenance_configuration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Maintenance",
+				Types:        []string{"maintenanceConfigurations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Maintenance/maintenanceConfigurations"},
+			},
+		},
+		"azurerm_managed_application": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Solutions",
+				Types:        []string{"applications"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Solutions/applications"},
+			},
+		},
+		"azurerm_managed_application_definition": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Solutions",
+				Types:        []string{"applicationDefinitions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Solutions/applicationDefinitions"},
+			},
+		},
+		"azurerm_managed_disk": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"disks"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/disks"},
+			},
+		},
+		"azurerm_managed_disk_sas_token": {
+			IsRemoved:    true,
+			RemoveReason: "This is a property rather than a resource",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"disks"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/disks"},
+			},
+		},
+		"azurerm_managed_lustre_file_system": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StorageCache",
+				Types:        []string{"amlFilesystems"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StorageCache/amlFilesystems"},
+			},
+		},
+		"azurerm_management_group": {
+			ManagementPlane: &MapManagementPlane{
+				Provider:    "Microsoft.Management",
+				Types:       []string{"managementGroups"},
+				ImportSpecs: []string{"/Microsoft.Management/managementGroups"},
+			},
+		},
+		"azurerm_management_group_policy_assignment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/Microsoft.Management/managementGroups"},
+				Provider:     "Microsoft.Authorization",
+				Types:        []string{"policyAssignments"},
+				ImportSpecs:  []string{"/Microsoft.Management/managementGroups/Microsoft.Authorization/policyAssignments"},
+			},
+		},
+		"azurerm_management_group_policy_exemption": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/Microsoft.Management/managementGroups"},
+				Provider:     "Microsoft.Authorization",
+				Types:        []string{"policyExemptions"},
+				ImportSpecs:  []string{"/Microsoft.Management/managementGroups/Microsoft.Authorization/policyExemptions"},
+			},
+		},
+		"azurerm_management_group_policy_remediation": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/Microsoft.Management/managementGroups"},
+				Provider:     "Microsoft.PolicyInsights",
+				Types:        []string{"remediations"},
+				ImportSpecs:  []string{"/Microsoft.Management/managementGroups/Microsoft.PolicyInsights/remediations"},
+			},
+		},
+		"azurerm_management_group_template_deployment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/Microsoft.Management/managementGroups"},
+				Provider:     "Microsoft.Resources",
+				Types:        []string{"deployments"},
+				ImportSpecs:  []string{"/Microsoft.Management/managementGroups/Microsoft.Resources/deployments"},
+			},
+		},
+		"azurerm_management_lock": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"any"},
+				Provider:     "Microsoft.Authorization",
+				Types:        []string{"locks"},
+			},
+		},
+		"azurerm_maps_account": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Maps",
+				Types:        []string{"accounts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Maps/accounts"},
+			},
+		},
+		"azurerm_maps_creator": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Maps",
+				Types:        []string{"accounts", "creators"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Maps/accounts/creators"},
+			},
+		},
+		"azurerm_marketplace_agreement": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions"},
+				Provider:     "Microsoft.MarketplaceOrdering",
+				Types:        []string{"agreements", "offers", "plans"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.MarketplaceOrdering/agreements/offers/plans"},
+			},
+		},
+		"azurerm_marketplace_role_assignment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/Microsoft.Marketplace"},
+				Provider:     "Microsoft.Authorization",
+				Types:        []string{"roleAssignments"},
+				ImportSpecs:  []string{"/Microsoft.Marketplace/Microsoft.Authorization/roleAssignments"},
+			},
+		},
+		"azurerm_mobile_network": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MobileNetwork",
+				Types:        []string{"mobileNetworks"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MobileNetwork/mobileNetworks"},
+			},
+		},
+		"azurerm_mobile_network_attached_data_network": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MobileNetwork",
+				Types:        []string{"packetCoreControlPlanes", "packetCoreDataPlanes", "attachedDataNetworks"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MobileNetwork/packetCoreControlPlanes/packetCoreDataPlanes/attachedDataNetworks"},
+			},
+		},
+		"azurerm_mobile_network_data_network": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MobileNetwork",
+				Types:        []string{"mobileNetworks", "dataNetworks"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MobileNetwork/mobileNetworks/dataNetworks"},
+			},
+		},
+		"azurerm_mobile_network_packet_core_control_plane": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MobileNetwork",
+				Types:        []string{"packetCoreControlPlanes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MobileNetwork/packetCoreControlPlanes"},
+			},
+		},
+		"azurerm_mobile_network_packet_core_data_plane": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MobileNetwork",
+				Types:        []string{"packetCoreControlPlanes", "packetCoreDataPlanes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MobileNetwork/packetCoreControlPlanes/packetCoreDataPlanes"},
+			},
+		},
+		"azurerm_mobile_network_service": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MobileNetwork",
+				Types:        []string{"mobileNetworks", "services"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MobileNetwork/mobileNetworks/services"},
+			},
+		},
+		"azurerm_mobile_network_sim": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MobileNetwork",
+				Types:        []string{"simGroups", "sims"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MobileNetwork/simGroups/sims"},
+			},
+		},
+		"azurerm_mobile_network_sim_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MobileNetwork",
+				Types:        []string{"simGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MobileNetwork/simGroups"},
+			},
+		},
+		"azurerm_mobile_network_sim_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MobileNetwork",
+				Types:        []string{"mobileNetworks", "simPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MobileNetwork/mobileNetworks/simPolicies"},
+			},
+		},
+		"azurerm_mobile_network_site": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MobileNetwork",
+				Types:        []string{"mobileNetworks", "sites"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MobileNetwork/mobileNetworks/sites"},
+			},
+		},
+		"azurerm_mobile_network_slice": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MobileNetwork",
+				Types:        []string{"mobileNetworks", "slices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MobileNetwork/mobileNetworks/slices"},
+			},
+		},
+		"azurerm_mongo_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DocumentDB",
+				Types:        []string{"mongoClusters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/mongoClusters"},
+			},
+		},
+		"azurerm_monitor_aad_diagnostic_setting": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/"},
+				Provider:     "Microsoft.AADIAM",
+				Types:        []string{"diagnosticSettings"},
+				ImportSpecs:  []string{"/Microsoft.AADIAM/diagnosticSettings"},
+			},
+		},
+		"azurerm_monitor_action_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Insights",
+				Types:        []string{"actionGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Insights/actionGroups"},
+			},
+		},
+		"azurerm_monitor_activity_log_alert": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Insights",
+				Types:        []string{"activityLogAlerts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Insights/activityLogAlerts"},
+			},
+		},
+		"azurerm_monitor_alert_processing_rule_action_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AlertsManagement",
+				Types:        []string{"actionRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AlertsManagement/actionRules"},
+			},
+		},
+		"azurerm_monitor_alert_processing_rule_suppression": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AlertsManagement",
+				Types:        []string{"actionRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AlertsManagement/actionRules"},
+			},
+		},
+		"azurerm_monitor_alert_prometheus_rule_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AlertsManagement",
+				Types:        []string{"prometheusRuleGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AlertsManagement/prometheusRuleGroups"},
+			},
+		},
+		"azurerm_monitor_autoscale_setting": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Insights",
+				Types:        []string{"autoScaleSettings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Insights/autoScaleSettings"},
+			},
+		},
+		"azurerm_monitor_data_collection_endpoint": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Insights",
+				Types:        []string{"dataCollectionEndpoints"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Insights/dataCollectionEndpoints"},
+			},
+		},
+		"azurerm_monitor_data_collection_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Insights",
+				Types:        []string{"dataCollectionRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Insights/dataCollectionRules"},
+			},
+		},
+		"azurerm_monitor_data_collection_rule_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines"},
+				Provider:     "Microsoft.Insights",
+				Types:        []string{"dataCollectionRuleAssociations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/Microsoft.Insights/dataCollectionRuleAssociations"},
+			},
+		},
+		"azurerm_monitor_diagnostic_setting": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"any"},
+				Provider:     "Microsoft.Insights",
+				Types:        []string{"diagnosticSettings"},
+			},
+		},
+		"azurerm_monitor_metric_alert": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Insights",
+				Types:        []string{"metricAlerts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Insights/metricAlerts"},
+			},
+		},
+		"azurerm_monitor_private_link_scope": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Insights",
+				Types:        []string{"privateLinkScopes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Insights/privateLinkScopes"},
+			},
+		},
+		"azurerm_monitor_private_link_scoped_service": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Insights",
+				Types:        []string{"privateLinkScopes", "scopedResources"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Insights/privateLinkScopes/scopedResources"},
+			},
+		},
+		"azurerm_monitor_scheduled_query_rules_alert": {
+			IsRemoved:    true,
+			RemoveReason: "This is deprecated in favor of `azurerm_monitor_scheduled_query_rules_alert_v2`",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Insights",
+				Types:        []string{"scheduledQueryRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Insights/scheduledQueryRules"},
+			},
+		},
+		"azurerm_monitor_scheduled_query_rules_alert_v2": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Insights",
+				Types:        []string{"scheduledQueryRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Insights/scheduledQueryRules"},
+			},
+		},
+		"azurerm_monitor_scheduled_query_rules_log": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Insights",
+				Types:        []string{"scheduledQueryRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Insights/scheduledQueryRules"},
+			},
+		},
+		"azurerm_monitor_smart_detector_alert_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AlertsManagement",
+				Types:        []string{"smartDetectorAlertRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AlertsManagement/smartDetectorAlertRules"},
+			},
+		},
+		"azurerm_monitor_workspace": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Monitor",
+				Types:        []string{"accounts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Monitor/accounts"},
+			},
+		},
+		"azurerm_mssql_database": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"servers", "databases"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/databases"},
+			},
+		},
+		"azurerm_mssql_database_extended_auditing_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"servers", "databases", "extendedAuditingSettings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/databases/extendedAuditingSettings"},
+			},
+		},
+		"azurerm_mssql_database_vulnerability_assessment_rule_baseline": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"servers", "databases", "vulnerabilityAssessments", "rules", "baselines"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/databases/vulnerabilityAssessments/rules/baselines"},
+			},
+		},
+		"azurerm_mssql_elasticpool": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"servers", "elasticPools"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/elasticPools"},
+			},
+		},
+		"azurerm_mssql_failover_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"servers", "failoverGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/failoverGroups"},
+			},
+		},
+		"azurerm_mssql_firewall_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"servers", "firewallRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/firewallRules"},
+			},
+		},
+		"azurerm_mssql_job": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"servers", "jobAgents", "jobs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents/jobs"},
+			},
+		},
+		"azurerm_mssql_job_agent": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"servers", "jobAgents"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents"},
+			},
+		},
+		"azurerm_mssql_job_credential": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"servers", "jobAgents", "credentials"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents/credentials"},
+			},
+		},
+		"azurerm_mssql_job_schedule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"servers", "jobAgents", "jobs", "schedules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents/jobs"},
+			},
+		},
+		"azurerm_mssql_job_step": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"servers", "jobAgents", "jobs", "steps"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents/jobs/steps"},
+			},
+		},
+		"azurerm_mssql_job_target_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"servers", "jobAgents", "targetGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents/targetGroups"},
+			},
+		},
+		"azurerm_mssql_managed_database": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"managedInstances", "databases"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/managedInstances/databases"},
+			},
+		},
+		"azurerm_mssql_managed_instance": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"managedInstances"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/managedInstances"},
+			},
+		},
+		"azurerm_mssql_managed_instance_active_directory_administrator": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"managedInstances", "administrators"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/managedInstances/administrators"},
+			},
+		},
+		"azurerm_mssql_managed_instance_failover_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"locations", "instanceFailoverGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/locations/instanceFailoverGroups"},
+			},
+		},
+		"azurerm_mssql_managed_instance_security_alert_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"managedInstances", "securityAlertPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/managedInstances/securityAlertPolicies"},
+			},
+		},
+		"azurerm_mssql_managed_instance_transparent_data_encryption": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"managedInstances", "encryptionProtector"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/managedInstances/encryptionProtector"},
+			},
+		},
+		"azurerm_mssql_managed_instance_vulnerability_assessment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"managedInstances", "vulnerabilityAssessments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/managedInstances/vulnerabilityAssessments"},
+			},
+		},
+		"azurerm_mssql_outbound_firewall_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"servers", "outboundFirewallRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/outboundFirewallRules"},
+			},
+		},
+		"azurerm_mssql_server": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"servers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers"},
+			},
+		},
+		"azurerm_mssql_server_dns_alias": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"servers", "dnsAliases"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/dnsAliases"},
+			},
+		},
+		"azurerm_mssql_server_extended_auditing_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"servers", "extendedAuditingSettings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/extendedAuditingSettings"},
+			},
+		},
+		"azurerm_mssql_server_microsoft_support_auditing_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"servers", "devOpsAuditingSettings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/devOpsAuditingSettings"},
+			},
+		},
+		"azurerm_mssql_server_security_alert_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"servers", "securityAlertPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/securityAlertPolicies"},
+			},
+		},
+		"azurerm_mssql_server_transparent_data_encryption": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"servers", "encryptionProtector"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/encryptionProtector"},
+			},
+		},
+		"azurerm_mssql_server_vulnerability_assessment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"servers", "vulnerabilityAssessments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/vulnerabilityAssessments"},
+			},
+		},
+		"azurerm_mssql_virtual_machine": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.SqlVirtualMachine",
+				Types:        []string{"sqlVirtualMachines"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.SqlVirtualMachine/sqlVirtualMachines"},
+			},
+		},
+		"azurerm_mssql_virtual_machine_availability_group_listener": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.SqlVirtualMachine",
+				Types:        []string{"sqlVirtualMachineGroups", "availabilityGroupListeners"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.SqlVirtualMachine/sqlVirtualMachineGroups/availabilityGroupListeners"},
+			},
+		},
+		"azurerm_mssql_virtual_machine_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.SqlVirtualMachine",
+				Types:        []string{"sqlVirtualMachineGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.SqlVirtualMachine/sqlVirtualMachineGroups"},
+			},
+		},
+		"azurerm_mssql_virtual_network_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Sql",
+				Types:        []string{"servers", "virtualNetworkRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/virtualNetworkRules"},
+			},
+		},
+		"azurerm_mysql_flexible_database": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DBforMySQL",
+				Types:        []string{"flexibleServers", "databases"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DBforMySQL/flexibleServers/databases"},
+			},
+		},
+		"azurerm_mysql_flexible_server": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DBforMySQL",
+				Types:        []string{"flexibleServers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DBforMySQL/flexibleServers"},
+			},
+		},
+		"azurerm_mysql_flexible_server_active_directory_administrator": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DBforMySQL",
+				Types:        []string{"flexibleServers", "administrators"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DBforMySQL/flexibleServers/administrators"},
+			},
+		},
+		"azurerm_mysql_flexible_server_configuration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DBforMySQL",
+				Types:        []string{"flexibleServers", "configurations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DBforMySQL/flexibleServers/configurations"},
+			},
+		},
+		"azurerm_mysql_flexible_server_firewall_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DBforMySQL",
+				Types:        []string{"flexibleServers", "firewallRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DBforMySQL/flexibleServers/firewallRules"},
+			},
+		},
+		"azurerm_nat_gateway": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"natGateways"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/natGateways"},
+			},
+		},
+		"azurerm_nat_gateway_public_ip_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"natGateways", "publicIPAddresses"},
+			},
+		},
+		"azurerm_nat_gateway_public_ip_prefix_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"natGateways", "publicIPPrefixes"},
+			},
+		},
+		"azurerm_netapp_account": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.NetApp",
+				Types:        []string{"netAppAccounts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts"},
+			},
+		},
+		"azurerm_netapp_account_encryption": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.NetApp",
+				Types:        []string{"netAppAccounts", "encryptions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts"},
+			},
+		},
+		"azurerm_netapp_backup_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.NetApp",
+				Types:        []string{"netAppAccounts", "backupPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/backupPolicies"},
+			},
+		},
+		"azurerm_netapp_backup_vault": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.NetApp",
+				Types:        []string{"netAppAccounts", "backupVaults"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/backupVaults"},
+			},
+		},
+		"azurerm_netapp_pool": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.NetApp",
+				Types:        []string{"netAppAccounts", "capacityPools"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/capacityPools"},
+			},
+		},
+		"azurerm_netapp_snapshot": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.NetApp",
+				Types:        []string{"netAppAccounts", "capacityPools", "volumes", "snapshots"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/capacityPools/volumes/snapshots"},
+			},
+		},
+		"azurerm_netapp_snapshot_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.NetApp",
+				Types:        []string{"netAppAccounts", "snapshotPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/snapshotPolicies"},
+			},
+		},
+		"azurerm_netapp_volume": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.NetApp",
+				Types:        []string{"netAppAccounts", "capacityPools", "volumes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/capacityPools/volumes"},
+			},
+		},
+		"azurerm_netapp_volume_group_oracle": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.NetApp",
+				Types:        []string{"netAppAccounts", "volumeGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/volumeGroups"},
+			},
+		},
+		"azurerm_netapp_volume_group_sap_hana": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.NetApp",
+				Types:        []string{"netAppAccounts", "volumeGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/volumeGroups"},
+			},
+		},
+		"azurerm_netapp_volume_quota_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.NetApp",
+				Types:        []string{"netAppAccounts", "capacityPools", "volumes", "volumeQuotaRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/capacityPools/volumes/volumeQuotaRules"},
+			},
+		},
+		"azurerm_network_connection_monitor": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkWatchers", "connectionMonitors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/networkWatchers/connectionMonitors"},
+			},
+		},
+		"azurerm_network_ddos_protection_plan": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"ddosProtectionPlans"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/ddosProtectionPlans"},
+			},
+		},
+		"azurerm_network_function_azure_traffic_collector": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.NetworkFunction",
+				Types:        []string{"azureTrafficCollectors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.NetworkFunction/azureTrafficCollectors"},
+			},
+		},
+		"azurerm_network_function_collector_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.NetworkFunction",
+				Types:        []string{"azureTrafficCollectors", "collectorPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.NetworkFunction/azureTrafficCollectors/collectorPolicies"},
+			},
+		},
+		"azurerm_network_interface": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkInterfaces"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/networkInterfaces"},
+			},
+		},
+		"azurerm_network_interface_application_gateway_backend_address_pool_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkInterfaces", "ipConfigurations", "applicationGatewayBackendAddressPools"},
+			},
+		},
+		"azurerm_network_interface_application_security_group_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkInterfaces", "ipConfigurations", "applicationSecurityGroups"},
+			},
+		},
+		"azurerm_network_interface_backend_address_pool_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkInterfaces", "ipConfigurations", "loadBalancerBackendAddressPools"},
+			},
+		},
+		"azurerm_network_interface_nat_rule_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkInterfaces", "ipConfigurations", "loadBalancerInboundNatRules"},
+			},
+		},
+		"azurerm_network_interface_security_group_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkInterfaces", "networkSecurityGroups"},
+			},
+		},
+		"azurerm_network_manager": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkManagers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers"},
+			},
+		},
+		"azurerm_network_manager_admin_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkManagers", "securityAdminConfigurations", "ruleCollections", "rules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers/securityAdminConfigurations/ruleCollections/rules"},
+			},
+		},
+		"azurerm_network_manager_admin_rule_collection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkManagers", "securityAdminConfigurations", "ruleCollections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers/securityAdminConfigurations/ruleCollections"},
+			},
+		},
+		"azurerm_network_manager_connectivity_configuration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkManagers", "connectivityConfigurations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers/connectivityConfigurations"},
+			},
+		},
+		"azurerm_network_manager_deployment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkManagers", "locations", "types"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers"},
+			},
+		},
+		"azurerm_network_manager_ipam_pool": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkManagers", "ipamPools"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers/ipamPools"},
+			},
+		},
+		"azurerm_network_manager_management_group_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/Microsoft.Management/managementGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkManagerConnections"},
+				ImportSpecs:  []string{"/Microsoft.Management/managementGroups/Microsoft.Network/networkManagerConnections"},
+			},
+		},
+		"azurerm_network_manager_network_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkManagers", "networkGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers/networkGroups"},
+			},
+		},
+		"azurerm_network_manager_scope_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkManagers", "scopeConnections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers/scopeConnections"},
+			},
+		},
+		"azurerm_network_manager_security_admin_configuration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkManagers", "securityAdminConfigurations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers/securityAdminConfigurations"},
+			},
+		},
+		"azurerm_network_manager_static_member": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkManagers", "networkGroups", "staticMembers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers/networkGroups/staticMembers"},
+			},
+		},
+		"azurerm_network_manager_subscription_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkManagerConnections"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.Network/networkManagerConnections"},
+			},
+		},
+		"azurerm_network_manager_verifier_workspace": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkManagers", "verifierWorkspaces"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers/verifierWorkspaces"},
+			},
+		},
+		"azurerm_network_profile": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkProfiles"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/networkProfiles"},
+			},
+		},
+		"azurerm_network_security_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkSecurityGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/networkSecurityGroups"},
+			},
+		},
+		"azurerm_network_security_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkSecurityGroups", "securityRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/networkSecurityGroups/securityRules"},
+			},
+		},
+		"azurerm_network_watcher": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkWatchers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/networkWatchers"},
+			},
+		},
+		"azurerm_network_watcher_flow_log": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkWatchers", "flowLogs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/networkWatchers/flowLogs"},
+			},
+		},
+		"azurerm_new_relic_monitor": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "NewRelic.Observability",
+				Types:        []string{"monitors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/NewRelic.Observability/monitors"},
+			},
+		},
+		"azurerm_new_relic_tag_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "NewRelic.Observability",
+				Types:        []string{"monitors", "tagRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/NewRelic.Observability/monitors/tagRules"},
+			},
+		},
+		"azurerm_nginx_certificate": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Nginx.NginxPlus",
+				Types:        []string{"nginxDeployments", "certificates"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Nginx.NginxPlus/nginxDeployments/certificates"},
+			},
+		},
+		"azurerm_nginx_configuration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Nginx.NginxPlus",
+				Types:        []string{"nginxDeployments", "configurations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Nginx.NginxPlus/nginxDeployments/configurations"},
+			},
+		},
+		"azurerm_nginx_deployment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Nginx.NginxPlus",
+				Types:        []string{"nginxDeployments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Nginx.NginxPlus/nginxDeployments"},
+			},
+		},
+		"azurerm_notification_hub": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.NotificationHubs",
+				Types:        []string{"namespaces", "notificationHubs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.NotificationHubs/namespaces/notificationHubs"},
+			},
+		},
+		"azurerm_notification_hub_authorization_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.NotificationHubs",
+				Types:        []string{"namespaces", "notificationHubs", "authorizationRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.NotificationHubs/namespaces/notificationHubs/authorizationRules"},
+			},
+		},
+		"azurerm_notification_hub_namespace": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.NotificationHubs",
+				Types:        []string{"namespaces"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.NotificationHubs/namespaces"},
+			},
+		},
+		"azurerm_oracle_autonomous_database": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Oracle.Database",
+				Types:        []string{"autonomousDatabases"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Oracle.Database/autonomousDatabases"},
+			},
+		},
+		"azurerm_oracle_cloud_vm_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Oracle.Database",
+				Types:        []string{"cloudVmClusters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Oracle.Database/cloudVmClusters"},
+			},
+		},
+		"azurerm_oracle_exadata_infrastructure": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Oracle.Database",
+				Types:        []string{"cloudExadataInfrastructures"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Oracle.Database/cloudExadataInfrastructures"},
+			},
+		},
+		"azurerm_orbital_contact": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Orbital",
+				Types:        []string{"spacecrafts", "contacts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Orbital/spacecrafts/contacts"},
+			},
+		},
+		"azurerm_orbital_contact_profile": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Orbital",
+				Types:        []string{"contactProfiles"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Orbital/contactProfiles"},
+			},
+		},
+		"azurerm_orbital_spacecraft": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Orbital",
+				Types:        []string{"spacecrafts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Orbital/spacecrafts"},
+			},
+		},
+		"azurerm_orchestrated_virtual_machine_scale_set": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"virtualMachineScaleSets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets"},
+			},
+		},
+		"azurerm_palo_alto_local_rulestack": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "PaloAltoNetworks.Cloudngfw",
+				Types:        []string{"localRulestacks"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/localRulestacks"},
+			},
+		},
+		"azurerm_palo_alto_local_rulestack_certificate": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "PaloAltoNetworks.Cloudngfw",
+				Types:        []string{"localRulestacks", "certificates"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/localRulestacks/certificates"},
+			},
+		},
+		"azurerm_palo_alto_local_rulestack_fqdn_list": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "PaloAltoNetworks.Cloudngfw",
+				Types:        []string{"localRulestacks", "fqdnLists"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/localRulestacks/fqdnLists"},
+			},
+		},
+		"azurerm_palo_alto_local_rulestack_prefix_list": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "PaloAltoNetworks.Cloudngfw",
+				Types:        []string{"localRulestacks", "prefixLists"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/localRulestacks/prefixLists"},
+			},
+		},
+		"azurerm_palo_alto_local_rulestack_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "PaloAltoNetworks.Cloudngfw",
+				Types:        []string{"localRulestacks", "localRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/localRulestacks/localRules"},
+			},
+		},
+		"azurerm_palo_alto_next_generation_firewall_virtual_hub_local_rulestack": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "PaloAltoNetworks.Cloudngfw",
+				Types:        []string{"firewalls"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/firewalls"},
+			},
+		},
+		"azurerm_palo_alto_next_generation_firewall_virtual_hub_panorama": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "PaloAltoNetworks.Cloudngfw",
+				Types:        []string{"firewalls"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/firewalls"},
+			},
+		},
+		"azurerm_palo_alto_next_generation_firewall_virtual_network_local_rulestack": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "PaloAltoNetworks.Cloudngfw",
+				Types:        []string{"firewalls"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/firewalls"},
+			},
+		},
+		"azurerm_palo_alto_next_generation_firewall_virtual_network_panorama": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "PaloAltoNetworks.Cloudngfw",
+				Types:        []string{"firewalls"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/firewalls"},
+			},
+		},
+		"azurerm_palo_alto_virtual_network_appliance": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkVirtualAppliances"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/networkVirtualAppliances"},
+			},
+		},
+		"azurerm_point_to_site_vpn_gateway": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"p2sVpnGateways"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/p2sVpnGateways"},
+			},
+		},
+		"azurerm_policy_definition": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions", "/Microsoft.Management/managementGroups"},
+				Provider:     "Microsoft.Authorization",
+				Types:        []string{"policyDefinitions"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.Authorization/policyDefinitions", "/Microsoft.Management/managementgroups/Microsoft.Authorization/policyDefinitions"},
+			},
+		},
+		"azurerm_policy_set_definition": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions", "/Microsoft.Management/managementGroups"},
+				Provider:     "Microsoft.Authorization",
+				Types:        []string{"policySetDefinitions"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.Authorization/policySetDefinitions", "/Microsoft.Management/managementgroups/Microsoft.Authorization/policySetDefinitions"},
+			},
+		},
+		"azurerm_policy_virtual_machine_configuration_assignment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines"},
+				Provider:     "Microsoft.GuestConfiguration",
+				Types:        []string{"guestConfigurationAssignments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/Microsoft.GuestConfiguration/guestConfigurationAssignments"},
+			},
+		},
+		"azurerm_portal_dashboard": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Portal",
+				Types:        []string{"dashboards"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Portal/dashboards"},
+			},
+		},
+		"azurerm_portal_tenant_configuration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/"},
+				Provider:     "Microsoft.Portal",
+				Types:        []string{"tenantConfigurations"},
+				ImportSpecs:  []string{"/Microsoft.Portal/tenantConfigurations"},
+			},
+		},
+		"azurerm_postgresql_active_directory_administrator": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DBforPostgreSQL",
+				Types:        []string{"servers", "administrators"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers"},
+			},
+		},
+		"azurerm_postgresql_configuration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DBforPostgreSQL",
+				Types:        []string{"servers", "configurations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers/configurations"},
+			},
+		},
+		"azurerm_postgresql_database": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DBforPostgreSQL",
+				Types:        []string{"servers", "databases"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers/databases"},
+			},
+		},
+		"azurerm_postgresql_firewall_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DBforPostgreSQL",
+				Types:        []string{"servers", "firewallRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers/firewallRules"},
+			},
+		},
+		"azurerm_postgresql_flexible_server": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DBforPostgreSQL",
+				Types:        []string{"flexibleServers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers"},
+			},
+		},
+		"azurerm_postgresql_flexible_server_active_directory_administrator": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DBforPostgreSQL",
+				Types:        []string{"flexibleServers", "administrators"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers/administrators"},
+			},
+		},
+		"azurerm_postgresql_flexible_server_configuration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DBforPostgreSQL",
+				Types:        []string{"flexibleServers", "configurations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers/configurations"},
+			},
+		},
+		"azurerm_postgresql_flexible_server_database": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DBforPostgreSQL",
+				Types:        []string{"flexibleServers", "databases"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers/databases"},
+			},
+		},
+		"azurerm_postgresql_flexible_server_firewall_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DBforPostgreSQL",
+				Types:        []string{"flexibleServers", "firewallRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers/firewallRules"},
+			},
+		},
+		"azurerm_postgresql_flexible_server_virtual_endpoint": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DBforPostgreSQL",
+				Types:        []string{"flexibleServers", "virtualEndpoints"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers/virtualEndpoints"},
+			},
+		},
+		"azurerm_postgresql_server": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DBforPostgreSQL",
+				Types:        []string{"servers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers"},
+			},
+		},
+		"azurerm_postgresql_server_key": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DBforPostgreSQL",
+				Types:        []string{"servers", "keys"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers/keys"},
+			},
+		},
+		"azurerm_postgresql_virtual_network_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DBforPostgreSQL",
+				Types:        []string{"servers", "virtualNetworkRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers/virtualNetworkRules"},
+			},
+		},
+		"azurerm_powerbi_embedded": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.PowerBIDedicated",
+				Types:        []string{"capacities"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.PowerBIDedicated/capacities"},
+			},
+		},
+		"azurerm_private_dns_a_record": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"privateDnsZones", "A"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/A"},
+			},
+		},
+		"azurerm_private_dns_aaaa_record": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"privateDnsZones", "AAAA"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/AAAA"},
+			},
+		},
+		"azurerm_private_dns_cname_record": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"privateDnsZones", "CNAME"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/CNAME"},
+			},
+		},
+		"azurerm_private_dns_mx_record": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"privateDnsZones", "MX"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/MX"},
+			},
+		},
+		"azurerm_private_dns_ptr_record": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"privateDnsZones", "PTR"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/PTR"},
+			},
+		},
+		"azurerm_private_dns_resolver": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"dnsResolvers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsResolvers"},
+			},
+		},
+		"azurerm_private_dns_resolver_dns_forwarding_ruleset": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"dnsForwardingRulesets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsForwardingRulesets"},
+			},
+		},
+		"azurerm_private_dns_resolver_forwarding_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"dnsForwardingRulesets", "forwardingRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsForwardingRulesets/forwardingRules"},
+			},
+		},
+		"azurerm_private_dns_resolver_inbound_endpoint": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"dnsResolvers", "inboundEndpoints"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsResolvers/inboundEndpoints"},
+			},
+		},
+		"azurerm_private_dns_resolver_outbound_endpoint": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"dnsResolvers", "outboundEndpoints"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsResolvers/outboundEndpoints"},
+			},
+		},
+		"azurerm_private_dns_resolver_virtual_network_link": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"dnsForwardingRulesets", "virtualNetworkLinks"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsForwardingRulesets/virtualNetworkLinks"},
+			},
+		},
+		"azurerm_private_dns_srv_record": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"privateDnsZones", "SRV"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/SRV"},
+			},
+		},
+		"azurerm_private_dns_txt_record": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"privateDnsZones", "TXT"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/TXT"},
+			},
+		},
+		"azurerm_private_dns_zone": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"privateDnsZones"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones"},
+			},
+		},
+		"azurerm_private_dns_zone_virtual_network_link": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"privateDnsZones", "virtualNetworkLinks"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/virtualNetworkLinks"},
+			},
+		},
+		"azurerm_private_endpoint": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"privateEndpoints"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/privateEndpoints"},
+			},
+		},
+		"azurerm_private_endpoint_application_security_group_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"privateEndpoints", "applicationSecurityGroups"},
+			},
+		},
+		"azurerm_private_link_service": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"privateLinkServices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/privateLinkServices"},
+			},
+		},
+		"azurerm_proximity_placement_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"proximityPlacementGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/proximityPlacementGroups"},
+			},
+		},
+		"azurerm_public_ip": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"publicIPAddresses"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/publicIPAddresses"},
+			},
+		},
+		"azurerm_public_ip_prefix": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"publicIPPrefixes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/publicIPPrefixes"},
+			},
+		},
+		"azurerm_purview_account": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Purview",
+				Types:        []string{"accounts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Purview/accounts"},
+			},
+		},
+		"azurerm_recovery_services_vault": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.RecoveryServices",
+				Types:        []string{"vaults"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults"},
+			},
+		},
+		"azurerm_recovery_services_vault_resource_guard_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.RecoveryServices",
+				Types:        []string{"vaults", "backupResourceGuardProxies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupResourceGuardProxies"},
+			},
+		},
+		"azurerm_redhat_openshift_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.RedHatOpenShift",
+				Types:        []string{"openShiftClusters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.RedHatOpenShift/openShiftClusters"},
+			},
+		},
+		"azurerm_redis_cache": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Cache",
+				Types:        []string{"redis"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Cache/redis"},
+			},
+		},
+		"azurerm_redis_cache_access_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Cache",
+				Types:        []string{"redis", "accessPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Cache/redis/accessPolicies"},
+			},
+		},
+		"azurerm_redis_cache_access_policy_assignment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Cache",
+				Types:        []string{"redis", "accessPolicyAssignments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Cache/redis/accessPolicyAssignments"},
+			},
+		},
+		"azurerm_redis_enterprise_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Cache",
+				Types:        []string{"redisEnterprise"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Cache/redisEnterprise"},
+			},
+		},
+		"azurerm_redis_enterprise_database": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Cache",
+				Types:        []string{"redisEnterprise", "databases"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Cache/redisEnterprise/databases"},
+			},
+		},
+		"azurerm_redis_firewall_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Cache",
+				Types:        []string{"redis", "firewallRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Cache/redis/firewallRules"},
+			},
+		},
+		"azurerm_redis_linked_server": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Cache",
+				Types:        []string{"redis", "linkedServers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Cache/redis/linkedServers"},
+			},
+		},
+		"azurerm_relay_hybrid_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Relay",
+				Types:        []string{"namespaces", "hybridConnections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Relay/namespaces/hybridConnections"},
+			},
+		},
+		"azurerm_relay_hybrid_connection_authorization_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Relay",
+				Types:        []string{"namespaces", "hybridConnections", "authorizationRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Relay/namespaces/hybridConnections/authorizationRules"},
+			},
+		},
+		"azurerm_relay_namespace": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Relay",
+				Types:        []string{"namespaces"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Relay/namespaces"},
+			},
+		},
+		"azurerm_relay_namespace_authorization_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Relay",
+				Types:        []string{"namespaces", "authorizationRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Relay/namespaces/authorizationRules"},
+			},
+		},
+		"azurerm_resource_deployment_script_azure_cli": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Resources",
+				Types:        []string{"deploymentScripts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Resources/deploymentScripts"},
+			},
+		},
+		"azurerm_resource_deployment_script_azure_power_shell": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Resources",
+				Types:        []string{"deploymentScripts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Resources/deploymentScripts"},
+			},
+		},
+		"azurerm_resource_group": {
+			ManagementPlane: &MapManagementPlane{
+				Provider:    "Microsoft.Resources",
+				Types:       []string{"subscriptions", "resourceGroups"},
+				ImportSpecs: []string{"/subscriptions/resourceGroups"},
+			},
+		},
+		"azurerm_resource_group_cost_management_export": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.CostManagement",
+				Types:        []string{"exports"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.CostManagement/exports"},
+			},
+		},
+		"azurerm_resource_group_cost_management_view": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.CostManagement",
+				Types:        []string{"views"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.CostManagement/views"},
+			},
+		},
+		"azurerm_resource_group_policy_assignment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Authorization",
+				Types:        []string{"policyAssignments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Authorization/policyAssignments"},
+			},
+		},
+		"azurerm_resource_group_policy_exemption": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Authorization",
+				Types:        []string{"policyExemptions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Authorization/policyExemptions"},
+			},
+		},
+		"azurerm_resource_group_policy_remediation": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.PolicyInsights",
+				Types:        []string{"remediations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.PolicyInsights/remediations"},
+			},
+		},
+		"azurerm_resource_group_template_deployment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Resources",
+				Types:        []string{"deployments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Resources/deployments"},
+			},
+		},
+		"azurerm_resource_management_private_link": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Authorization",
+				Types:        []string{"resourceManagementPrivateLinks"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Authorization/resourceManagementPrivateLinks"},
+			},
+		},
+		"azurerm_resource_management_private_link_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/Microsoft.Management/managementGroups"},
+				Provider:     "Microsoft.Authorization",
+				Types:        []string{"privateLinkAssociations"},
+				ImportSpecs:  []string{"/Microsoft.Management/managementGroups/Microsoft.Authorization/privateLinkAssociations"},
+			},
+		},
+		"azurerm_resource_policy_assignment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"any"},
+				Provider:     "Microsoft.Authorization",
+				Types:        []string{"policyAssignments"},
+			},
+		},
+		"azurerm_resource_policy_exemption": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"any"},
+				Provider:     "Microsoft.Authorization",
+				Types:        []string{"policyExemptions"},
+			},
+		},
+		"azurerm_resource_policy_remediation": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"any"},
+				Provider:     "Microsoft.PolicyInsights",
+				Types:        []string{"remediations"},
+			},
+		},
+		"azurerm_resource_provider_registration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions"},
+				Provider:     "Microsoft.PolicyInsights",
+				Types:        []string{},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.PolicyInsights"},
+			},
+		},
+		"azurerm_role_assignment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"any"},
+				Provider:     "Microsoft.Authorization",
+				Types:        []string{"roleAssignments"},
+			},
+		},
+		"azurerm_role_definition": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"any"},
+				Provider:     "Microsoft.Authorization",
+				Types:        []string{"roleDefinitions"},
+			},
+		},
+		"azurerm_role_management_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"any"},
+				Provider:     "Microsoft.Authorization",
+				Types:        []string{"roleManagementPolicies"},
+			},
+		},
+		"azurerm_route": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"routeTables", "routes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/routeTables/routes"},
+			},
+		},
+		"azurerm_route_filter": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"routeFilters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/routeFilters"},
+			},
+		},
+		"azurerm_route_map": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"virtualHubs", "routeMaps"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/routeMaps"},
+			},
+		},
+		"azurerm_route_server": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"virtualHubs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualHubs"},
+			},
+		},
+		"azurerm_route_server_bgp_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"virtualHubs", "bgpConnections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/bgpConnections"},
+			},
+		},
+		"azurerm_route_table": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"routeTables"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/routeTables"},
+			},
+		},
+		"azurerm_search_service": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Search",
+				Types:        []string{"searchServices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Search/searchServices"},
+			},
+		},
+		"azurerm_search_shared_private_link_service": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Search",
+				Types:        []string{"searchServices", "sharedPrivateLinkResources"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Search/searchServices/sharedPrivateLinkResources"},
+			},
+		},
+		"azurerm_security_center_assessment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"any"},
+				Provider:     "Microsoft.Security",
+				Types:        []string{"assessments"},
+			},
+		},
+		"azurerm_security_center_assessment_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions"},
+				Provider:     "Microsoft.Security",
+				Types:        []string{"assessmentMetadata"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.Security/assessmentMetadata"},
+			},
+		},
+		"azurerm_security_center_auto_provisioning": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions"},
+				Provider:     "Microsoft.Security",
+				Types:        []string{"autoProvisioningSettings"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.Security/autoProvisioningSettings"},
+			},
+		},
+		"azurerm_security_center_automation": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Security",
+				Types:        []string{"automations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Security/automations"},
+			},
+		},
+		"azurerm_security_center_contact": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions"},
+				Provider:     "Microsoft.Security",
+				Types:        []string{"securityContacts"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.Security/securityContacts"},
+			},
+		},
+		"azurerm_security_center_server_vulnerability_assessment_virtual_machine": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines"},
+				Provider:     "Microsoft.Security",
+				Types:        []string{"serverVulnerabilityAssessments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/Microsoft.Security/serverVulnerabilityAssessments"},
+			},
+		},
+		"azurerm_security_center_server_vulnerability_assessments_setting": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions"},
+				Provider:     "Microsoft.Security",
+				Types:        []string{"serverVulnerabilityAssessmentsSettings"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.Security/serverVulnerabilityAssessmentsSettings"},
+			},
+		},
+		"azurerm_security_center_setting": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions"},
+				Provider:     "Microsoft.Security",
+				Types:        []string{"settings"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.Security/settings"},
+			},
+		},
+		"azurerm_security_center_storage_defender": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"},
+				Provider:     "Microsoft.Security",
+				Types:        []string{"defenderForStorageSettings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/Microsoft.Security/defenderForStorageSettings"},
+			},
+		},
+		"azurerm_security_center_subscription_pricing": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions"},
+				Provider:     "Microsoft.Security",
+				Types:        []string{"pricings"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.Security/pricings"},
+			},
+		},
+		"azurerm_security_center_workspace": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions"},
+				Provider:     "Microsoft.Security",
+				Types:        []string{"workspaceSettings"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.Security/workspaceSettings"},
+			},
+		},
+		"azurerm_sentinel_alert_rule_anomaly_built_in": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"securityMLAnalyticsSettings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/securityMLAnalyticsSettings"},
+			},
+		},
+		"azurerm_sentinel_alert_rule_anomaly_duplicate": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"securityMLAnalyticsSettings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/securityMLAnalyticsSettings"},
+			},
+		},
+		"azurerm_sentinel_alert_rule_fusion": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"alertRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"},
+			},
+		},
+		"azurerm_sentinel_alert_rule_machine_learning_behavior_analytics": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"alertRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"},
+			},
+		},
+		"azurerm_sentinel_alert_rule_ms_security_incident": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"alertRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"},
+			},
+		},
+		"azurerm_sentinel_alert_rule_nrt": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"alertRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"},
+			},
+		},
+		"azurerm_sentinel_alert_rule_scheduled": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"alertRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"},
+			},
+		},
+		"azurerm_sentinel_alert_rule_threat_intelligence": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"alertRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"},
+			},
+		},
+		"azurerm_sentinel_automation_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"automationRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/automationRules"},
+			},
+		},
+		"azurerm_sentinel_data_connector_aws_cloud_trail": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"dataConnectors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+			},
+		},
+		"azurerm_sentinel_data_connector_aws_s3": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"dataConnectors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+			},
+		},
+		"azurerm_sentinel_data_connector_azure_active_directory": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"dataConnectors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+			},
+		},
+		"azurerm_sentinel_data_connector_azure_advanced_threat_protection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"dataConnectors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+			},
+		},
+		"azurerm_sentinel_data_connector_azure_security_center": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"dataConnectors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+			},
+		},
+		"azurerm_sentinel_data_connector_dynamics_365": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"dataConnectors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+			},
+		},
+		"azurerm_sentinel_data_connector_iot": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"dataConnectors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+			},
+		},
+		"azurerm_sentinel_data_connector_microsoft_cloud_app_security": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"dataConnectors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+			},
+		},
+		"azurerm_sentinel_data_connector_microsoft_defender_advanced_threat_protection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"dataConnectors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+			},
+		},
+		"azurerm_sentinel_data_connector_microsoft_threat_intelligence": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"dataConnectors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+			},
+		},
+		"azurerm_sentinel_data_connector_microsoft_threat_protection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"dataConnectors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+			},
+		},
+		"azurerm_sentinel_data_connector_office_365": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"dataConnectors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+			},
+		},
+		"azurerm_sentinel_data_connector_office_365_project": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"dataConnectors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+			},
+		},
+		"azurerm_sentinel_data_connector_office_atp": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"dataConnectors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+			},
+		},
+		"azurerm_sentinel_data_connector_office_irm": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"dataConnectors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+			},
+		},
+		"azurerm_sentinel_data_connector_office_power_bi": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"dataConnectors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+			},
+		},
+		"azurerm_sentinel_data_connector_threat_intelligence": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"dataConnectors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+			},
+		},
+		"azurerm_sentinel_data_connector_threat_intelligence_taxii": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"dataConnectors"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+			},
+		},
+		"azurerm_sentinel_log_analytics_workspace_onboarding": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"onboardingStates"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/onboardingStates"},
+			},
+		},
+		"azurerm_sentinel_metadata": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"metadata"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/metadata"},
+			},
+		},
+		"azurerm_sentinel_threat_intelligence_indicator": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"threatIntelligence", "indicators"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/threatIntelligence/indicators"},
+			},
+		},
+		"azurerm_sentinel_watchlist": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"watchlists"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/watchlists"},
+			},
+		},
+		"azurerm_sentinel_watchlist_item": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+				Provider:     "Microsoft.SecurityInsights",
+				Types:        []string{"watchlists", "watchlistItems"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/watchlists/watchlistItems"},
+			},
+		},
+		"azurerm_service_fabric_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ServiceFabric",
+				Types:        []string{"clusters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ServiceFabric/clusters"},
+			},
+		},
+		"azurerm_service_fabric_managed_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ServiceFabric",
+				Types:        []string{"managedClusters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ServiceFabric/managedClusters"},
+			},
+		},
+		"azurerm_service_plan": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"serverFarms"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/serverFarms"},
+			},
+		},
+		"azurerm_servicebus_namespace": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ServiceBus",
+				Types:        []string{"namespaces"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces"},
+			},
+		},
+		"azurerm_servicebus_namespace_authorization_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ServiceBus",
+				Types:        []string{"namespaces", "authorizationRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/authorizationRules"},
+			},
+		},
+		"azurerm_servicebus_namespace_customer_managed_key": {
+			IsRemoved:    true,
+			RemoveReason: "This is a property rather than a resource",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ServiceBus",
+				Types:        []string{"namespaces"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces"},
+			},
+		},
+		"azurerm_servicebus_namespace_disaster_recovery_config": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ServiceBus",
+				Types:        []string{"namespaces", "disasterRecoveryConfigs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/disasterRecoveryConfigs"},
+			},
+		},
+		"azurerm_servicebus_queue": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ServiceBus",
+				Types:        []string{"namespaces", "queues"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/queues"},
+			},
+		},
+		"azurerm_servicebus_queue_authorization_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ServiceBus",
+				Types:        []string{"namespaces", "queues", "authorizationRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/queues/authorizationRules"},
+			},
+		},
+		"azurerm_servicebus_subscription": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ServiceBus",
+				Types:        []string{"namespaces", "topics", "subscriptions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/topics/subscriptions"},
+			},
+		},
+		"azurerm_servicebus_subscription_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ServiceBus",
+				Types:        []string{"namespaces", "topics", "subscriptions", "rules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/topics/subscriptions/rules"},
+			},
+		},
+		"azurerm_servicebus_topic": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ServiceBus",
+				Types:        []string{"namespaces", "topics"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/topics"},
+			},
+		},
+		"azurerm_servicebus_topic_authorization_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ServiceBus",
+				Types:        []string{"namespaces", "topics", "authorizationRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/topics/authorizationRules"},
+			},
+		},
+		"azurerm_shared_image": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"galleries", "images"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/galleries/images"},
+			},
+		},
+		"azurerm_shared_image_gallery": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"galleries"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/galleries"},
+			},
+		},
+		"azurerm_shared_image_version": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"galleries", "images", "versions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/galleries/images/versions"},
+			},
+		},
+		"azurerm_signalr_service": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.SignalRService",
+				Types:        []string{"signalR"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/signalR"},
+			},
+		},
+		"azurerm_signalr_service_custom_certificate": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.SignalRService",
+				Types:        []string{"signalR", "customCertificates"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/signalR/customCertificates"},
+			},
+		},
+		"azurerm_signalr_service_custom_domain": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.SignalRService",
+				Types:        []string{"signalR", "customDomains"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/signalR/customDomains"},
+			},
+		},
+		"azurerm_signalr_service_network_acl": {
+			IsRemoved:    true,
+			RemoveReason: "This is a property rather than a resource",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.SignalRService",
+				Types:        []string{"signalR"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/signalR"},
+			},
+		},
+		"azurerm_signalr_shared_private_link_resource": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.SignalRService",
+				Types:        []string{"signalR", "sharedPrivateLinkResources"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/signalR/sharedPrivateLinkResources"},
+			},
+		},
+		"azurerm_site_recovery_fabric": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.RecoveryServices",
+				Types:        []string{"vaults", "replicationFabrics"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics"},
+			},
+		},
+		"azurerm_site_recovery_hyperv_network_mapping": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.RecoveryServices",
+				Types:        []string{"vaults", "replicationFabrics", "replicationNetworks", "replicationNetworkMappings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationNetworks/replicationNetworkMappings"},
+			},
+		},
+		"azurerm_site_recovery_hyperv_replication_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.RecoveryServices",
+				Types:        []string{"vaults", "replicationPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationPolicies"},
+			},
+		},
+		"azurerm_site_recovery_hyperv_replication_policy_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.RecoveryServices",
+				Types:        []string{"vaults", "replicationFabrics", "replicationProtectionContainers", "replicationProtectionContainerMappings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectionContainerMappings"},
+			},
+		},
+		"azurerm_site_recovery_network_mapping": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.RecoveryServices",
+				Types:        []string{"vaults", "replicationFabrics", "replicationNetworks", "replicationNetworkMappings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationNetworks/replicationNetworkMappings"},
+			},
+		},
+		"azurerm_site_recovery_protection_container": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.RecoveryServices",
+				Types:        []string{"vaults", "replicationFabrics", "replicationProtectionContainers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers"},
+			},
+		},
+		"azurerm_site_recovery_protection_container_mapping": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.RecoveryServices",
+				Types:        []string{"vaults", "replicationFabrics", "replicationProtectionContainers", "replicationProtectionContainerMappings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectionContainerMappings"},
+			},
+		},
+		"azurerm_site_recovery_replicated_vm": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.RecoveryServices",
+				Types:        []string{"vaults", "replicationFabrics", "replicationProtectionContainers", "replicationProtectedItems"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectedItems"},
+			},
+		},
+		"azurerm_site_recovery_replication_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.RecoveryServices",
+				Types:        []string{"vaults", "replicationPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationPolicies"},
+			},
+		},
+		"azurerm_site_recovery_replication_recovery_plan": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.RecoveryServices",
+				Types:        []string{"vaults", "replicationRecoveryPlans"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationRecoveryPlans"},
+			},
+		},
+		"azurerm_site_recovery_services_vault_hyperv_site": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.RecoveryServices",
+				Types:        []string{"vaults", "replicationFabrics"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics"},
+			},
+		},
+		"azurerm_site_recovery_vmware_replicated_vm": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.RecoveryServices",
+				Types:        []string{"vaults", "replicationFabrics", "replicationProtectionContainers", "replicationProtectedItems"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectedItems"},
+			},
+		},
+		"azurerm_site_recovery_vmware_replication_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.RecoveryServices",
+				Types:        []string{"vaults", "replicationPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationPolicies"},
+			},
+		},
+		"azurerm_site_recovery_vmware_replication_policy_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.RecoveryServices",
+				Types:        []string{"vaults", "replicationFabrics", "replicationProtectionContainers", "replicationProtectionContainerMappings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectionContainerMappings"},
+			},
+		},
+		"azurerm_snapshot": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"snapshots"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/snapshots"},
+			},
+		},
+		"azurerm_source_control_token": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sourceControls"},
+				ImportSpecs:  []string{"/Microsoft.Web/sourceControls"},
+			},
+		},
+		"azurerm_spatial_anchors_account": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.MixedReality",
+				Types:        []string{"spatialAnchorsAccounts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.MixedReality/spatialAnchorsAccounts"},
+			},
+		},
+		"azurerm_spring_cloud_accelerator": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "applicationAccelerators"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/applicationAccelerators"},
+			},
+		},
+		"azurerm_spring_cloud_active_deployment": {
+			IsRemoved:    true,
+			RemoveReason: "This is a property rather than a resource",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "apps"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps"},
+			},
+		},
+		"azurerm_spring_cloud_api_portal": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "apiPortals"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apiPortals"},
+			},
+		},
+		"azurerm_spring_cloud_api_portal_custom_domain": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "apiPortals", "domains"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apiPortals/domains"},
+			},
+		},
+		"azurerm_spring_cloud_app": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "apps"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps"},
+			},
+		},
+		"azurerm_spring_cloud_app_cosmosdb_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "apps", "bindings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/bindings"},
+			},
+		},
+		"azurerm_spring_cloud_app_dynamics_application_performance_monitoring": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "apms"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apms"},
+			},
+		},
+		"azurerm_spring_cloud_app_mysql_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "apps", "bindings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/bindings"},
+			},
+		},
+		"azurerm_spring_cloud_app_redis_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "apps", "bindings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/bindings"},
+			},
+		},
+		"azurerm_spring_cloud_application_insights_application_performance_monitoring": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "apms"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apms"},
+			},
+		},
+		"azurerm_spring_cloud_application_live_view": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "applicationLiveViews"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/applicationLiveViews"},
+			},
+		},
+		"azurerm_spring_cloud_build_deployment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "apps", "deployments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/deployments"},
+			},
+		},
+		"azurerm_spring_cloud_build_pack_binding": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "buildServices", "builders", "buildPackBindings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/buildServices/builders/buildPackBindings"},
+			},
+		},
+		"azurerm_spring_cloud_builder": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "buildServices", "builders"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/buildServices/builders"},
+			},
+		},
+		"azurerm_spring_cloud_certificate": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "certificates"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/certificates"},
+			},
+		},
+		"azurerm_spring_cloud_configuration_service": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "configurationServices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/configurationServices"},
+			},
+		},
+		"azurerm_spring_cloud_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/Spring/apps/deployments"},
+				Provider:     "Microsoft.ServiceLinker",
+				Types:        []string{"linkers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/Spring/apps/deployments/Microsoft.ServiceLinker/linkers"},
+			},
+		},
+		"azurerm_spring_cloud_container_deployment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "apps", "deployments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/deployments"},
+			},
+		},
+		"azurerm_spring_cloud_custom_domain": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "apps", "domains"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/domains"},
+			},
+		},
+		"azurerm_spring_cloud_customized_accelerator": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "applicationAccelerators", "customizedAccelerators"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/applicationAccelerators/customizedAccelerators"},
+			},
+		},
+		"azurerm_spring_cloud_dev_tool_portal": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"Spring", "DevToolPortals"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/Spring/DevToolPortals"},
+			},
+		},
+		"azurerm_spring_cloud_dynatrace_application_performance_monitoring": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "apms"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apms"},
+			},
+		},
+		"azurerm_spring_cloud_elastic_application_performance_monitoring": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "apms"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apms"},
+			},
+		},
+		"azurerm_spring_cloud_gateway": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "gateways"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/gateways"},
+			},
+		},
+		"azurerm_spring_cloud_gateway_custom_domain": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "gateways", "domains"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/gateways/domains"},
+			},
+		},
+		"azurerm_spring_cloud_gateway_route_config": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "gateways", "routeConfigs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/gateways/routeConfigs"},
+			},
+		},
+		"azurerm_spring_cloud_java_deployment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "apps", "deployments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/deployments"},
+			},
+		},
+		"azurerm_spring_cloud_new_relic_application_performance_monitoring": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "apms"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apms"},
+			},
+		},
+		"azurerm_spring_cloud_service": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring"},
+			},
+		},
+		"azurerm_spring_cloud_storage": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppPlatform",
+				Types:        []string{"spring", "storages"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/storages"},
+			},
+		},
+		"azurerm_ssh_public_key": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"sshPublicKeys"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/sshPublicKeys"},
+			},
+		},
+		"azurerm_stack_hci_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AzureStackHCI",
+				Types:        []string{"clusters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AzureStackHCI/clusters"},
+			},
+		},
+		"azurerm_stack_hci_deployment_setting": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AzureStackHCI",
+				Types:        []string{"clusters", "deploymentSettings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AzureStackHCI/clusters/deploymentSettings"},
+			},
+		},
+		"azurerm_stack_hci_extension": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AzureStackHCI",
+				Types:        []string{"clusters", "arcSettings", "extensions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AzureStackHCI/clusters/arcSettings/extensions"},
+			},
+		},
+		"azurerm_stack_hci_logical_network": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AzureStackHCI",
+				Types:        []string{"logicalNetworks"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AzureStackHCI/logicalNetworks"},
+			},
+		},
+		"azurerm_stack_hci_marketplace_gallery_image": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AzureStackHCI",
+				Types:        []string{"marketplaceGalleryImages"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AzureStackHCI/marketplaceGalleryImages"},
+			},
+		},
+		"azurerm_stack_hci_network_interface": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AzureStackHCI",
+				Types:        []string{"networkInterfaces"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AzureStackHCI/networkInterfaces"},
+			},
+		},
+		"azurerm_stack_hci_storage_path": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AzureStackHCI",
+				Types:        []string{"storageContainers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AzureStackHCI/storageContainers"},
+			},
+		},
+		"azurerm_stack_hci_virtual_hard_disk": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AzureStackHCI",
+				Types:        []string{"virtualHardDisks"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AzureStackHCI/virtualHardDisks"},
+			},
+		},
+		"azurerm_static_site": {
+			IsRemoved:    true,
+			RemoveReason: "This is deprecated in favor of `azurerm_static_web_app`",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"staticSites"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/staticSites"},
+			},
+		},
+		"azurerm_static_site_custom_domain": {
+			IsRemoved:    true,
+			RemoveReason: "This is deprecated in favor of `azurerm_static_web_app_custom_domain`",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"staticSites", "customDomains"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/staticSites/customDomains"},
+			},
+		},
+		"azurerm_static_web_app": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"staticSites"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/staticSites"},
+			},
+		},
+		"azurerm_static_web_app_custom_domain": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"staticSites", "customDomains"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/staticSites/customDomains"},
+			},
+		},
+		"azurerm_static_web_app_function_app_registration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"staticSites", "userProvidedFunctionApps"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/staticSites/userProvidedFunctionApps"},
+			},
+		},
+		"azurerm_storage_account": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Storage",
+				Types:        []string{"storageAccounts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"},
+			},
+		},
+		"azurerm_storage_account_customer_managed_key": {
+			IsRemoved:    true,
+			RemoveReason: "This is a property rather than a resource",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Storage",
+				Types:        []string{"storageAccounts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"},
+			},
+		},
+		"azurerm_storage_account_local_user": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Storage",
+				Types:        []string{"storageAccounts", "localUsers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/localUsers"},
+			},
+		},
+		"azurerm_storage_account_network_rules": {
+			IsRemoved:    true,
+			RemoveReason: "This is a property rather than a resource",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Storage",
+				Types:        []string{"storageAccounts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"},
+			},
+		},
+		"azurerm_storage_account_queue_properties": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Storage",
+				Types:        []string{"storageAccounts", "queueServices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"},
+			},
+		},
+		"azurerm_storage_account_static_website": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Storage",
+				Types:        []string{"storageAccounts", "staticWebsites"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"},
+			},
+		},
+		"azurerm_storage_blob": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Storage",
+				Types:        []string{"storageAccounts", "blobServices", "containers", "blobs"},
+			},
+		},
+		"azurerm_storage_blob_inventory_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Storage",
+				Types:        []string{"storageAccounts", "inventoryPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"},
+			},
+		},
+		"azurerm_storage_container": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Storage",
+				Types:        []string{"storageAccounts", "blobServices", "containers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/blobServices/containers"},
+			},
+		},
+		"azurerm_storage_container_immutability_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Storage",
+				Types:        []string{"storageAccounts", "blobServices", "containers", "immutabilityPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/blobServices/containers/immutabilityPolicies"},
+			},
+		},
+		"azurerm_storage_data_lake_gen2_filesystem": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Storage",
+				Types:        []string{"storageAccounts", "dfs"},
+			},
+		},
+		"azurerm_storage_data_lake_gen2_path": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Storage",
+				Types:        []string{"storageAccounts", "dfs", "paths"},
+			},
+		},
+		"azurerm_storage_encryption_scope": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Storage",
+				Types:        []string{"storageAccounts", "encryptionScopes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/encryptionScopes"},
+			},
+		},
+		"azurerm_storage_management_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Storage",
+				Types:        []string{"storageAccounts", "managementPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/managementPolicies"},
+			},
+		},
+		"azurerm_storage_mover": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StorageMover",
+				Types:        []string{"storageMovers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers"},
+			},
+		},
+		"azurerm_storage_mover_agent": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StorageMover",
+				Types:        []string{"storageMovers", "agents"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers/agents"},
+			},
+		},
+		"azurerm_storage_mover_job_definition": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StorageMover",
+				Types:        []string{"storageMovers", "projects", "jobDefinitions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers/projects/jobDefinitions"},
+			},
+		},
+		"azurerm_storage_mover_project": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StorageMover",
+				Types:        []string{"storageMovers", "projects"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers/projects"},
+			},
+		},
+		"azurerm_storage_mover_source_endpoint": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StorageMover",
+				Types:        []string{"storageMovers", "endpoints"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers/endpoints"},
+			},
+		},
+		"azurerm_storage_mover_target_endpoint": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StorageMover",
+				Types:        []string{"storageMovers", "endpoints"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers/endpoints"},
+			},
+		},
+		"azurerm_storage_object_replication": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Storage",
+				Types:        []string{"storageAccounts", "objectReplicationPolicies"},
+			},
+		},
+		"azurerm_storage_queue": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Storage",
+				Types:        []string{"storageAccounts", "queueServices", "queues"},
+			},
+		},
+		"azurerm_storage_share": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Storage",
+				Types:        []string{"storageAccounts", "fileServices", "shares"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/fileServices/shares"},
+			},
+		},
+		"azurerm_storage_share_directory": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Storage",
+				Types:        []string{"storageAccounts", "fileServices", "shares", "directories"},
+			},
+		},
+		"azurerm_storage_share_file": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Storage",
+				Types:        []string{"storageAccounts", "fileServices", "shares", "files"},
+			},
+		},
+		"azurerm_storage_sync": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StorageSync",
+				Types:        []string{"storageSyncServices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StorageSync/storageSyncServices"},
+			},
+		},
+		"azurerm_storage_sync_cloud_endpoint": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StorageSync",
+				Types:        []string{"storageSyncServices", "syncGroups", "cloudEndpoints"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StorageSync/storageSyncServices/syncGroups/cloudEndpoints"},
+			},
+		},
+		"azurerm_storage_sync_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StorageSync",
+				Types:        []string{"storageSyncServices", "syncGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StorageSync/storageSyncServices/syncGroups"},
+			},
+		},
+		"azurerm_storage_sync_server_endpoint": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StorageSync",
+				Types:        []string{"storageSyncServices", "syncGroups", "serverEndpoints"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StorageSync/storageSyncServices/syncGroups/serverEndpoints"},
+			},
+		},
+		"azurerm_storage_table": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Storage",
+				Types:        []string{"storageAccounts", "tableServices", "tables"},
+			},
+		},
+		"azurerm_storage_table_entity": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Storage",
+				Types:        []string{"storageAccounts", "tableServices", "tables", "partitionKeys", "rowKeys"},
+			},
+		},
+		"azurerm_stream_analytics_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StreamAnalytics",
+				Types:        []string{"clusters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/clusters"},
+			},
+		},
+		"azurerm_stream_analytics_function_javascript_uda": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StreamAnalytics",
+				Types:        []string{"streamingJobs", "functions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/functions"},
+			},
+		},
+		"azurerm_stream_analytics_function_javascript_udf": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StreamAnalytics",
+				Types:        []string{"streamingJobs", "functions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/functions"},
+			},
+		},
+		"azurerm_stream_analytics_job": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StreamAnalytics",
+				Types:        []string{"streamingJobs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs"},
+			},
+		},
+		"azurerm_stream_analytics_job_schedule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StreamAnalytics",
+				Types:        []string{"streamingJobs", "schedule"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/schedule"},
+			},
+		},
+		"azurerm_stream_analytics_job_storage_account": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StreamAnalytics",
+				Types:        []string{"streamingJobs", "storageAccounts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs"},
+			},
+		},
+		"azurerm_stream_analytics_managed_private_endpoint": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StreamAnalytics",
+				Types:        []string{"clusters", "privateEndpoints"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/clusters/privateEndpoints"},
+			},
+		},
+		"azurerm_stream_analytics_output_blob": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StreamAnalytics",
+				Types:        []string{"streamingJobs", "outputs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"},
+			},
+		},
+		"azurerm_stream_analytics_output_cosmosdb": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StreamAnalytics",
+				Types:        []string{"streamingJobs", "outputs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"},
+			},
+		},
+		"azurerm_stream_analytics_output_eventhub": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StreamAnalytics",
+				Types:        []string{"streamingJobs", "outputs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"},
+			},
+		},
+		"azurerm_stream_analytics_output_function": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StreamAnalytics",
+				Types:        []string{"streamingJobs", "outputs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"},
+			},
+		},
+		"azurerm_stream_analytics_output_mssql": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StreamAnalytics",
+				Types:        []string{"streamingJobs", "outputs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"},
+			},
+		},
+		"azurerm_stream_analytics_output_powerbi": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StreamAnalytics",
+				Types:        []string{"streamingjobs", "outputs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"},
+			},
+		},
+		"azurerm_stream_analytics_output_servicebus_queue": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StreamAnalytics",
+				Types:        []string{"streamingJobs", "outputs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"},
+			},
+		},
+		"azurerm_stream_analytics_output_servicebus_topic": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StreamAnalytics",
+				Types:        []string{"streamingjobs", "outputs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"},
+			},
+		},
+		"azurerm_stream_analytics_output_synapse": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StreamAnalytics",
+				Types:        []string{"streamingJobs", "outputs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"},
+			},
+		},
+		"azurerm_stream_analytics_output_table": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StreamAnalytics",
+				Types:        []string{"streamingJobs", "outputs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"},
+			},
+		},
+		"azurerm_stream_analytics_reference_input_blob": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StreamAnalytics",
+				Types:        []string{"streamingjobs", "inputs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"},
+			},
+		},
+		"azurerm_stream_analytics_reference_input_mssql": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StreamAnalytics",
+				Types:        []string{"streamingJobs", "inputs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"},
+			},
+		},
+		"azurerm_stream_analytics_stream_input_blob": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StreamAnalytics",
+				Types:        []string{"streamingJobs", "inputs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"},
+			},
+		},
+		"azurerm_stream_analytics_stream_input_eventhub": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StreamAnalytics",
+				Types:        []string{"streamingJobs", "inputs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"},
+			},
+		},
+		"azurerm_stream_analytics_stream_input_eventhub_v2": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StreamAnalytics",
+				Types:        []string{"streamingJobs", "inputs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"},
+			},
+		},
+		"azurerm_stream_analytics_stream_input_iothub": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StreamAnalytics",
+				Types:        []string{"streamingJobs", "inputs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"},
+			},
+		},
+		"azurerm_subnet": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"virtualNetworks", "subnets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualNetworks/subnets"},
+			},
+		},
+		"azurerm_subnet_nat_gateway_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"virtualNetworks", "subnets", "natGateways"},
+			},
+		},
+		"azurerm_subnet_network_security_group_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"virtualNetworks", "subnets", "networkSecurityGroups"},
+			},
+		},
+		"azurerm_subnet_route_table_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"virtualNetworks", "subnets", "routeTables"},
+			},
+		},
+		"azurerm_subnet_service_endpoint_storage_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"serviceEndpointPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/serviceEndpointPolicies"},
+			},
+		},
+		"azurerm_subscription": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/"},
+				Provider:     "Microsoft.Subscription",
+				Types:        []string{"aliases"},
+				ImportSpecs:  []string{"/Microsoft.Subscription/aliases"},
+			},
+		},
+		"azurerm_subscription_cost_management_export": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions"},
+				Provider:     "Microsoft.CostManagement",
+				Types:        []string{"exports"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.CostManagement/exports"},
+			},
+		},
+		"azurerm_subscription_cost_management_view": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions"},
+				Provider:     "Microsoft.CostManagement",
+				Types:        []string{"views"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.CostManagement/views"},
+			},
+		},
+		"azurerm_subscription_policy_assignment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions"},
+				Provider:     "Microsoft.Authorization",
+				Types:        []string{"policyAssignments"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.Authorization/policyAssignments"},
+			},
+		},
+		"azurerm_subscription_policy_exemption": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions"},
+				Provider:     "Microsoft.Authorization",
+				Types:        []string{"policyExemptions"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.Authorization/policyExemptions"},
+			},
+		},
+		"azurerm_subscription_policy_remediation": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions"},
+				Provider:     "Microsoft.PolicyInsights",
+				Types:        []string{"remediations"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.PolicyInsights/remediations"},
+			},
+		},
+		"azurerm_subscription_template_deployment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions"},
+				Provider:     "Microsoft.Resources",
+				Types:        []string{"deployments"},
+				ImportSpecs:  []string{"/subscriptions/Microsoft.Resources/deployments"},
+			},
+		},
+		"azurerm_synapse_firewall_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Synapse",
+				Types:        []string{"workspaces", "firewallRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/firewallRules"},
+			},
+		},
+		"azurerm_synapse_integration_runtime_azure": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Synapse",
+				Types:        []string{"workspaces", "integrationRuntimes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/integrationRuntimes"},
+			},
+		},
+		"azurerm_synapse_integration_runtime_self_hosted": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Synapse",
+				Types:        []string{"workspaces", "integrationRuntimes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/integrationRuntimes"},
+			},
+		},
+		"azurerm_synapse_linked_service": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Synapse",
+				Types:        []string{"workspaces", "linkedServices"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/linkedServices"},
+			},
+		},
+		"azurerm_synapse_managed_private_endpoint": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Synapse",
+				Types:        []string{"workspaces", "managedVirtualNetworks", "managedPrivateEndpoints"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/managedVirtualNetworks/managedPrivateEndpoints"},
+			},
+		},
+		"azurerm_synapse_private_link_hub": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Synapse",
+				Types:        []string{"privateLinkHubs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Synapse/privateLinkHubs"},
+			},
+		},
+		"azurerm_synapse_role_assignment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Synapse",
+				Types:        []string{"workspaces", "roleAssignments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces"},
+			},
+		},
+		"azurerm_synapse_spark_pool": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Synapse",
+				Types:        []string{"workspaces", "bigDataPools"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/bigDataPools"},
+			},
+		},
+		"azurerm_synapse_sql_pool": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Synapse",
+				Types:        []string{"workspaces", "sqlPools"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools"},
+			},
+		},
+		"azurerm_synapse_sql_pool_extended_auditing_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Synapse",
+				Types:        []string{"workspaces", "sqlPools", "extendedAuditingSettings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/extendedAuditingSettings"},
+			},
+		},
+		"azurerm_synapse_sql_pool_security_alert_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Synapse",
+				Types:        []string{"workspaces", "sqlPools", "securityAlertPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/securityAlertPolicies"},
+			},
+		},
+		"azurerm_synapse_sql_pool_vulnerability_assessment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Synapse",
+				Types:        []string{"workspaces", "sqlPools", "vulnerabilityAssessments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/vulnerabilityAssessments"},
+			},
+		},
+		"azurerm_synapse_sql_pool_vulnerability_assessment_baseline": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Synapse",
+				Types:        []string{"workspaces", "sqlPools", "vulnerabilityAssessments", "rules", "baselines"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/vulnerabilityAssessments/rules/baselines"},
+			},
+		},
+		"azurerm_synapse_sql_pool_workload_classifier": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Synapse",
+				Types:        []string{"workspaces", "sqlPools", "workloadGroups", "workloadClassifiers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/workloadGroups/workloadClassifiers"},
+			},
+		},
+		"azurerm_synapse_sql_pool_workload_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Synapse",
+				Types:        []string{"workspaces", "sqlPools", "workloadGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/workloadGroups"},
+			},
+		},
+		"azurerm_synapse_workspace": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Synapse",
+				Types:        []string{"workspaces"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces"},
+			},
+		},
+		"azurerm_synapse_workspace_aad_admin": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Synapse",
+				Types:        []string{"workspaces", "administrators"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/administrators"},
+			},
+		},
+		"azurerm_synapse_workspace_extended_auditing_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Synapse",
+				Types:        []string{"workspaces", "extendedAuditingSettings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/extendedAuditingSettings"},
+			},
+		},
+		"azurerm_synapse_workspace_key": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Synapse",
+				Types:        []string{"workspaces", "keys"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/keys"},
+			},
+		},
+		"azurerm_synapse_workspace_security_alert_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Synapse",
+				Types:        []string{"workspaces", "securityAlertPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/securityAlertPolicies"},
+			},
+		},
+		"azurerm_synapse_workspace_sql_aad_admin": {
+			IsRemoved:    true,
+			RemoveReason: "This is the same as `azurerm_synapse_workspace_aad_admin`",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Synapse",
+				Types:        []string{"workspaces", "administrators"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlAdministrators"},
+			},
+		},
+		"azurerm_synapse_workspace_vulnerability_assessment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Synapse",
+				Types:        []string{"workspaces", "vulnerabilityAssessments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/vulnerabilityAssessments"},
+			},
+		},
+		"azurerm_system_center_virtual_machine_manager_availability_set": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ScVmm",
+				Types:        []string{"availabilitySets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ScVmm/availabilitySets"},
+			},
+		},
+		"azurerm_system_center_virtual_machine_manager_cloud": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ScVmm",
+				Types:        []string{"clouds"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ScVmm/clouds"},
+			},
+		},
+		"azurerm_system_center_virtual_machine_manager_server": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ScVmm",
+				Types:        []string{"vmmServers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ScVmm/vmmServers"},
+			},
+		},
+		"azurerm_system_center_virtual_machine_manager_virtual_machine_instance": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.HybridCompute/machines"},
+				Provider:     "Microsoft.ScVmm",
+				Types:        []string{"virtualMachineInstances"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.HybridCompute/machines/Microsoft.ScVmm/virtualMachineInstances"},
+			},
+		},
+		"azurerm_system_center_virtual_machine_manager_virtual_machine_template": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ScVmm",
+				Types:        []string{"virtualMachineTemplates"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ScVmm/virtualMachineTemplates"},
+			},
+		},
+		"azurerm_system_center_virtual_machine_manager_virtual_network": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ScVmm",
+				Types:        []string{"virtualNetworks"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ScVmm/virtualNetworks"},
+			},
+		},
+		"azurerm_tenant_template_deployment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/"},
+				Provider:     "Microsoft.Resources",
+				Types:        []string{"deployments"},
+				ImportSpecs:  []string{"/Microsoft.Resources/deployments"},
+			},
+		},
+		"azurerm_traffic_manager_azure_endpoint": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"trafficManagerProfiles", "AzureEndpoints"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/trafficManagerProfiles/AzureEndpoints"},
+			},
+		},
+		"azurerm_traffic_manager_external_endpoint": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"trafficManagerProfiles", "ExternalEndpoints"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/trafficManagerProfiles/ExternalEndpoints"},
+			},
+		},
+		"azurerm_traffic_manager_nested_endpoint": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"trafficManagerProfiles", "NestedEndpoints"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/trafficManagerProfiles/NestedEndpoints"},
+			},
+		},
+		"azurerm_traffic_manager_profile": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"trafficManagerProfiles"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/trafficManagerProfiles"},
+			},
+		},
+		"azurerm_trusted_signing_account": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.CodeSigning",
+				Types:        []string{"codeSigningAccounts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.CodeSigning/codeSigningAccounts"},
+			},
+		},
+		"azurerm_user_assigned_identity": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.ManagedIdentity",
+				Types:        []string{"userAssignedIdentities"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.ManagedIdentity/userAssignedIdentities"},
+			},
+		},
+		"azurerm_video_indexer_account": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.VideoIndexer",
+				Types:        []string{"accounts"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.VideoIndexer/accounts"},
+			},
+		},
+		"azurerm_virtual_desktop_application": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DesktopVirtualization",
+				Types:        []string{"applicationGroups", "applications"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/applicationGroups/applications"},
+			},
+		},
+		"azurerm_virtual_desktop_application_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DesktopVirtualization",
+				Types:        []string{"applicationGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/applicationGroups"},
+			},
+		},
+		"azurerm_virtual_desktop_host_pool": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DesktopVirtualization",
+				Types:        []string{"hostPools"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/hostPools"},
+			},
+		},
+		"azurerm_virtual_desktop_host_pool_registration_info": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DesktopVirtualization",
+				Types:        []string{"hostPools", "registrationInfo"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/hostPools/registrationInfo"},
+			},
+		},
+		"azurerm_virtual_desktop_scaling_plan": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DesktopVirtualization",
+				Types:        []string{"scalingPlans"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/scalingPlans"},
+			},
+		},
+		"azurerm_virtual_desktop_scaling_plan_host_pool_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DesktopVirtualization",
+				Types:        []string{"scalingPlans", "hostPools"},
+			},
+		},
+		"azurerm_virtual_desktop_workspace": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DesktopVirtualization",
+				Types:        []string{"workspaces"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/workspaces"},
+			},
+		},
+		"azurerm_virtual_desktop_workspace_application_group_association": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.DesktopVirtualization",
+				Types:        []string{"workspaces", "applicationGroups"},
+			},
+		},
+		"azurerm_virtual_hub": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"virtualHubs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualHubs"},
+			},
+		},
+		"azurerm_virtual_hub_bgp_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"virtualHubs", "bgpConnections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/bgpConnections"},
+			},
+		},
+		"azurerm_virtual_hub_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"virtualHubs", "hubVirtualNetworkConnections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/hubVirtualNetworkConnections"},
+			},
+		},
+		"azurerm_virtual_hub_ip": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"virtualHubs", "ipConfigurations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/ipConfigurations"},
+			},
+		},
+		"azurerm_virtual_hub_route_table": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"virtualHubs", "hubRouteTables"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/hubRouteTables"},
+			},
+		},
+		"azurerm_virtual_hub_route_table_route": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"virtualHubs", "hubRouteTables", "routes"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/hubRouteTables/routes"},
+			},
+		},
+		"azurerm_virtual_hub_routing_intent": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"virtualHubs", "routingIntent"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/routingIntent"},
+			},
+		},
+		"azurerm_virtual_hub_security_partner_provider": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"securityPartnerProviders"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/securityPartnerProviders"},
+			},
+		},
+		"azurerm_virtual_machine": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"virtualMachines"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines"},
+			},
+		},
+		"azurerm_virtual_machine_automanage_configuration_assignment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines"},
+				Provider:     "Microsoft.AutoManage",
+				Types:        []string{"configurationProfileAssignments"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/Microsoft.AutoManage/configurationProfileAssignments"},
+			},
+		},
+		"azurerm_virtual_machine_data_disk_attachment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"virtualMachines", "dataDisks"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/dataDisks"},
+			},
+		},
+		"azurerm_virtual_machine_extension": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"virtualMachines", "extensions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/extensions"},
+			},
+		},
+		"azurerm_virtual_machine_gallery_application_assignment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"virtualMachines", "galleryApplications"},
+			},
+		},
+		"azurerm_virtual_machine_implicit_data_disk_from_source": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"virtualMachines", "dataDisks"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/dataDisks"},
+			},
+		},
+		"azurerm_virtual_machine_packet_capture": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkWatchers", "packetCaptures"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/networkWatchers/packetCaptures"},
+			},
+		},
+		"azurerm_virtual_machine_restore_point": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"restorePointCollections", "restorePoints"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/restorePointCollections/restorePoints"},
+			},
+		},
+		"azurerm_virtual_machine_restore_point_collection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"restorePointCollections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/restorePointCollections"},
+			},
+		},
+		"azurerm_virtual_machine_run_command": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"virtualMachines", "runCommands"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/runCommands"},
+			},
+		},
+		"azurerm_virtual_machine_scale_set": {
+			IsRemoved:    true,
+			RemoveReason: "This is deprecated in favor of `azurerm_linux_virtual_machine_scale_set` and `azurerm_windows_virtual_machine_scale_set`",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"virtualMachineScaleSets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets"},
+			},
+		},
+		"azurerm_virtual_machine_scale_set_extension": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"virtualMachineScaleSets", "extensions"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets/extensions"},
+			},
+		},
+		"azurerm_virtual_machine_scale_set_packet_capture": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkWatchers", "packetCaptures"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/networkWatchers/packetCaptures"},
+			},
+		},
+		"azurerm_virtual_machine_scale_set_standby_pool": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.StandbyPool",
+				Types:        []string{"standbyVirtualMachinePools"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.StandbyPool/standbyVirtualMachinePools"},
+			},
+		},
+		"azurerm_virtual_network": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"virtualNetworks"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualNetworks"},
+			},
+		},
+		"azurerm_virtual_network_dns_servers": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"virtualNetworks", "dnsServers"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualNetworks/dnsServers"},
+			},
+		},
+		"azurerm_virtual_network_gateway": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"virtualNetworkGateways"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualNetworkGateways"},
+			},
+		},
+		"azurerm_virtual_network_gateway_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"connections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/connections"},
+			},
+		},
+		"azurerm_virtual_network_gateway_nat_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"virtualNetworkGateways", "natRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualNetworkGateways/natRules"},
+			},
+		},
+		"azurerm_virtual_network_peering": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"virtualNetworks", "virtualNetworkPeerings"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualNetworks/virtualNetworkPeerings"},
+			},
+		},
+		"azurerm_virtual_wan": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"virtualWans"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualWans"},
+			},
+		},
+		"azurerm_vmware_cluster": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AVS",
+				Types:        []string{"privateClouds", "clusters"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AVS/privateClouds/clusters"},
+			},
+		},
+		"azurerm_vmware_express_route_authorization": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AVS",
+				Types:        []string{"privateClouds", "authorizations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AVS/privateClouds/authorizations"},
+			},
+		},
+		"azurerm_vmware_netapp_volume_attachment": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AVS",
+				Types:        []string{"privateClouds", "clusters", "dataStores"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AVS/privateClouds/clusters/dataStores"},
+			},
+		},
+		"azurerm_vmware_private_cloud": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AVS",
+				Types:        []string{"privateClouds"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AVS/privateClouds"},
+			},
+		},
+		"azurerm_voice_services_communications_gateway": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.VoiceServices",
+				Types:        []string{"communicationsGateways"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.VoiceServices/communicationsGateways"},
+			},
+		},
+		"azurerm_voice_services_communications_gateway_test_line": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.VoiceServices",
+				Types:        []string{"communicationsGateways", "testLines"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.VoiceServices/communicationsGateways/testLines"},
+			},
+		},
+		"azurerm_vpn_gateway": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"vpnGateways"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/vpnGateways"},
+			},
+		},
+		"azurerm_vpn_gateway_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"vpnGateways", "vpnConnections"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/vpnGateways/vpnConnections"},
+			},
+		},
+		"azurerm_vpn_gateway_nat_rule": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"vpnGateways", "natRules"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/vpnGateways/natRules"},
+			},
+		},
+		"azurerm_vpn_server_configuration": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"vpnServerConfigurations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/vpnServerConfigurations"},
+			},
+		},
+		"azurerm_vpn_server_configuration_policy_group": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"vpnServerConfigurations", "configurationPolicyGroups"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/vpnServerConfigurations/configurationPolicyGroups"},
+			},
+		},
+		"azurerm_vpn_site": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"vpnSites"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/vpnSites"},
+			},
+		},
+		"azurerm_web_app_active_slot": {
+			IsRemoved:    true,
+			RemoveReason: "This is a property rather than a resource",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"},
+			},
+		},
+		"azurerm_web_app_hybrid_connection": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites", "hybridConnectionNamespaces", "relays"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/hybridConnectionNamespaces/relays"},
+			},
+		},
+		"azurerm_web_application_firewall_policy": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"applicationGatewayWebApplicationFirewallPolicies"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/applicationGatewayWebApplicationFirewallPolicies"},
+			},
+		},
+		"azurerm_web_pubsub": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.SignalRService",
+				Types:        []string{"webPubSub"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub"},
+			},
+		},
+		"azurerm_web_pubsub_custom_certificate": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.SignalRService",
+				Types:        []string{"webPubSub", "customCertificates"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub/customCertificates"},
+			},
+		},
+		"azurerm_web_pubsub_custom_domain": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.SignalRService",
+				Types:        []string{"webPubSub", "customDomains"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub/customDomains"},
+			},
+		},
+		"azurerm_web_pubsub_hub": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.SignalRService",
+				Types:        []string{"webPubSub", "hubs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub/hubs"},
+			},
+		},
+		"azurerm_web_pubsub_network_acl": {
+			IsRemoved:    true,
+			RemoveReason: "This is a property rather than a resource",
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.SignalRService",
+				Types:        []string{"webPubSub"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub"},
+			},
+		},
+		"azurerm_web_pubsub_shared_private_link_resource": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.SignalRService",
+				Types:        []string{"webPubSub", "sharedPrivateLinkResources"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub/sharedPrivateLinkResources"},
+			},
+		},
+		"azurerm_web_pubsub_socketio": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.SignalRService",
+				Types:        []string{"webPubSub"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub"},
+			},
+		},
+		"azurerm_windows_function_app": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"},
+			},
+		},
+		"azurerm_windows_function_app_slot": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites", "slots"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/slots"},
+			},
+		},
+		"azurerm_windows_virtual_machine": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"virtualMachines"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines"},
+			},
+		},
+		"azurerm_windows_virtual_machine_scale_set": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Compute",
+				Types:        []string{"virtualMachineScaleSets"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets"},
+			},
+		},
+		"azurerm_windows_web_app": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"},
+			},
+		},
+		"azurerm_windows_web_app_slot": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Web",
+				Types:        []string{"sites", "slots"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/slots"},
+			},
+		},
+		"azurerm_workloads_sap_discovery_virtual_instance": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Workloads",
+				Types:        []string{"sapVirtualInstances"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Workloads/sapVirtualInstances"},
+			},
+		},
+		"azurerm_workloads_sap_single_node_virtual_instance": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Workloads",
+				Types:        []string{"sapVirtualInstances"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Workloads/sapVirtualInstances"},
+			},
+		},
+		"azurerm_workloads_sap_three_tier_virtual_instance": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Workloads",
+				Types:        []string{"sapVirtualInstances"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Workloads/sapVirtualInstances"},
+			},
+		},
+		"fake_azurerm_application_gateway_backend_address_pool": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"applicationGateways", "backendAddressPools"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/applicationGateways/backendAddressPools"},
+			},
+		},
+		"fake_azurerm_network_interface_ipconfig": {
+			ManagementPlane: &MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Network",
+				Types:        []string{"networkInterfaces", "ipConfigurations"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Network/networkInterfaces/ipConfigurations"},
+			},
+		},
+	}
+}
+
+func genARMId2TFMap() ARMId2TFMapType {
+	return ARMId2TFMapType{
+		"/DYNATRACE.OBSERVABILITY/MONITORS/TAGRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dynatrace_tag_rules", ImportSpec: "/subscriptions/resourceGroups/Dynatrace.Observability/monitors/tagRules"},
+			},
+		},
+		"/MICROSOFT.AAD/DOMAINSERVICES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_active_directory_domain_service", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AAD/domainServices/initialReplicaSetId"},
+			},
+		},
+		"/MICROSOFT.AAD/DOMAINSERVICES/REPLICASETS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_active_directory_domain_service_replica_set", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AAD/domainServices/replicaSets"},
+			},
+		},
+		"/MICROSOFT.AADIAM/DIAGNOSTICSETTINGS": {
+			"/": {
+				{ResourceType: "azurerm_monitor_aad_diagnostic_setting", ImportSpec: "/Microsoft.AADIAM/diagnosticSettings"},
+			},
+		},
+		"/MICROSOFT.ADVISOR/RECOMMENDATIONS/SUPPRESSIONS": {
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_advisor_suppression", ImportSpec: "/subscriptions/Microsoft.Advisor/recommendations/suppressions"},
+			},
+		},
+		"/MICROSOFT.ALERTSMANAGEMENT/ACTIONRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_monitor_alert_processing_rule_action_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AlertsManagement/actionRules"},
+				{ResourceType: "azurerm_monitor_alert_processing_rule_suppression", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AlertsManagement/actionRules"},
+			},
+		},
+		"/MICROSOFT.ALERTSMANAGEMENT/PROMETHEUSRULEGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_monitor_alert_prometheus_rule_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AlertsManagement/prometheusRuleGroups"},
+			},
+		},
+		"/MICROSOFT.ALERTSMANAGEMENT/SMARTDETECTORALERTRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_monitor_smart_detector_alert_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AlertsManagement/smartDetectorAlertRules"},
+			},
+		},
+		"/MICROSOFT.ANALYSISSERVICES/SERVERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_analysis_services_server", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AnalysisServices/servers"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/APIS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_api", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/APIS/DIAGNOSTICS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_api_diagnostic", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/diagnostics"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/APIS/OPERATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_api_operation", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/operations"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/APIS/OPERATIONS/POLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_api_operation_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/operations"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/APIS/OPERATIONS/TAGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_api_operation_tag", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/operations/tags"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/APIS/POLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_api_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/APIS/RELEASES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_api_release", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/releases"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/APIS/SCHEMAS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_api_schema", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/schemas"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/APIS/TAGDESCRIPTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_api_tag_description", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/tagDescriptions"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/APIS/TAGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_api_tag", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/tags"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/APIVERSIONSETS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_api_version_set", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apiVersionSets"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/AUTHORIZATIONSERVERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_authorization_server", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/authorizationServers"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/BACKENDS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_backend", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/backends"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/CACHES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_redis_cache", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/caches"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/CERTIFICATES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_certificate", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/certificates"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/CUSTOMDOMAINS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_custom_domain", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/customDomains"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/DIAGNOSTICS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_diagnostic", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/diagnostics"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/GATEWAYS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_gateway", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/gateways"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/GATEWAYS/APIS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_gateway_api", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/gateways/apis"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/GATEWAYS/CERTIFICATEAUTHORITIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_gateway_certificate_authority", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/gateways/certificateAuthorities"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/GATEWAYS/HOSTNAMECONFIGURATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_gateway_host_name_configuration", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/gateways/hostnameConfigurations"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/GROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/groups"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/GROUPS/USERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_group_user", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/groups/users"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/IDENTITYPROVIDERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_identity_provider_aad", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"},
+				{ResourceType: "azurerm_api_management_identity_provider_aadb2c", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"},
+				{ResourceType: "azurerm_api_management_identity_provider_facebook", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"},
+				{ResourceType: "azurerm_api_management_identity_provider_google", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"},
+				{ResourceType: "azurerm_api_management_identity_provider_microsoft", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"},
+				{ResourceType: "azurerm_api_management_identity_provider_twitter", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/LOGGERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_logger", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/loggers"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/NAMEDVALUES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_named_value", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/namedValues"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/NOTIFICATIONS/RECIPIENTEMAILS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_notification_recipient_email", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/notifications/recipientEmails"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/NOTIFICATIONS/RECIPIENTUSERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_notification_recipient_user", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/notifications/recipientUsers"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/OPENIDCONNECTPROVIDERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_openid_connect_provider", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/openidConnectProviders"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/POLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/POLICYFRAGMENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_policy_fragment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/policyFragments"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/PRODUCTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_product", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/products"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/PRODUCTS/APIS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_product_api", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/products/apis"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/PRODUCTS/GROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_product_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/products/groups"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/PRODUCTS/POLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_product_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/products"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/PRODUCTS/TAGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_product_tag", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/products/tags"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/SCHEMAS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_global_schema", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/schemas"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/SUBSCRIPTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_subscription", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/subscriptions"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/TAGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_tag", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/tags"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/TEMPLATES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_email_template", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/templates"},
+			},
+		},
+		"/MICROSOFT.APIMANAGEMENT/SERVICE/USERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_management_user", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/users"},
+			},
+		},
+		"/MICROSOFT.APP/CONTAINERAPPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_container_app", ImportSpec: "/subscriptions/resourceGroups/Microsoft.App/containerApps"},
+			},
+		},
+		"/MICROSOFT.APP/CONTAINERAPPS/CUSTOMDOMAINNAME": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_container_app_custom_domain", ImportSpec: "/subscriptions/resourceGroups/Microsoft.App/containerApps/customDomainName"},
+			},
+		},
+		"/MICROSOFT.APP/JOBS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_container_app_job", ImportSpec: "/subscriptions/resourceGroups/Microsoft.App/jobs"},
+			},
+		},
+		"/MICROSOFT.APP/MANAGEDENVIRONMENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_container_app_environment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.App/managedEnvironments"},
+			},
+		},
+		"/MICROSOFT.APP/MANAGEDENVIRONMENTS/CERTIFICATES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_container_app_environment_certificate", ImportSpec: "/subscriptions/resourceGroups/Microsoft.App/managedEnvironments/certificates"},
+			},
+		},
+		"/MICROSOFT.APP/MANAGEDENVIRONMENTS/CUSTOMDOMAINS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_container_app_environment_custom_domain", ImportSpec: "/subscriptions/resourceGroups/Microsoft.App/managedEnvironments"},
+			},
+		},
+		"/MICROSOFT.APP/MANAGEDENVIRONMENTS/DAPRCOMPONENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_container_app_environment_dapr_component", ImportSpec: "/subscriptions/resourceGroups/Microsoft.App/managedEnvironments/daprComponents"},
+			},
+		},
+		"/MICROSOFT.APP/MANAGEDENVIRONMENTS/STORAGES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_container_app_environment_storage", ImportSpec: "/subscriptions/resourceGroups/Microsoft.App/managedEnvironments/storages"},
+			},
+		},
+		"/MICROSOFT.APPCONFIGURATION/CONFIGURATIONSTORES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_app_configuration", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppConfiguration/configurationStores"},
+			},
+		},
+		"/MICROSOFT.APPCONFIGURATION/CONFIGURATIONSTORES/APPCONFIGURATIONFEATURE/LABEL": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_app_configuration_feature", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppConfiguration/configurationStores/AppConfigurationFeature/Label"},
+			},
+		},
+		"/MICROSOFT.APPCONFIGURATION/CONFIGURATIONSTORES/APPCONFIGURATIONKEY/LABEL": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_app_configuration_key", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppConfiguration/configurationStores/AppConfigurationKey/Label"},
+			},
+		},
+		"/MICROSOFT.APPPLATFORM/SPRING": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_spring_cloud_service", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring"},
+			},
+		},
+		"/MICROSOFT.APPPLATFORM/SPRING/APIPORTALS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_spring_cloud_api_portal", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apiPortals"},
+			},
+		},
+		"/MICROSOFT.APPPLATFORM/SPRING/APIPORTALS/DOMAINS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_spring_cloud_api_portal_custom_domain", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apiPortals/domains"},
+			},
+		},
+		"/MICROSOFT.APPPLATFORM/SPRING/APMS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_spring_cloud_app_dynamics_application_performance_monitoring", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apms"},
+				{ResourceType: "azurerm_spring_cloud_application_insights_application_performance_monitoring", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apms"},
+				{ResourceType: "azurerm_spring_cloud_dynatrace_application_performance_monitoring", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apms"},
+				{ResourceType: "azurerm_spring_cloud_elastic_application_performance_monitoring", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apms"},
+				{ResourceType: "azurerm_spring_cloud_new_relic_application_performance_monitoring", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apms"},
+			},
+		},
+		"/MICROSOFT.APPPLATFORM/SPRING/APPLICATIONACCELERATORS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_spring_cloud_accelerator", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/applicationAccelerators"},
+			},
+		},
+		"/MICROSOFT.APPPLATFORM/SPRING/APPLICATIONACCELERATORS/CUSTOMIZEDACCELERATORS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_spring_cloud_customized_accelerator", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/applicationAccelerators/customizedAccelerators"},
+			},
+		},
+		"/MICROSOFT.APPPLATFORM/SPRING/APPLICATIONLIVEVIEWS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_spring_cloud_application_live_view", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/applicationLiveViews"},
+			},
+		},
+		"/MICROSOFT.APPPLATFORM/SPRING/APPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_spring_cloud_app", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps"},
+			},
+		},
+		"/MICROSOFT.APPPLATFORM/SPRING/APPS/BINDINGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_spring_cloud_app_cosmosdb_association", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/bindings"},
+				{ResourceType: "azurerm_spring_cloud_app_mysql_association", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/bindings"},
+				{ResourceType: "azurerm_spring_cloud_app_redis_association", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/bindings"},
+			},
+		},
+		"/MICROSOFT.APPPLATFORM/SPRING/APPS/DEPLOYMENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_spring_cloud_build_deployment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/deployments"},
+				{ResourceType: "azurerm_spring_cloud_container_deployment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/deployments"},
+				{ResourceType: "azurerm_spring_cloud_java_deployment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/deployments"},
+			},
+		},
+		"/MICROSOFT.APPPLATFORM/SPRING/APPS/DOMAINS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_spring_cloud_custom_domain", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/domains"},
+			},
+		},
+		"/MICROSOFT.APPPLATFORM/SPRING/BUILDSERVICES/BUILDERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_spring_cloud_builder", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/buildServices/builders"},
+			},
+		},
+		"/MICROSOFT.APPPLATFORM/SPRING/BUILDSERVICES/BUILDERS/BUILDPACKBINDINGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_spring_cloud_build_pack_binding", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/buildServices/builders/buildPackBindings"},
+			},
+		},
+		"/MICROSOFT.APPPLATFORM/SPRING/CERTIFICATES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_spring_cloud_certificate", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/certificates"},
+			},
+		},
+		"/MICROSOFT.APPPLATFORM/SPRING/CONFIGURATIONSERVICES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_spring_cloud_configuration_service", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/configurationServices"},
+			},
+		},
+		"/MICROSOFT.APPPLATFORM/SPRING/DEVTOOLPORTALS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_spring_cloud_dev_tool_portal", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/Spring/DevToolPortals"},
+			},
+		},
+		"/MICROSOFT.APPPLATFORM/SPRING/GATEWAYS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_spring_cloud_gateway", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/gateways"},
+			},
+		},
+		"/MICROSOFT.APPPLATFORM/SPRING/GATEWAYS/DOMAINS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_spring_cloud_gateway_custom_domain", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/gateways/domains"},
+			},
+		},
+		"/MICROSOFT.APPPLATFORM/SPRING/GATEWAYS/ROUTECONFIGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_spring_cloud_gateway_route_config", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/gateways/routeConfigs"},
+			},
+		},
+		"/MICROSOFT.APPPLATFORM/SPRING/STORAGES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_spring_cloud_storage", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/storages"},
+			},
+		},
+		"/MICROSOFT.ATTESTATION/ATTESTATIONPROVIDERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_attestation_provider", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Attestation/attestationProviders"},
+			},
+		},
+		"/MICROSOFT.AUTHORIZATION/LOCKS": {
+			"ANY": {
+				{ResourceType: "azurerm_management_lock"},
+			},
+		},
+		"/MICROSOFT.AUTHORIZATION/POLICYASSIGNMENTS": {
+			"/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS": {
+				{ResourceType: "azurerm_management_group_policy_assignment", ImportSpec: "/Microsoft.Management/managementGroups/Microsoft.Authorization/policyAssignments"},
+			},
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_subscription_policy_assignment", ImportSpec: "/subscriptions/Microsoft.Authorization/policyAssignments"},
+			},
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_resource_group_policy_assignment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Authorization/policyAssignments"},
+			},
+			"ANY": {
+				{ResourceType: "azurerm_resource_policy_assignment"},
+			},
+		},
+		"/MICROSOFT.AUTHORIZATION/POLICYDEFINITIONS": {
+			"/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS": {
+				{ResourceType: "azurerm_policy_definition", ImportSpec: "/Microsoft.Management/managementgroups/Microsoft.Authorization/policyDefinitions"},
+			},
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_policy_definition", ImportSpec: "/subscriptions/Microsoft.Authorization/policyDefinitions"},
+			},
+		},
+		"/MICROSOFT.AUTHORIZATION/POLICYEXEMPTIONS": {
+			"/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS": {
+				{ResourceType: "azurerm_management_group_policy_exemption", ImportSpec: "/Microsoft.Management/managementGroups/Microsoft.Authorization/policyExemptions"},
+			},
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_subscription_policy_exemption", ImportSpec: "/subscriptions/Microsoft.Authorization/policyExemptions"},
+			},
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_resource_group_policy_exemption", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Authorization/policyExemptions"},
+			},
+			"ANY": {
+				{ResourceType: "azurerm_resource_policy_exemption"},
+			},
+		},
+		"/MICROSOFT.AUTHORIZATION/POLICYSETDEFINITIONS": {
+			"/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS": {
+				{ResourceType: "azurerm_policy_set_definition", ImportSpec: "/Microsoft.Management/managementgroups/Microsoft.Authorization/policySetDefinitions"},
+			},
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_policy_set_definition", ImportSpec: "/subscriptions/Microsoft.Authorization/policySetDefinitions"},
+			},
+		},
+		"/MICROSOFT.AUTHORIZATION/PRIVATELINKASSOCIATIONS": {
+			"/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS": {
+				{ResourceType: "azurerm_resource_management_private_link_association", ImportSpec: "/Microsoft.Management/managementGroups/Microsoft.Authorization/privateLinkAssociations"},
+			},
+		},
+		"/MICROSOFT.AUTHORIZATION/RESOURCEMANAGEMENTPRIVATELINKS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_resource_management_private_link", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Authorization/resourceManagementPrivateLinks"},
+			},
+		},
+		"/MICROSOFT.AUTHORIZATION/ROLEASSIGNMENTS": {
+			"/MICROSOFT.MARKETPLACE": {
+				{ResourceType: "azurerm_marketplace_role_assignment", ImportSpec: "/Microsoft.Marketplace/Microsoft.Authorization/roleAssignments"},
+			},
+			"ANY": {
+				{ResourceType: "azurerm_role_assignment"},
+			},
+		},
+		"/MICROSOFT.AUTHORIZATION/ROLEDEFINITIONS": {
+			"ANY": {
+				{ResourceType: "azurerm_role_definition"},
+			},
+		},
+		"/MICROSOFT.AUTHORIZATION/ROLEMANAGEMENTPOLICIES": {
+			"ANY": {
+				{ResourceType: "azurerm_role_management_policy"},
+			},
+		},
+		"/MICROSOFT.AUTOMANAGE/CONFIGURATIONPROFILEASSIGNMENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.COMPUTE/VIRTUALMACHINES": {
+				{ResourceType: "azurerm_virtual_machine_automanage_configuration_assignment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/Microsoft.AutoManage/configurationProfileAssignments"},
+			},
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.HYBRIDCOMPUTE/MACHINES": {
+				{ResourceType: "azurerm_arc_machine_automanage_configuration_assignment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.HybridCompute/machines/Microsoft.AutoManage/configurationProfileAssignments"},
+			},
+		},
+		"/MICROSOFT.AUTOMANAGE/CONFIGURATIONPROFILES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_automanage_configuration", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AutoManage/configurationProfiles"},
+			},
+		},
+		"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_automation_account", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts"},
+			},
+		},
+		"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/CERTIFICATES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_automation_certificate", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/certificates"},
+			},
+		},
+		"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/CONFIGURATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_automation_dsc_configuration", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/configurations"},
+			},
+		},
+		"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/CONNECTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_automation_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/connections"},
+				{ResourceType: "azurerm_automation_connection_certificate", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/connections"},
+				{ResourceType: "azurerm_automation_connection_classic_certificate", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/connections"},
+				{ResourceType: "azurerm_automation_connection_service_principal", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/connections"},
+			},
+		},
+		"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/CONNECTIONTYPES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_automation_connection_type", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/connectionTypes"},
+			},
+		},
+		"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/CREDENTIALS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_automation_credential", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/credentials"},
+			},
+		},
+		"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/HYBRIDRUNBOOKWORKERGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_automation_hybrid_runbook_worker_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/hybridRunbookWorkerGroups"},
+			},
+		},
+		"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/HYBRIDRUNBOOKWORKERGROUPS/HYBRIDRUNBOOKWORKERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_automation_hybrid_runbook_worker", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/hybridRunbookWorkerGroups/hybridRunbookWorkers"},
+			},
+		},
+		"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/JOBSCHEDULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_automation_job_schedule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/jobSchedules"},
+			},
+		},
+		"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/MODULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_automation_module", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/modules"},
+			},
+		},
+		"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/NODECONFIGURATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_automation_dsc_nodeconfiguration", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/nodeConfigurations"},
+			},
+		},
+		"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/POWERSHELL72MODULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_automation_powershell72_module", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/powerShell72Modules"},
+			},
+		},
+		"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/PYTHON3PACKAGES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_automation_python3_package", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/python3Packages"},
+			},
+		},
+		"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/RUNBOOKS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_automation_runbook", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/runbooks"},
+			},
+		},
+		"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/SCHEDULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_automation_schedule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/schedules"},
+			},
+		},
+		"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/SOFTWAREUPDATECONFIGURATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_automation_software_update_configuration", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/softwareUpdateConfigurations"},
+			},
+		},
+		"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/SOURCECONTROLS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_automation_source_control", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/sourceControls"},
+			},
+		},
+		"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/VARIABLES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_automation_variable_bool", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/variables"},
+				{ResourceType: "azurerm_automation_variable_datetime", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/variables"},
+				{ResourceType: "azurerm_automation_variable_int", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/variables"},
+				{ResourceType: "azurerm_automation_variable_object", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/variables"},
+				{ResourceType: "azurerm_automation_variable_string", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/variables"},
+			},
+		},
+		"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/WATCHERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_automation_watcher", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/watchers"},
+			},
+		},
+		"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/WEBHOOKS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_automation_webhook", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/webHooks"},
+			},
+		},
+		"/MICROSOFT.AVS/PRIVATECLOUDS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_vmware_private_cloud", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AVS/privateClouds"},
+			},
+		},
+		"/MICROSOFT.AVS/PRIVATECLOUDS/AUTHORIZATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_vmware_express_route_authorization", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AVS/privateClouds/authorizations"},
+			},
+		},
+		"/MICROSOFT.AVS/PRIVATECLOUDS/CLUSTERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_vmware_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AVS/privateClouds/clusters"},
+			},
+		},
+		"/MICROSOFT.AVS/PRIVATECLOUDS/CLUSTERS/DATASTORES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_vmware_netapp_volume_attachment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AVS/privateClouds/clusters/dataStores"},
+			},
+		},
+		"/MICROSOFT.AZUREACTIVEDIRECTORY/B2CDIRECTORIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_aadb2c_directory", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AzureActiveDirectory/b2cDirectories"},
+			},
+		},
+		"/MICROSOFT.AZURESTACKHCI/CLUSTERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_stack_hci_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AzureStackHCI/clusters"},
+			},
+		},
+		"/MICROSOFT.AZURESTACKHCI/CLUSTERS/ARCSETTINGS/EXTENSIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_stack_hci_extension", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AzureStackHCI/clusters/arcSettings/extensions"},
+			},
+		},
+		"/MICROSOFT.AZURESTACKHCI/CLUSTERS/DEPLOYMENTSETTINGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_stack_hci_deployment_setting", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AzureStackHCI/clusters/deploymentSettings"},
+			},
+		},
+		"/MICROSOFT.AZURESTACKHCI/LOGICALNETWORKS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_stack_hci_logical_network", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AzureStackHCI/logicalNetworks"},
+			},
+		},
+		"/MICROSOFT.AZURESTACKHCI/MARKETPLACEGALLERYIMAGES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_stack_hci_marketplace_gallery_image", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AzureStackHCI/marketplaceGalleryImages"},
+			},
+		},
+		"/MICROSOFT.AZURESTACKHCI/NETWORKINTERFACES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_stack_hci_network_interface", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AzureStackHCI/networkInterfaces"},
+			},
+		},
+		"/MICROSOFT.AZURESTACKHCI/STORAGECONTAINERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_stack_hci_storage_path", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AzureStackHCI/storageContainers"},
+			},
+		},
+		"/MICROSOFT.AZURESTACKHCI/VIRTUALHARDDISKS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_stack_hci_virtual_hard_disk", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AzureStackHCI/virtualHardDisks"},
+			},
+		},
+		"/MICROSOFT.BATCH/BATCHACCOUNTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_batch_account", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Batch/batchAccounts"},
+			},
+		},
+		"/MICROSOFT.BATCH/BATCHACCOUNTS/APPLICATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_batch_application", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Batch/batchAccounts/applications"},
+			},
+		},
+		"/MICROSOFT.BATCH/BATCHACCOUNTS/CERTIFICATES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_batch_certificate", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Batch/batchAccounts/certificates"},
+			},
+		},
+		"/MICROSOFT.BATCH/BATCHACCOUNTS/POOLS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_batch_pool", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Batch/batchAccounts/pools"},
+			},
+		},
+		"/MICROSOFT.BATCH/BATCHACCOUNTS/POOLS/JOBS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_batch_job", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Batch/batchAccounts/pools/jobs"},
+			},
+		},
+		"/MICROSOFT.BLUEPRINT/BLUEPRINTASSIGNMENTS": {
+			"/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS": {
+				{ResourceType: "azurerm_blueprint_assignment", ImportSpec: "/Microsoft.Management/managementGroups/Microsoft.Blueprint/blueprintAssignments"},
+			},
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_blueprint_assignment", ImportSpec: "/subscriptions/Microsoft.Blueprint/blueprintAssignments"},
+			},
+		},
+		"/MICROSOFT.BOTSERVICE/BOTSERVICES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_bot_channels_registration", ImportSpec: "/subscriptions/resourceGroups/Microsoft.BotService/botServices"},
+				{ResourceType: "azurerm_bot_service_azure_bot", ImportSpec: "/subscriptions/resourceGroups/Microsoft.BotService/botServices"},
+				{ResourceType: "azurerm_bot_web_app", ImportSpec: "/subscriptions/resourceGroups/Microsoft.BotService/botServices"},
+			},
+		},
+		"/MICROSOFT.BOTSERVICE/BOTSERVICES/CHANNELS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_bot_channel_alexa", ImportSpec: "/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"},
+				{ResourceType: "azurerm_bot_channel_direct_line_speech", ImportSpec: "/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"},
+				{ResourceType: "azurerm_bot_channel_directline", ImportSpec: "/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"},
+				{ResourceType: "azurerm_bot_channel_email", ImportSpec: "/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"},
+				{ResourceType: "azurerm_bot_channel_facebook", ImportSpec: "/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"},
+				{ResourceType: "azurerm_bot_channel_line", ImportSpec: "/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"},
+				{ResourceType: "azurerm_bot_channel_ms_teams", ImportSpec: "/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"},
+				{ResourceType: "azurerm_bot_channel_slack", ImportSpec: "/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"},
+				{ResourceType: "azurerm_bot_channel_sms", ImportSpec: "/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"},
+				{ResourceType: "azurerm_bot_channel_web_chat", ImportSpec: "/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"},
+			},
+		},
+		"/MICROSOFT.BOTSERVICE/BOTSERVICES/CONNECTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_bot_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.BotService/botServices/connections"},
+			},
+		},
+		"/MICROSOFT.CACHE/REDIS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_redis_cache", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Cache/redis"},
+			},
+		},
+		"/MICROSOFT.CACHE/REDIS/ACCESSPOLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_redis_cache_access_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Cache/redis/accessPolicies"},
+			},
+		},
+		"/MICROSOFT.CACHE/REDIS/ACCESSPOLICYASSIGNMENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_redis_cache_access_policy_assignment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Cache/redis/accessPolicyAssignments"},
+			},
+		},
+		"/MICROSOFT.CACHE/REDIS/FIREWALLRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_redis_firewall_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Cache/redis/firewallRules"},
+			},
+		},
+		"/MICROSOFT.CACHE/REDIS/LINKEDSERVERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_redis_linked_server", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Cache/redis/linkedServers"},
+			},
+		},
+		"/MICROSOFT.CACHE/REDISENTERPRISE": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_redis_enterprise_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Cache/redisEnterprise"},
+			},
+		},
+		"/MICROSOFT.CACHE/REDISENTERPRISE/DATABASES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_redis_enterprise_database", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Cache/redisEnterprise/databases"},
+			},
+		},
+		"/MICROSOFT.CDN/PROFILES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cdn_frontdoor_profile", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Cdn/profiles"},
+				{ResourceType: "azurerm_cdn_profile", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Cdn/profiles"},
+			},
+		},
+		"/MICROSOFT.CDN/PROFILES/AFDENDPOINTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cdn_frontdoor_endpoint", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/afdEndpoints"},
+			},
+		},
+		"/MICROSOFT.CDN/PROFILES/AFDENDPOINTS/ROUTES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cdn_frontdoor_route", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/afdEndpoints/routes"},
+			},
+		},
+		"/MICROSOFT.CDN/PROFILES/ASSOCIATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cdn_frontdoor_custom_domain_association", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/associations"},
+			},
+		},
+		"/MICROSOFT.CDN/PROFILES/CUSTOMDOMAINS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cdn_frontdoor_custom_domain", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/customDomains"},
+			},
+		},
+		"/MICROSOFT.CDN/PROFILES/ENDPOINTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cdn_endpoint", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/endpoints"},
+			},
+		},
+		"/MICROSOFT.CDN/PROFILES/ENDPOINTS/CUSTOMDOMAINS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cdn_endpoint_custom_domain", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/endpoints/customDomains"},
+			},
+		},
+		"/MICROSOFT.CDN/PROFILES/ORIGINGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cdn_frontdoor_origin_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/originGroups"},
+			},
+		},
+		"/MICROSOFT.CDN/PROFILES/ORIGINGROUPS/ORIGINS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cdn_frontdoor_origin", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/originGroups/origins"},
+			},
+		},
+		"/MICROSOFT.CDN/PROFILES/RULESETS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cdn_frontdoor_rule_set", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/ruleSets"},
+			},
+		},
+		"/MICROSOFT.CDN/PROFILES/RULESETS/RULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cdn_frontdoor_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/ruleSets/rules"},
+			},
+		},
+		"/MICROSOFT.CDN/PROFILES/SECRETS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cdn_frontdoor_secret", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/secrets"},
+			},
+		},
+		"/MICROSOFT.CDN/PROFILES/SECURITYPOLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cdn_frontdoor_security_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/securityPolicies"},
+			},
+		},
+		"/MICROSOFT.CERTIFICATEREGISTRATION/CERTIFICATEORDERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_app_service_certificate_order", ImportSpec: "/subscriptions/resourceGroups/Microsoft.CertificateRegistration/certificateOrders"},
+			},
+		},
+		"/MICROSOFT.CHAOS/EXPERIMENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_chaos_studio_experiment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Chaos/experiments"},
+			},
+		},
+		"/MICROSOFT.CHAOS/TARGETS": {
+			"ANY": {
+				{ResourceType: "azurerm_chaos_studio_target"},
+			},
+		},
+		"/MICROSOFT.CHAOS/TARGETS/CAPABILITIES": {
+			"ANY": {
+				{ResourceType: "azurerm_chaos_studio_capability"},
+			},
+		},
+		"/MICROSOFT.CODESIGNING/CODESIGNINGACCOUNTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_trusted_signing_account", ImportSpec: "/subscriptions/resourceGroups/Microsoft.CodeSigning/codeSigningAccounts"},
+			},
+		},
+		"/MICROSOFT.COGNITIVESERVICES/ACCOUNTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_ai_services", ImportSpec: "/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts"},
+				{ResourceType: "azurerm_cognitive_account", ImportSpec: "/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts"},
+			},
+		},
+		"/MICROSOFT.COGNITIVESERVICES/ACCOUNTS/DEPLOYMENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cognitive_deployment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/deployments"},
+			},
+		},
+		"/MICROSOFT.COGNITIVESERVICES/ACCOUNTS/RAIBLOCKLISTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cognitive_account_rai_blocklist", ImportSpec: "/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/raiBlocklists"},
+			},
+		},
+		"/MICROSOFT.COGNITIVESERVICES/ACCOUNTS/RAIPOLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cognitive_account_rai_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/raiPolicies"},
+			},
+		},
+		"/MICROSOFT.COMMUNICATION/COMMUNICATIONSERVICES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_communication_service", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Communication/communicationServices"},
+			},
+		},
+		"/MICROSOFT.COMMUNICATION/COMMUNICATIONSERVICES/LINKEDDOMAINS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_communication_service_email_domain_association"},
+			},
+		},
+		"/MICROSOFT.COMMUNICATION/EMAILSERVICES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_email_communication_service", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Communication/emailServices"},
+			},
+		},
+		"/MICROSOFT.COMMUNICATION/EMAILSERVICES/DOMAINS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_email_communication_service_domain", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Communication/emailServices/domains"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/AVAILABILITYSETS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_availability_set", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/availabilitySets"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/CAPACITYRESERVATIONGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_capacity_reservation_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/capacityReservationGroups"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/CAPACITYRESERVATIONGROUPS/CAPACITYRESERVATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_capacity_reservation", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/capacityReservationGroups/capacityReservations"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/DISKACCESSES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_disk_access", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/diskAccesses"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/DISKENCRYPTIONSETS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_disk_encryption_set", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/diskEncryptionSets"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/DISKS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_managed_disk", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/disks"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/GALLERIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_shared_image_gallery", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/galleries"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/GALLERIES/APPLICATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_gallery_application", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/galleries/applications"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/GALLERIES/APPLICATIONS/VERSIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_gallery_application_version", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/galleries/applications/versions"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/GALLERIES/IMAGES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_shared_image", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/galleries/images"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/GALLERIES/IMAGES/VERSIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_shared_image_version", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/galleries/images/versions"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/HOSTGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dedicated_host_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/hostGroups"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/HOSTGROUPS/HOSTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dedicated_host", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/hostGroups/hosts"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/IMAGES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_image", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/images"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/PROXIMITYPLACEMENTGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_proximity_placement_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/proximityPlacementGroups"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/RESTOREPOINTCOLLECTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_machine_restore_point_collection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/restorePointCollections"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/RESTOREPOINTCOLLECTIONS/RESTOREPOINTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_machine_restore_point", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/restorePointCollections/restorePoints"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/SNAPSHOTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_snapshot", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/snapshots"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/SSHPUBLICKEYS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_ssh_public_key", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/sshPublicKeys"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/VIRTUALMACHINES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_linux_virtual_machine", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines"},
+				{ResourceType: "azurerm_virtual_machine", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines"},
+				{ResourceType: "azurerm_windows_virtual_machine", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/VIRTUALMACHINES/DATADISKS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_machine_data_disk_attachment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/dataDisks"},
+				{ResourceType: "azurerm_virtual_machine_implicit_data_disk_from_source", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/dataDisks"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/VIRTUALMACHINES/EXTENSIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_machine_extension", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/extensions"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/VIRTUALMACHINES/GALLERYAPPLICATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_machine_gallery_application_assignment"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/VIRTUALMACHINES/RUNCOMMANDS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_machine_run_command", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/runCommands"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/VIRTUALMACHINESCALESETS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_linux_virtual_machine_scale_set", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets"},
+				{ResourceType: "azurerm_orchestrated_virtual_machine_scale_set", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets"},
+				{ResourceType: "azurerm_windows_virtual_machine_scale_set", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets"},
+			},
+		},
+		"/MICROSOFT.COMPUTE/VIRTUALMACHINESCALESETS/EXTENSIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_machine_scale_set_extension", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets/extensions"},
+			},
+		},
+		"/MICROSOFT.CONFIDENTIALLEDGER/LEDGERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_confidential_ledger", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ConfidentialLedger/ledgers"},
+			},
+		},
+		"/MICROSOFT.CONSUMPTION/BUDGETS": {
+			"/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS": {
+				{ResourceType: "azurerm_consumption_budget_management_group", ImportSpec: "/Microsoft.Management/managementGroups/Microsoft.Consumption/budgets"},
+			},
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_consumption_budget_subscription", ImportSpec: "/subscriptions/Microsoft.Consumption/budgets"},
+			},
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_consumption_budget_resource_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Consumption/budgets"},
+			},
+		},
+		"/MICROSOFT.CONTAINERINSTANCE/CONTAINERGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_container_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ContainerInstance/containerGroups"},
+			},
+		},
+		"/MICROSOFT.CONTAINERREGISTRY/REGISTRIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_container_registry", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries"},
+			},
+		},
+		"/MICROSOFT.CONTAINERREGISTRY/REGISTRIES/AGENTPOOLS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_container_registry_agent_pool", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/agentPools"},
+			},
+		},
+		"/MICROSOFT.CONTAINERREGISTRY/REGISTRIES/CACHERULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_container_registry_cache_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/cacheRules"},
+			},
+		},
+		"/MICROSOFT.CONTAINERREGISTRY/REGISTRIES/CONNECTEDREGISTRIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_container_connected_registry", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/connectedRegistries"},
+			},
+		},
+		"/MICROSOFT.CONTAINERREGISTRY/REGISTRIES/CREDENTIALSETS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_container_registry_credential_set", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/credentialSets"},
+			},
+		},
+		"/MICROSOFT.CONTAINERREGISTRY/REGISTRIES/SCOPEMAPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_container_registry_scope_map", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/scopeMaps"},
+			},
+		},
+		"/MICROSOFT.CONTAINERREGISTRY/REGISTRIES/TASKS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_container_registry_task", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/tasks"},
+			},
+		},
+		"/MICROSOFT.CONTAINERREGISTRY/REGISTRIES/TOKENS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_container_registry_token", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/tokens"},
+			},
+		},
+		"/MICROSOFT.CONTAINERREGISTRY/REGISTRIES/TOKENS/PASSWORDS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_container_registry_token_password", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/tokens/passwords"},
+			},
+		},
+		"/MICROSOFT.CONTAINERREGISTRY/REGISTRIES/WEBHOOKS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_container_registry_webhook", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/webHooks"},
+			},
+		},
+		"/MICROSOFT.CONTAINERSERVICE/FLEETS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_kubernetes_fleet_manager", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ContainerService/fleets"},
+			},
+		},
+		"/MICROSOFT.CONTAINERSERVICE/FLEETS/MEMBERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_kubernetes_fleet_member", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ContainerService/fleets/members"},
+			},
+		},
+		"/MICROSOFT.CONTAINERSERVICE/FLEETS/UPDATERUNS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_kubernetes_fleet_update_run", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ContainerService/fleets/updateRuns"},
+			},
+		},
+		"/MICROSOFT.CONTAINERSERVICE/FLEETS/UPDATESTRATEGIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_kubernetes_fleet_update_strategy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ContainerService/fleets/updateStrategies"},
+			},
+		},
+		"/MICROSOFT.CONTAINERSERVICE/MANAGEDCLUSTERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_kubernetes_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters"},
+			},
+		},
+		"/MICROSOFT.CONTAINERSERVICE/MANAGEDCLUSTERS/AGENTPOOLS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_kubernetes_cluster_node_pool", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters/agentPools"},
+			},
+		},
+		"/MICROSOFT.CONTAINERSERVICE/MANAGEDCLUSTERS/TRUSTEDACCESSROLEBINDINGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_kubernetes_cluster_trusted_access_role_binding", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters/trustedAccessRoleBindings"},
+			},
+		},
+		"/MICROSOFT.COSTMANAGEMENT/EXPORTS": {
+			"/MICROSOFT.BILLING/BILLINGACCOUNTS": {
+				{ResourceType: "azurerm_billing_account_cost_management_export", ImportSpec: "/Microsoft.Billing/billingAccounts/Microsoft.CostManagement/exports"},
+			},
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_subscription_cost_management_export", ImportSpec: "/subscriptions/Microsoft.CostManagement/exports"},
+			},
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_resource_group_cost_management_export", ImportSpec: "/subscriptions/resourceGroups/Microsoft.CostManagement/exports"},
+			},
+		},
+		"/MICROSOFT.COSTMANAGEMENT/SCHEDULEDACTIONS": {
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_cost_anomaly_alert", ImportSpec: "/subscriptions/Microsoft.CostManagement/scheduledActions"},
+				{ResourceType: "azurerm_cost_management_scheduled_action", ImportSpec: "/subscriptions/Microsoft.CostManagement/scheduledActions"},
+			},
+		},
+		"/MICROSOFT.COSTMANAGEMENT/VIEWS": {
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_subscription_cost_management_view", ImportSpec: "/subscriptions/Microsoft.CostManagement/views"},
+			},
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_resource_group_cost_management_view", ImportSpec: "/subscriptions/resourceGroups/Microsoft.CostManagement/views"},
+			},
+		},
+		"/MICROSOFT.CUSTOMPROVIDERS/RESOURCEPROVIDERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_custom_provider", ImportSpec: "/subscriptions/resourceGroups/Microsoft.CustomProviders/resourceProviders"},
+			},
+		},
+		"/MICROSOFT.DASHBOARD/GRAFANA": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dashboard_grafana", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Dashboard/grafana"},
+			},
+		},
+		"/MICROSOFT.DASHBOARD/GRAFANA/MANAGEDPRIVATEENDPOINTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dashboard_grafana_managed_private_endpoint", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Dashboard/grafana/managedPrivateEndpoints"},
+			},
+		},
+		"/MICROSOFT.DATABOXEDGE/DATABOXEDGEDEVICES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_databox_edge_device", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataBoxEdge/dataBoxEdgeDevices"},
+			},
+		},
+		"/MICROSOFT.DATABRICKS/ACCESSCONNECTORS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_databricks_access_connector", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Databricks/accessConnectors"},
+			},
+		},
+		"/MICROSOFT.DATABRICKS/WORKSPACES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_databricks_workspace", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Databricks/workspaces"},
+			},
+		},
+		"/MICROSOFT.DATABRICKS/WORKSPACES/VIRTUALNETWORKPEERINGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_databricks_virtual_network_peering", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Databricks/workspaces/virtualNetworkPeerings"},
+			},
+		},
+		"/MICROSOFT.DATADOG/MONITORS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_datadog_monitor", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Datadog/monitors"},
+			},
+		},
+		"/MICROSOFT.DATADOG/MONITORS/SINGLESIGNONCONFIGURATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_datadog_monitor_sso_configuration", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Datadog/monitors/singleSignOnConfigurations"},
+			},
+		},
+		"/MICROSOFT.DATADOG/MONITORS/TAGRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_datadog_monitor_tag_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Datadog/monitors/tagRules"},
+			},
+		},
+		"/MICROSOFT.DATAFACTORY/FACTORIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_data_factory", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories"},
+			},
+		},
+		"/MICROSOFT.DATAFACTORY/FACTORIES/CREDENTIALS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_data_factory_credential_service_principal", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/credentials"},
+				{ResourceType: "azurerm_data_factory_credential_user_managed_identity", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/credentials"},
+			},
+		},
+		"/MICROSOFT.DATAFACTORY/FACTORIES/DATAFLOWS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_data_factory_data_flow", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/dataflows"},
+				{ResourceType: "azurerm_data_factory_flowlet_data_flow", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/dataflows"},
+			},
+		},
+		"/MICROSOFT.DATAFACTORY/FACTORIES/DATASETS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_data_factory_custom_dataset", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+				{ResourceType: "azurerm_data_factory_dataset_azure_blob", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+				{ResourceType: "azurerm_data_factory_dataset_azure_sql_table", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+				{ResourceType: "azurerm_data_factory_dataset_binary", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+				{ResourceType: "azurerm_data_factory_dataset_cosmosdb_sqlapi", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+				{ResourceType: "azurerm_data_factory_dataset_delimited_text", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+				{ResourceType: "azurerm_data_factory_dataset_http", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+				{ResourceType: "azurerm_data_factory_dataset_json", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+				{ResourceType: "azurerm_data_factory_dataset_mysql", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+				{ResourceType: "azurerm_data_factory_dataset_parquet", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+				{ResourceType: "azurerm_data_factory_dataset_postgresql", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+				{ResourceType: "azurerm_data_factory_dataset_snowflake", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+				{ResourceType: "azurerm_data_factory_dataset_sql_server_table", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"},
+			},
+		},
+		"/MICROSOFT.DATAFACTORY/FACTORIES/INTEGRATIONRUNTIMES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_data_factory_integration_runtime_azure", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/integrationruntimes"},
+				{ResourceType: "azurerm_data_factory_integration_runtime_azure_ssis", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/integrationruntimes"},
+				{ResourceType: "azurerm_data_factory_integration_runtime_self_hosted", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/integrationruntimes"},
+			},
+		},
+		"/MICROSOFT.DATAFACTORY/FACTORIES/LINKEDSERVICES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_data_factory_linked_custom_service", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+				{ResourceType: "azurerm_data_factory_linked_service_azure_blob_storage", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+				{ResourceType: "azurerm_data_factory_linked_service_azure_databricks", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+				{ResourceType: "azurerm_data_factory_linked_service_azure_file_storage", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+				{ResourceType: "azurerm_data_factory_linked_service_azure_function", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+				{ResourceType: "azurerm_data_factory_linked_service_azure_search", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+				{ResourceType: "azurerm_data_factory_linked_service_azure_sql_database", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+				{ResourceType: "azurerm_data_factory_linked_service_azure_table_storage", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+				{ResourceType: "azurerm_data_factory_linked_service_cosmosdb", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+				{ResourceType: "azurerm_data_factory_linked_service_cosmosdb_mongoapi", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+				{ResourceType: "azurerm_data_factory_linked_service_data_lake_storage_gen2", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+				{ResourceType: "azurerm_data_factory_linked_service_key_vault", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+				{ResourceType: "azurerm_data_factory_linked_service_kusto", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+				{ResourceType: "azurerm_data_factory_linked_service_mysql", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+				{ResourceType: "azurerm_data_factory_linked_service_odata", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+				{ResourceType: "azurerm_data_factory_linked_service_odbc", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+				{ResourceType: "azurerm_data_factory_linked_service_postgresql", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+				{ResourceType: "azurerm_data_factory_linked_service_sftp", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+				{ResourceType: "azurerm_data_factory_linked_service_snowflake", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+				{ResourceType: "azurerm_data_factory_linked_service_sql_server", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+				{ResourceType: "azurerm_data_factory_linked_service_synapse", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+				{ResourceType: "azurerm_data_factory_linked_service_web", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"},
+			},
+		},
+		"/MICROSOFT.DATAFACTORY/FACTORIES/MANAGEDVIRTUALNETWORKS/MANAGEDPRIVATEENDPOINTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_data_factory_managed_private_endpoint", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/managedVirtualNetworks/managedPrivateEndpoints"},
+			},
+		},
+		"/MICROSOFT.DATAFACTORY/FACTORIES/PIPELINES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_data_factory_pipeline", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/pipelines"},
+			},
+		},
+		"/MICROSOFT.DATAFACTORY/FACTORIES/TRIGGERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_data_factory_trigger_blob_event", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/triggers"},
+				{ResourceType: "azurerm_data_factory_trigger_custom_event", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/triggers"},
+				{ResourceType: "azurerm_data_factory_trigger_schedule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/triggers"},
+				{ResourceType: "azurerm_data_factory_trigger_tumbling_window", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/triggers"},
+			},
+		},
+		"/MICROSOFT.DATAMIGRATION/SERVICES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_database_migration_service", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataMigration/services"},
+			},
+		},
+		"/MICROSOFT.DATAMIGRATION/SERVICES/PROJECTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_database_migration_project", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataMigration/services/projects"},
+			},
+		},
+		"/MICROSOFT.DATAPROTECTION/BACKUPVAULTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_data_protection_backup_vault", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults"},
+			},
+		},
+		"/MICROSOFT.DATAPROTECTION/BACKUPVAULTS/BACKUPINSTANCES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_data_protection_backup_instance_blob_storage", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"},
+				{ResourceType: "azurerm_data_protection_backup_instance_disk", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"},
+				{ResourceType: "azurerm_data_protection_backup_instance_kubernetes_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"},
+				{ResourceType: "azurerm_data_protection_backup_instance_mysql_flexible_server", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"},
+				{ResourceType: "azurerm_data_protection_backup_instance_postgresql", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"},
+				{ResourceType: "azurerm_data_protection_backup_instance_postgresql_flexible_server", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"},
+			},
+		},
+		"/MICROSOFT.DATAPROTECTION/BACKUPVAULTS/BACKUPPOLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_data_protection_backup_policy_blob_storage", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"},
+				{ResourceType: "azurerm_data_protection_backup_policy_disk", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"},
+				{ResourceType: "azurerm_data_protection_backup_policy_kubernetes_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"},
+				{ResourceType: "azurerm_data_protection_backup_policy_mysql_flexible_server", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"},
+				{ResourceType: "azurerm_data_protection_backup_policy_postgresql", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"},
+				{ResourceType: "azurerm_data_protection_backup_policy_postgresql_flexible_server", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"},
+			},
+		},
+		"/MICROSOFT.DATAPROTECTION/RESOURCEGUARDS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_data_protection_resource_guard", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataProtection/resourceGuards"},
+			},
+		},
+		"/MICROSOFT.DATASHARE/ACCOUNTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_data_share_account", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataShare/accounts"},
+			},
+		},
+		"/MICROSOFT.DATASHARE/ACCOUNTS/SHARES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_data_share", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataShare/accounts/shares"},
+			},
+		},
+		"/MICROSOFT.DATASHARE/ACCOUNTS/SHARES/DATASETS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_data_share_dataset_blob_storage", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataShare/accounts/shares/dataSets"},
+				{ResourceType: "azurerm_data_share_dataset_data_lake_gen2", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataShare/accounts/shares/dataSets"},
+				{ResourceType: "azurerm_data_share_dataset_kusto_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataShare/accounts/shares/dataSets"},
+				{ResourceType: "azurerm_data_share_dataset_kusto_database", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DataShare/accounts/shares/dataSets"},
+			},
+		},
+		"/MICROSOFT.DBFORMYSQL/FLEXIBLESERVERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mysql_flexible_server", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DBforMySQL/flexibleServers"},
+			},
+		},
+		"/MICROSOFT.DBFORMYSQL/FLEXIBLESERVERS/ADMINISTRATORS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mysql_flexible_server_active_directory_administrator", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DBforMySQL/flexibleServers/administrators"},
+			},
+		},
+		"/MICROSOFT.DBFORMYSQL/FLEXIBLESERVERS/CONFIGURATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mysql_flexible_server_configuration", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DBforMySQL/flexibleServers/configurations"},
+			},
+		},
+		"/MICROSOFT.DBFORMYSQL/FLEXIBLESERVERS/DATABASES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mysql_flexible_database", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DBforMySQL/flexibleServers/databases"},
+			},
+		},
+		"/MICROSOFT.DBFORMYSQL/FLEXIBLESERVERS/FIREWALLRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mysql_flexible_server_firewall_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DBforMySQL/flexibleServers/firewallRules"},
+			},
+		},
+		"/MICROSOFT.DBFORPOSTGRESQL/FLEXIBLESERVERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_postgresql_flexible_server", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers"},
+			},
+		},
+		"/MICROSOFT.DBFORPOSTGRESQL/FLEXIBLESERVERS/ADMINISTRATORS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_postgresql_flexible_server_active_directory_administrator", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers/administrators"},
+			},
+		},
+		"/MICROSOFT.DBFORPOSTGRESQL/FLEXIBLESERVERS/CONFIGURATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_postgresql_flexible_server_configuration", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers/configurations"},
+			},
+		},
+		"/MICROSOFT.DBFORPOSTGRESQL/FLEXIBLESERVERS/DATABASES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_postgresql_flexible_server_database", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers/databases"},
+			},
+		},
+		"/MICROSOFT.DBFORPOSTGRESQL/FLEXIBLESERVERS/FIREWALLRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_postgresql_flexible_server_firewall_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers/firewallRules"},
+			},
+		},
+		"/MICROSOFT.DBFORPOSTGRESQL/FLEXIBLESERVERS/VIRTUALENDPOINTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_postgresql_flexible_server_virtual_endpoint", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers/virtualEndpoints"},
+			},
+		},
+		"/MICROSOFT.DBFORPOSTGRESQL/SERVERGROUPSV2": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_postgresql_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/serverGroupsv2"},
+			},
+		},
+		"/MICROSOFT.DBFORPOSTGRESQL/SERVERGROUPSV2/COORDINATORCONFIGURATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_postgresql_coordinator_configuration", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/serverGroupsv2/coordinatorConfigurations"},
+			},
+		},
+		"/MICROSOFT.DBFORPOSTGRESQL/SERVERGROUPSV2/FIREWALLRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_postgresql_firewall_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/serverGroupsv2/firewallRules"},
+			},
+		},
+		"/MICROSOFT.DBFORPOSTGRESQL/SERVERGROUPSV2/NODECONFIGURATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_postgresql_node_configuration", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/serverGroupsv2/nodeConfigurations"},
+			},
+		},
+		"/MICROSOFT.DBFORPOSTGRESQL/SERVERGROUPSV2/ROLES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_postgresql_role", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/serverGroupsv2/roles"},
+			},
+		},
+		"/MICROSOFT.DBFORPOSTGRESQL/SERVERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_postgresql_server", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers"},
+			},
+		},
+		"/MICROSOFT.DBFORPOSTGRESQL/SERVERS/ADMINISTRATORS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_postgresql_active_directory_administrator", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers"},
+			},
+		},
+		"/MICROSOFT.DBFORPOSTGRESQL/SERVERS/CONFIGURATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_postgresql_configuration", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers/configurations"},
+			},
+		},
+		"/MICROSOFT.DBFORPOSTGRESQL/SERVERS/DATABASES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_postgresql_database", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers/databases"},
+			},
+		},
+		"/MICROSOFT.DBFORPOSTGRESQL/SERVERS/FIREWALLRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_postgresql_firewall_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers/firewallRules"},
+			},
+		},
+		"/MICROSOFT.DBFORPOSTGRESQL/SERVERS/KEYS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_postgresql_server_key", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers/keys"},
+			},
+		},
+		"/MICROSOFT.DBFORPOSTGRESQL/SERVERS/VIRTUALNETWORKRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_postgresql_virtual_network_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers/virtualNetworkRules"},
+			},
+		},
+		"/MICROSOFT.DESKTOPVIRTUALIZATION/APPLICATIONGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_desktop_application_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/applicationGroups"},
+			},
+		},
+		"/MICROSOFT.DESKTOPVIRTUALIZATION/APPLICATIONGROUPS/APPLICATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_desktop_application", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/applicationGroups/applications"},
+			},
+		},
+		"/MICROSOFT.DESKTOPVIRTUALIZATION/HOSTPOOLS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_desktop_host_pool", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/hostPools"},
+			},
+		},
+		"/MICROSOFT.DESKTOPVIRTUALIZATION/HOSTPOOLS/REGISTRATIONINFO": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_desktop_host_pool_registration_info", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/hostPools/registrationInfo"},
+			},
+		},
+		"/MICROSOFT.DESKTOPVIRTUALIZATION/SCALINGPLANS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_desktop_scaling_plan", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/scalingPlans"},
+			},
+		},
+		"/MICROSOFT.DESKTOPVIRTUALIZATION/SCALINGPLANS/HOSTPOOLS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_desktop_scaling_plan_host_pool_association"},
+			},
+		},
+		"/MICROSOFT.DESKTOPVIRTUALIZATION/WORKSPACES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_desktop_workspace", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/workspaces"},
+			},
+		},
+		"/MICROSOFT.DESKTOPVIRTUALIZATION/WORKSPACES/APPLICATIONGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_desktop_workspace_application_group_association"},
+			},
+		},
+		"/MICROSOFT.DEVCENTER/DEVCENTERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dev_center", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters"},
+			},
+		},
+		"/MICROSOFT.DEVCENTER/DEVCENTERS/ATTACHEDNETWORKS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dev_center_attached_network", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters/attachedNetworks"},
+			},
+		},
+		"/MICROSOFT.DEVCENTER/DEVCENTERS/CATALOGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dev_center_catalog", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters/catalogs"},
+			},
+		},
+		"/MICROSOFT.DEVCENTER/DEVCENTERS/DEVBOXDEFINITIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dev_center_dev_box_definition", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters/devBoxDefinitions"},
+			},
+		},
+		"/MICROSOFT.DEVCENTER/DEVCENTERS/ENVIRONMENTTYPES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dev_center_environment_type", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters/environmentTypes"},
+			},
+		},
+		"/MICROSOFT.DEVCENTER/DEVCENTERS/GALLERIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dev_center_gallery", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters/galleries"},
+			},
+		},
+		"/MICROSOFT.DEVCENTER/NETWORKCONNECTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dev_center_network_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DevCenter/networkConnections"},
+			},
+		},
+		"/MICROSOFT.DEVCENTER/PROJECTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dev_center_project", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DevCenter/projects"},
+			},
+		},
+		"/MICROSOFT.DEVCENTER/PROJECTS/ENVIRONMENTTYPES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dev_center_project_environment_type", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DevCenter/projects/environmentTypes"},
+			},
+		},
+		"/MICROSOFT.DEVCENTER/PROJECTS/POOLS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dev_center_project_pool", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DevCenter/projects/pools"},
+			},
+		},
+		"/MICROSOFT.DEVICES/IOTHUBS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_iothub", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs"},
+			},
+		},
+		"/MICROSOFT.DEVICES/IOTHUBS/CERTIFICATES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_iothub_certificate", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/certificates"},
+			},
+		},
+		"/MICROSOFT.DEVICES/IOTHUBS/ENDPOINTSCOSMOSDBACCOUNT": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_iothub_endpoint_cosmosdb_account", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/endpoints"},
+			},
+		},
+		"/MICROSOFT.DEVICES/IOTHUBS/ENDPOINTSEVENTHUB": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_iothub_endpoint_eventhub", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/endpoints"},
+			},
+		},
+		"/MICROSOFT.DEVICES/IOTHUBS/ENDPOINTSSERVICEBUSQUEUE": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_iothub_endpoint_servicebus_queue", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/endpoints"},
+			},
+		},
+		"/MICROSOFT.DEVICES/IOTHUBS/ENDPOINTSSERVICEBUSTOPIC": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_iothub_endpoint_servicebus_topic", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/endpoints"},
+			},
+		},
+		"/MICROSOFT.DEVICES/IOTHUBS/ENDPOINTSSTORAGECONTAINER": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_iothub_endpoint_storage_container", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/endpoints"},
+			},
+		},
+		"/MICROSOFT.DEVICES/IOTHUBS/ENRICHMENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_iothub_enrichment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/enrichments"},
+			},
+		},
+		"/MICROSOFT.DEVICES/IOTHUBS/EVENTHUBENDPOINTS/CONSUMERGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_iothub_consumer_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/eventHubEndpoints/consumerGroups"},
+			},
+		},
+		"/MICROSOFT.DEVICES/IOTHUBS/FALLBACKROUTE": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_iothub_fallback_route", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/fallbackRoute"},
+			},
+		},
+		"/MICROSOFT.DEVICES/IOTHUBS/IOTHUBKEYS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_iothub_shared_access_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/iotHubKeys"},
+			},
+		},
+		"/MICROSOFT.DEVICES/IOTHUBS/ROUTES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_iothub_route", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/routes"},
+			},
+		},
+		"/MICROSOFT.DEVICES/PROVISIONINGSERVICES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_iothub_dps", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Devices/provisioningServices"},
+			},
+		},
+		"/MICROSOFT.DEVICES/PROVISIONINGSERVICES/CERTIFICATES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_iothub_dps_certificate", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Devices/provisioningServices/certificates"},
+			},
+		},
+		"/MICROSOFT.DEVICES/PROVISIONINGSERVICES/KEYS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_iothub_dps_shared_access_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Devices/provisioningServices/keys"},
+			},
+		},
+		"/MICROSOFT.DEVICEUPDATE/ACCOUNTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_iothub_device_update_account", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DeviceUpdate/accounts"},
+			},
+		},
+		"/MICROSOFT.DEVICEUPDATE/ACCOUNTS/INSTANCES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_iothub_device_update_instance", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DeviceUpdate/accounts/instances"},
+			},
+		},
+		"/MICROSOFT.DEVTESTLAB/LABS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dev_test_lab", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DevTestLab/labs"},
+			},
+		},
+		"/MICROSOFT.DEVTESTLAB/LABS/POLICYSETS/POLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dev_test_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DevTestLab/labs/policySets/policies"},
+			},
+		},
+		"/MICROSOFT.DEVTESTLAB/LABS/SCHEDULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dev_test_schedule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DevTestLab/labs/schedules"},
+			},
+		},
+		"/MICROSOFT.DEVTESTLAB/LABS/VIRTUALMACHINES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dev_test_linux_virtual_machine", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DevTestLab/labs/virtualMachines"},
+				{ResourceType: "azurerm_dev_test_windows_virtual_machine", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DevTestLab/labs/virtualMachines"},
+			},
+		},
+		"/MICROSOFT.DEVTESTLAB/LABS/VIRTUALNETWORKS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dev_test_virtual_network", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DevTestLab/labs/virtualNetworks"},
+			},
+		},
+		"/MICROSOFT.DEVTESTLAB/SCHEDULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dev_test_global_vm_shutdown_schedule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DevTestLab/schedules"},
+			},
+		},
+		"/MICROSOFT.DIGITALTWINS/DIGITALTWINSINSTANCES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_digital_twins_instance", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DigitalTwins/digitalTwinsInstances"},
+			},
+		},
+		"/MICROSOFT.DIGITALTWINS/DIGITALTWINSINSTANCES/ENDPOINTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_digital_twins_endpoint_eventgrid", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DigitalTwins/digitalTwinsInstances/endpoints"},
+				{ResourceType: "azurerm_digital_twins_endpoint_eventhub", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DigitalTwins/digitalTwinsInstances/endpoints"},
+				{ResourceType: "azurerm_digital_twins_endpoint_servicebus", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DigitalTwins/digitalTwinsInstances/endpoints"},
+			},
+		},
+		"/MICROSOFT.DIGITALTWINS/DIGITALTWINSINSTANCES/TIMESERIESDATABASECONNECTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_digital_twins_time_series_database_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DigitalTwins/digitalTwinsInstances/timeSeriesDatabaseConnections"},
+			},
+		},
+		"/MICROSOFT.DOCUMENTDB/CASSANDRACLUSTERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_cassandra_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DocumentDB/cassandraClusters"},
+			},
+		},
+		"/MICROSOFT.DOCUMENTDB/CASSANDRACLUSTERS/DATACENTERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_cassandra_datacenter", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DocumentDB/cassandraClusters/dataCenters"},
+			},
+		},
+		"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_account", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts"},
+			},
+		},
+		"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/CASSANDRAKEYSPACES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_cassandra_keyspace", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/cassandraKeyspaces"},
+			},
+		},
+		"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/CASSANDRAKEYSPACES/TABLES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_cassandra_table", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/cassandraKeyspaces/tables"},
+			},
+		},
+		"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/GREMLINDATABASES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_gremlin_database", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/gremlinDatabases"},
+			},
+		},
+		"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/GREMLINDATABASES/GRAPHS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_gremlin_graph", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/gremlinDatabases/graphs"},
+			},
+		},
+		"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/MONGODBDATABASES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_mongo_database", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/mongodbDatabases"},
+			},
+		},
+		"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/MONGODBDATABASES/COLLECTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_mongo_collection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/mongodbDatabases/collections"},
+			},
+		},
+		"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/MONGODBROLEDEFINITIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_mongo_role_definition", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/mongodbRoleDefinitions"},
+			},
+		},
+		"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/MONGODBUSERDEFINITIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_mongo_user_definition", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/mongodbUserDefinitions"},
+			},
+		},
+		"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/SERVICES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_sql_dedicated_gateway", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/services"},
+			},
+		},
+		"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/SQLDATABASES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_sql_database", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlDatabases"},
+			},
+		},
+		"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/SQLDATABASES/CONTAINERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_sql_container", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers"},
+			},
+		},
+		"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/SQLDATABASES/CONTAINERS/STOREDPROCEDURES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_sql_stored_procedure", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers/storedProcedures"},
+			},
+		},
+		"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/SQLDATABASES/CONTAINERS/TRIGGERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_sql_trigger", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers/triggers"},
+			},
+		},
+		"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/SQLDATABASES/CONTAINERS/USERDEFINEDFUNCTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_sql_function", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers/userDefinedFunctions"},
+			},
+		},
+		"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/SQLROLEASSIGNMENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_sql_role_assignment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlRoleAssignments"},
+			},
+		},
+		"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/SQLROLEDEFINITIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_sql_role_definition", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlRoleDefinitions"},
+			},
+		},
+		"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/TABLES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cosmosdb_table", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/tables"},
+			},
+		},
+		"/MICROSOFT.DOCUMENTDB/MONGOCLUSTERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mongo_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.DocumentDB/mongoClusters"},
+			},
+		},
+		"/MICROSOFT.ELASTIC/MONITORS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_elastic_cloud_elasticsearch", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Elastic/monitors"},
+			},
+		},
+		"/MICROSOFT.ELASTICSAN/ELASTICSANS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_elastic_san", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ElasticSan/elasticSans"},
+			},
+		},
+		"/MICROSOFT.ELASTICSAN/ELASTICSANS/VOLUMEGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_elastic_san_volume_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ElasticSan/elasticSans/volumeGroups"},
+			},
+		},
+		"/MICROSOFT.ELASTICSAN/ELASTICSANS/VOLUMEGROUPS/VOLUMES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_elastic_san_volume", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ElasticSan/elasticSans/volumeGroups/volumes"},
+			},
+		},
+		"/MICROSOFT.EVENTGRID/DOMAINS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_eventgrid_domain", ImportSpec: "/subscriptions/resourceGroups/Microsoft.EventGrid/domains"},
+			},
+		},
+		"/MICROSOFT.EVENTGRID/DOMAINS/TOPICS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_eventgrid_domain_topic", ImportSpec: "/subscriptions/resourceGroups/Microsoft.EventGrid/domains/topics"},
+			},
+		},
+		"/MICROSOFT.EVENTGRID/EVENTSUBSCRIPTIONS": {
+			"ANY": {
+				{ResourceType: "azurerm_eventgrid_event_subscription"},
+			},
+		},
+		"/MICROSOFT.EVENTGRID/NAMESPACES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_eventgrid_namespace", ImportSpec: "/subscriptions/resourceGroups/Microsoft.EventGrid/namespaces"},
+			},
+		},
+		"/MICROSOFT.EVENTGRID/SYSTEMTOPICS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_eventgrid_system_topic", ImportSpec: "/subscriptions/resourceGroups/Microsoft.EventGrid/systemTopics"},
+			},
+		},
+		"/MICROSOFT.EVENTGRID/SYSTEMTOPICS/EVENTSUBSCRIPTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_eventgrid_system_topic_event_subscription", ImportSpec: "/subscriptions/resourceGroups/Microsoft.EventGrid/systemTopics/eventSubscriptions"},
+			},
+		},
+		"/MICROSOFT.EVENTGRID/TOPICS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_eventgrid_topic", ImportSpec: "/subscriptions/resourceGroups/Microsoft.EventGrid/topics"},
+			},
+		},
+		"/MICROSOFT.EVENTHUB/CLUSTERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_eventhub_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.EventHub/clusters"},
+			},
+		},
+		"/MICROSOFT.EVENTHUB/NAMESPACES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_eventhub_namespace", ImportSpec: "/subscriptions/resourceGroups/Microsoft.EventHub/namespaces"},
+			},
+		},
+		"/MICROSOFT.EVENTHUB/NAMESPACES/AUTHORIZATIONRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_eventhub_namespace_authorization_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/authorizationRules"},
+			},
+		},
+		"/MICROSOFT.EVENTHUB/NAMESPACES/DISASTERRECOVERYCONFIGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_eventhub_namespace_disaster_recovery_config", ImportSpec: "/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/disasterRecoveryConfigs"},
+			},
+		},
+		"/MICROSOFT.EVENTHUB/NAMESPACES/EVENTHUBS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_eventhub", ImportSpec: "/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/eventhubs"},
+			},
+		},
+		"/MICROSOFT.EVENTHUB/NAMESPACES/EVENTHUBS/AUTHORIZATIONRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_eventhub_authorization_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/eventhubs/authorizationRules"},
+			},
+		},
+		"/MICROSOFT.EVENTHUB/NAMESPACES/EVENTHUBS/CONSUMERGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_eventhub_consumer_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/eventhubs/consumerGroups"},
+			},
+		},
+		"/MICROSOFT.EVENTHUB/NAMESPACES/SCHEMAGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_eventhub_namespace_schema_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/schemaGroups"},
+			},
+		},
+		"/MICROSOFT.EXTENDEDLOCATION/CUSTOMLOCATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_extended_location_custom_location", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ExtendedLocation/customLocations"},
+			},
+		},
+		"/MICROSOFT.FABRIC/CAPACITIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_fabric_capacity", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Fabric/capacities"},
+			},
+		},
+		"/MICROSOFT.FLUIDRELAY/FLUIDRELAYSERVERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_fluid_relay_server", ImportSpec: "/subscriptions/resourceGroups/Microsoft.FluidRelay/fluidRelayServers"},
+			},
+		},
+		"/MICROSOFT.GRAPHSERVICES/ACCOUNTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_graph_services_account", ImportSpec: "/subscriptions/resourceGroups/Microsoft.GraphServices/accounts"},
+			},
+		},
+		"/MICROSOFT.GUESTCONFIGURATION/GUESTCONFIGURATIONASSIGNMENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.COMPUTE/VIRTUALMACHINES": {
+				{ResourceType: "azurerm_policy_virtual_machine_configuration_assignment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/Microsoft.GuestConfiguration/guestConfigurationAssignments"},
+			},
+		},
+		"/MICROSOFT.HARDWARESECURITYMODULES/DEDICATEDHSMS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dedicated_hardware_security_module", ImportSpec: "/subscriptions/resourceGroups/Microsoft.HardwareSecurityModules/dedicatedHSMs"},
+			},
+		},
+		"/MICROSOFT.HDINSIGHT/CLUSTERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_hdinsight_hadoop_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.HDInsight/clusters"},
+				{ResourceType: "azurerm_hdinsight_hbase_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.HDInsight/clusters"},
+				{ResourceType: "azurerm_hdinsight_interactive_query_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.HDInsight/clusters"},
+				{ResourceType: "azurerm_hdinsight_kafka_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.HDInsight/clusters"},
+				{ResourceType: "azurerm_hdinsight_spark_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.HDInsight/clusters"},
+			},
+		},
+		"/MICROSOFT.HEALTHBOT/HEALTHBOTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_healthbot", ImportSpec: "/subscriptions/resourceGroups/Microsoft.HealthBot/healthBots"},
+			},
+		},
+		"/MICROSOFT.HEALTHCAREAPIS/SERVICES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_healthcare_service", ImportSpec: "/subscriptions/resourceGroups/Microsoft.HealthcareApis/services"},
+			},
+		},
+		"/MICROSOFT.HEALTHCAREAPIS/WORKSPACES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_healthcare_workspace", ImportSpec: "/subscriptions/resourceGroups/Microsoft.HealthcareApis/workspaces"},
+			},
+		},
+		"/MICROSOFT.HEALTHCAREAPIS/WORKSPACES/DICOMSERVICES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_healthcare_dicom_service", ImportSpec: "/subscriptions/resourceGroups/Microsoft.HealthcareApis/workspaces/dicomServices"},
+			},
+		},
+		"/MICROSOFT.HEALTHCAREAPIS/WORKSPACES/FHIRSERVICES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_healthcare_fhir_service", ImportSpec: "/subscriptions/resourceGroups/Microsoft.HealthcareApis/workspaces/fhirServices"},
+			},
+		},
+		"/MICROSOFT.HEALTHCAREAPIS/WORKSPACES/IOTCONNECTORS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_healthcare_medtech_service", ImportSpec: "/subscriptions/resourceGroups/Microsoft.HealthcareApis/workspaces/iotConnectors"},
+			},
+		},
+		"/MICROSOFT.HEALTHCAREAPIS/WORKSPACES/IOTCONNECTORS/FHIRDESTINATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_healthcare_medtech_service_fhir_destination", ImportSpec: "/subscriptions/resourceGroups/Microsoft.HealthcareApis/workspaces/iotConnectors/fhirDestinations"},
+			},
+		},
+		"/MICROSOFT.HYBRIDCOMPUTE/MACHINES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_arc_machine", ImportSpec: "/subscriptions/resourceGroups/Microsoft.HybridCompute/machines"},
+			},
+		},
+		"/MICROSOFT.HYBRIDCOMPUTE/MACHINES/EXTENSIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_arc_machine_extension", ImportSpec: "/subscriptions/resourceGroups/Microsoft.HybridCompute/machines/extensions"},
+			},
+		},
+		"/MICROSOFT.HYBRIDCOMPUTE/PRIVATELINKSCOPES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_arc_private_link_scope", ImportSpec: "/subscriptions/resourceGroups/Microsoft.HybridCompute/privateLinkScopes"},
+			},
+		},
+		"/MICROSOFT.INSIGHTS/ACTIONGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_monitor_action_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Insights/actionGroups"},
+			},
+		},
+		"/MICROSOFT.INSIGHTS/ACTIVITYLOGALERTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_monitor_activity_log_alert", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Insights/activityLogAlerts"},
+			},
+		},
+		"/MICROSOFT.INSIGHTS/AUTOSCALESETTINGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_monitor_autoscale_setting", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Insights/autoScaleSettings"},
+			},
+		},
+		"/MICROSOFT.INSIGHTS/COMPONENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_application_insights", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Insights/components"},
+			},
+		},
+		"/MICROSOFT.INSIGHTS/COMPONENTS/ANALYTICSITEMS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_application_insights_analytics_item", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Insights/components/analyticsItems"},
+			},
+		},
+		"/MICROSOFT.INSIGHTS/COMPONENTS/APIKEYS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_application_insights_api_key", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Insights/components/apiKeys"},
+			},
+		},
+		"/MICROSOFT.INSIGHTS/COMPONENTS/PROACTIVEDETECTIONCONFIGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_application_insights_smart_detection_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Insights/components/proactiveDetectionConfigs"},
+			},
+		},
+		"/MICROSOFT.INSIGHTS/DATACOLLECTIONENDPOINTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_monitor_data_collection_endpoint", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Insights/dataCollectionEndpoints"},
+			},
+		},
+		"/MICROSOFT.INSIGHTS/DATACOLLECTIONRULEASSOCIATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.COMPUTE/VIRTUALMACHINES": {
+				{ResourceType: "azurerm_monitor_data_collection_rule_association", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/Microsoft.Insights/dataCollectionRuleAssociations"},
+			},
+		},
+		"/MICROSOFT.INSIGHTS/DATACOLLECTIONRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_monitor_data_collection_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Insights/dataCollectionRules"},
+			},
+		},
+		"/MICROSOFT.INSIGHTS/DIAGNOSTICSETTINGS": {
+			"ANY": {
+				{ResourceType: "azurerm_monitor_diagnostic_setting"},
+			},
+		},
+		"/MICROSOFT.INSIGHTS/METRICALERTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_monitor_metric_alert", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Insights/metricAlerts"},
+			},
+		},
+		"/MICROSOFT.INSIGHTS/PRIVATELINKSCOPES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_monitor_private_link_scope", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Insights/privateLinkScopes"},
+			},
+		},
+		"/MICROSOFT.INSIGHTS/PRIVATELINKSCOPES/SCOPEDRESOURCES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_monitor_private_link_scoped_service", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Insights/privateLinkScopes/scopedResources"},
+			},
+		},
+		"/MICROSOFT.INSIGHTS/SCHEDULEDQUERYRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_monitor_scheduled_query_rules_alert_v2", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Insights/scheduledQueryRules"},
+				{ResourceType: "azurerm_monitor_scheduled_query_rules_log", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Insights/scheduledQueryRules"},
+			},
+		},
+		"/MICROSOFT.INSIGHTS/WEBTESTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_application_insights_standard_web_test", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Insights/webTests"},
+				{ResourceType: "azurerm_application_insights_web_test", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Insights/webTests"},
+			},
+		},
+		"/MICROSOFT.INSIGHTS/WORKBOOKS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_application_insights_workbook", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Insights/workbooks"},
+			},
+		},
+		"/MICROSOFT.INSIGHTS/WORKBOOKTEMPLATES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_application_insights_workbook_template", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Insights/workbookTemplates"},
+			},
+		},
+		"/MICROSOFT.IOTCENTRAL/IOTAPPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_iotcentral_application", ImportSpec: "/subscriptions/resourceGroups/Microsoft.IoTCentral/iotApps"},
+			},
+		},
+		"/MICROSOFT.IOTCENTRAL/IOTAPPS/NETWORKRULESETS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_iotcentral_application_network_rule_set", ImportSpec: "/subscriptions/resourceGroups/Microsoft.IoTCentral/iotApps"},
+			},
+		},
+		"/MICROSOFT.IOTCENTRAL/IOTAPPS/ORGANIZATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_iotcentral_organization", ImportSpec: "/subscriptions/resourceGroups/Microsoft.IoTCentral/iotApps/organizations"},
+			},
+		},
+		"/MICROSOFT.KEYVAULT/MANAGEDHSMS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_key_vault_managed_hardware_security_module", ImportSpec: "/subscriptions/resourceGroups/Microsoft.KeyVault/managedHSMs"},
+			},
+		},
+		"/MICROSOFT.KEYVAULT/VAULTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_key_vault", ImportSpec: "/subscriptions/resourceGroups/Microsoft.KeyVault/vaults"},
+			},
+		},
+		"/MICROSOFT.KEYVAULT/VAULTS/CERTIFICATES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_key_vault_certificate"},
+			},
+		},
+		"/MICROSOFT.KEYVAULT/VAULTS/CERTIFICATES/CONTACTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_key_vault_certificate_contacts"},
+			},
+		},
+		"/MICROSOFT.KEYVAULT/VAULTS/CERTIFICATES/ISSUERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_key_vault_certificate_issuer"},
+			},
+		},
+		"/MICROSOFT.KEYVAULT/VAULTS/KEYS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_key_vault_key"},
+			},
+		},
+		"/MICROSOFT.KEYVAULT/VAULTS/OBJECTID": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_key_vault_access_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.KeyVault/vaults/objectId"},
+			},
+		},
+		"/MICROSOFT.KEYVAULT/VAULTS/SECRETS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_key_vault_secret"},
+			},
+		},
+		"/MICROSOFT.KEYVAULT/VAULTS/STORAGE": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_key_vault_managed_storage_account"},
+			},
+		},
+		"/MICROSOFT.KEYVAULT/VAULTS/STORAGE/SAS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_key_vault_managed_storage_account_sas_token_definition"},
+			},
+		},
+		"/MICROSOFT.KUBERNETES/CONNECTEDCLUSTERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_arc_kubernetes_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Kubernetes/connectedClusters"},
+				{ResourceType: "azurerm_arc_kubernetes_provisioned_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Kubernetes/connectedClusters"},
+			},
+		},
+		"/MICROSOFT.KUBERNETESCONFIGURATION/EXTENSIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.CONTAINERSERVICE/MANAGEDCLUSTERS": {
+				{ResourceType: "azurerm_kubernetes_cluster_extension", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters/Microsoft.KubernetesConfiguration/extensions"},
+			},
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.KUBERNETES/CONNECTEDCLUSTERS": {
+				{ResourceType: "azurerm_arc_kubernetes_cluster_extension", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Kubernetes/connectedClusters/Microsoft.KubernetesConfiguration/extensions"},
+			},
+		},
+		"/MICROSOFT.KUBERNETESCONFIGURATION/FLUXCONFIGURATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.CONTAINERSERVICE/MANAGEDCLUSTERS": {
+				{ResourceType: "azurerm_kubernetes_flux_configuration", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters/Microsoft.KubernetesConfiguration/fluxConfigurations"},
+			},
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.KUBERNETES/CONNECTEDCLUSTERS": {
+				{ResourceType: "azurerm_arc_kubernetes_flux_configuration", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Kubernetes/connectedClusters/Microsoft.KubernetesConfiguration/fluxConfigurations"},
+			},
+		},
+		"/MICROSOFT.KUSTO/CLUSTERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_kusto_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Kusto/clusters"},
+			},
+		},
+		"/MICROSOFT.KUSTO/CLUSTERS/ATTACHEDDATABASECONFIGURATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_kusto_attached_database_configuration", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Kusto/clusters/attachedDatabaseConfigurations"},
+			},
+		},
+		"/MICROSOFT.KUSTO/CLUSTERS/DATABASES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_kusto_database", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases"},
+			},
+		},
+		"/MICROSOFT.KUSTO/CLUSTERS/DATABASES/DATACONNECTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_kusto_cosmosdb_data_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/dataConnections"},
+				{ResourceType: "azurerm_kusto_eventgrid_data_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/dataConnections"},
+				{ResourceType: "azurerm_kusto_eventhub_data_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/dataConnections"},
+				{ResourceType: "azurerm_kusto_iothub_data_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/dataConnections"},
+			},
+		},
+		"/MICROSOFT.KUSTO/CLUSTERS/DATABASES/PRINCIPALASSIGNMENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_kusto_database_principal_assignment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/principalAssignments"},
+			},
+		},
+		"/MICROSOFT.KUSTO/CLUSTERS/DATABASES/SCRIPTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_kusto_script", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/scripts"},
+			},
+		},
+		"/MICROSOFT.KUSTO/CLUSTERS/MANAGEDPRIVATEENDPOINTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_kusto_cluster_managed_private_endpoint", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Kusto/clusters/managedPrivateEndpoints"},
+			},
+		},
+		"/MICROSOFT.KUSTO/CLUSTERS/PRINCIPALASSIGNMENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_kusto_cluster_principal_assignment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Kusto/clusters/principalAssignments"},
+			},
+		},
+		"/MICROSOFT.LOADTESTSERVICE/LOADTESTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_load_test", ImportSpec: "/subscriptions/resourceGroups/Microsoft.LoadTestService/loadTests"},
+			},
+		},
+		"/MICROSOFT.LOGIC/INTEGRATIONACCOUNTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_logic_app_integration_account", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts"},
+			},
+		},
+		"/MICROSOFT.LOGIC/INTEGRATIONACCOUNTS/AGREEMENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_logic_app_integration_account_agreement", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/agreements"},
+			},
+		},
+		"/MICROSOFT.LOGIC/INTEGRATIONACCOUNTS/ASSEMBLIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_logic_app_integration_account_assembly", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/assemblies"},
+			},
+		},
+		"/MICROSOFT.LOGIC/INTEGRATIONACCOUNTS/BATCHCONFIGURATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_logic_app_integration_account_batch_configuration", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/batchConfigurations"},
+			},
+		},
+		"/MICROSOFT.LOGIC/INTEGRATIONACCOUNTS/CERTIFICATES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_logic_app_integration_account_certificate", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/certificates"},
+			},
+		},
+		"/MICROSOFT.LOGIC/INTEGRATIONACCOUNTS/MAPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_logic_app_integration_account_map", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/maps"},
+			},
+		},
+		"/MICROSOFT.LOGIC/INTEGRATIONACCOUNTS/PARTNERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_logic_app_integration_account_partner", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/partners"},
+			},
+		},
+		"/MICROSOFT.LOGIC/INTEGRATIONACCOUNTS/SCHEMAS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_logic_app_integration_account_schema", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/schemas"},
+			},
+		},
+		"/MICROSOFT.LOGIC/INTEGRATIONACCOUNTS/SESSIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_logic_app_integration_account_session", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/sessions"},
+			},
+		},
+		"/MICROSOFT.LOGIC/WORKFLOWS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_logic_app_workflow", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Logic/workflows"},
+			},
+		},
+		"/MICROSOFT.LOGIC/WORKFLOWS/ACTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_logic_app_action_custom", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Logic/workflows/actions"},
+				{ResourceType: "azurerm_logic_app_action_http", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Logic/workflows/actions"},
+			},
+		},
+		"/MICROSOFT.LOGIC/WORKFLOWS/TRIGGERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_logic_app_trigger_custom", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Logic/workflows/triggers"},
+				{ResourceType: "azurerm_logic_app_trigger_http_request", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Logic/workflows/triggers"},
+				{ResourceType: "azurerm_logic_app_trigger_recurrence", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Logic/workflows/triggers"},
+			},
+		},
+		"/MICROSOFT.MACHINELEARNINGSERVICES/WORKSPACES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_ai_foundry", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces"},
+				{ResourceType: "azurerm_ai_foundry_project", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces"},
+				{ResourceType: "azurerm_machine_learning_workspace", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces"},
+			},
+		},
+		"/MICROSOFT.MACHINELEARNINGSERVICES/WORKSPACES/COMPUTES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_machine_learning_compute_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/computes"},
+				{ResourceType: "azurerm_machine_learning_compute_instance", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/computes"},
+				{ResourceType: "azurerm_machine_learning_inference_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/computes"},
+				{ResourceType: "azurerm_machine_learning_synapse_spark", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/computes"},
+			},
+		},
+		"/MICROSOFT.MACHINELEARNINGSERVICES/WORKSPACES/DATASTORES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_machine_learning_datastore_blobstorage", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/dataStores"},
+				{ResourceType: "azurerm_machine_learning_datastore_datalake_gen2", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/dataStores"},
+				{ResourceType: "azurerm_machine_learning_datastore_fileshare", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/dataStores"},
+			},
+		},
+		"/MICROSOFT.MACHINELEARNINGSERVICES/WORKSPACES/OUTBOUNDRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_machine_learning_workspace_network_outbound_rule_fqdn", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/outboundRules"},
+				{ResourceType: "azurerm_machine_learning_workspace_network_outbound_rule_private_endpoint", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/outboundRules"},
+				{ResourceType: "azurerm_machine_learning_workspace_network_outbound_rule_service_tag", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/outboundRules"},
+			},
+		},
+		"/MICROSOFT.MAINTENANCE/CONFIGURATIONASSIGNMENTS": {
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_maintenance_assignment_dynamic_scope", ImportSpec: "/subscriptions/Microsoft.Maintenance/configurationAssignments"},
+			},
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.COMPUTE/HOSTGROUPS/HOSTS": {
+				{ResourceType: "azurerm_maintenance_assignment_dedicated_host", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/hostGroups/hosts/Microsoft.Maintenance/configurationAssignments"},
+			},
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.COMPUTE/VIRTUALMACHINES": {
+				{ResourceType: "azurerm_maintenance_assignment_virtual_machine", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/Microsoft.Maintenance/configurationAssignments"},
+			},
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.COMPUTE/VIRTUALMACHINESCALESETS": {
+				{ResourceType: "azurerm_maintenance_assignment_virtual_machine_scale_set", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets/Microsoft.Maintenance/configurationAssignments"},
+			},
+		},
+		"/MICROSOFT.MAINTENANCE/MAINTENANCECONFIGURATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_maintenance_configuration", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Maintenance/maintenanceConfigurations"},
+			},
+		},
+		"/MICROSOFT.MANAGEDIDENTITY/USERASSIGNEDIDENTITIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_user_assigned_identity", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ManagedIdentity/userAssignedIdentities"},
+			},
+		},
+		"/MICROSOFT.MANAGEDIDENTITY/USERASSIGNEDIDENTITIES/FEDERATEDIDENTITYCREDENTIALS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_federated_identity_credential", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ManagedIdentity/userAssignedIdentities/federatedIdentityCredentials"},
+			},
+		},
+		"/MICROSOFT.MANAGEDSERVICES/REGISTRATIONASSIGNMENTS": {
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_lighthouse_assignment", ImportSpec: "/subscriptions/Microsoft.ManagedServices/registrationAssignments"},
+			},
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_lighthouse_assignment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ManagedServices/registrationAssignments"},
+			},
+		},
+		"/MICROSOFT.MANAGEDSERVICES/REGISTRATIONDEFINITIONS": {
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_lighthouse_definition", ImportSpec: "/subscriptions/Microsoft.ManagedServices/registrationDefinitions"},
+			},
+		},
+		"/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS": {
+			"": {
+				{ResourceType: "azurerm_management_group"},
+			},
+		},
+		"/MICROSOFT.MAPS/ACCOUNTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_maps_account", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Maps/accounts"},
+			},
+		},
+		"/MICROSOFT.MAPS/ACCOUNTS/CREATORS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_maps_creator", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Maps/accounts/creators"},
+			},
+		},
+		"/MICROSOFT.MARKETPLACEORDERING/AGREEMENTS/OFFERS/PLANS": {
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_marketplace_agreement", ImportSpec: "/subscriptions/Microsoft.MarketplaceOrdering/agreements/offers/plans"},
+			},
+		},
+		"/MICROSOFT.MIXEDREALITY/SPATIALANCHORSACCOUNTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_spatial_anchors_account", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MixedReality/spatialAnchorsAccounts"},
+			},
+		},
+		"/MICROSOFT.MOBILENETWORK/MOBILENETWORKS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mobile_network", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MobileNetwork/mobileNetworks"},
+			},
+		},
+		"/MICROSOFT.MOBILENETWORK/MOBILENETWORKS/DATANETWORKS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mobile_network_data_network", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MobileNetwork/mobileNetworks/dataNetworks"},
+			},
+		},
+		"/MICROSOFT.MOBILENETWORK/MOBILENETWORKS/SERVICES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mobile_network_service", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MobileNetwork/mobileNetworks/services"},
+			},
+		},
+		"/MICROSOFT.MOBILENETWORK/MOBILENETWORKS/SIMPOLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mobile_network_sim_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MobileNetwork/mobileNetworks/simPolicies"},
+			},
+		},
+		"/MICROSOFT.MOBILENETWORK/MOBILENETWORKS/SITES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mobile_network_site", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MobileNetwork/mobileNetworks/sites"},
+			},
+		},
+		"/MICROSOFT.MOBILENETWORK/MOBILENETWORKS/SLICES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mobile_network_slice", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MobileNetwork/mobileNetworks/slices"},
+			},
+		},
+		"/MICROSOFT.MOBILENETWORK/PACKETCORECONTROLPLANES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mobile_network_packet_core_control_plane", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MobileNetwork/packetCoreControlPlanes"},
+			},
+		},
+		"/MICROSOFT.MOBILENETWORK/PACKETCORECONTROLPLANES/PACKETCOREDATAPLANES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mobile_network_packet_core_data_plane", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MobileNetwork/packetCoreControlPlanes/packetCoreDataPlanes"},
+			},
+		},
+		"/MICROSOFT.MOBILENETWORK/PACKETCORECONTROLPLANES/PACKETCOREDATAPLANES/ATTACHEDDATANETWORKS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mobile_network_attached_data_network", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MobileNetwork/packetCoreControlPlanes/packetCoreDataPlanes/attachedDataNetworks"},
+			},
+		},
+		"/MICROSOFT.MOBILENETWORK/SIMGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mobile_network_sim_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MobileNetwork/simGroups"},
+			},
+		},
+		"/MICROSOFT.MOBILENETWORK/SIMGROUPS/SIMS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mobile_network_sim", ImportSpec: "/subscriptions/resourceGroups/Microsoft.MobileNetwork/simGroups/sims"},
+			},
+		},
+		"/MICROSOFT.MONITOR/ACCOUNTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_monitor_workspace", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Monitor/accounts"},
+			},
+		},
+		"/MICROSOFT.NETAPP/NETAPPACCOUNTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_netapp_account", ImportSpec: "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts"},
+			},
+		},
+		"/MICROSOFT.NETAPP/NETAPPACCOUNTS/BACKUPPOLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_netapp_backup_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/backupPolicies"},
+			},
+		},
+		"/MICROSOFT.NETAPP/NETAPPACCOUNTS/BACKUPVAULTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_netapp_backup_vault", ImportSpec: "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/backupVaults"},
+			},
+		},
+		"/MICROSOFT.NETAPP/NETAPPACCOUNTS/CAPACITYPOOLS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_netapp_pool", ImportSpec: "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/capacityPools"},
+			},
+		},
+		"/MICROSOFT.NETAPP/NETAPPACCOUNTS/CAPACITYPOOLS/VOLUMES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_netapp_volume", ImportSpec: "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/capacityPools/volumes"},
+			},
+		},
+		"/MICROSOFT.NETAPP/NETAPPACCOUNTS/CAPACITYPOOLS/VOLUMES/SNAPSHOTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_netapp_snapshot", ImportSpec: "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/capacityPools/volumes/snapshots"},
+			},
+		},
+		"/MICROSOFT.NETAPP/NETAPPACCOUNTS/CAPACITYPOOLS/VOLUMES/VOLUMEQUOTARULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_netapp_volume_quota_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/capacityPools/volumes/volumeQuotaRules"},
+			},
+		},
+		"/MICROSOFT.NETAPP/NETAPPACCOUNTS/ENCRYPTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_netapp_account_encryption", ImportSpec: "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts"},
+			},
+		},
+		"/MICROSOFT.NETAPP/NETAPPACCOUNTS/SNAPSHOTPOLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_netapp_snapshot_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/snapshotPolicies"},
+			},
+		},
+		"/MICROSOFT.NETAPP/NETAPPACCOUNTS/VOLUMEGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_netapp_volume_group_oracle", ImportSpec: "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/volumeGroups"},
+				{ResourceType: "azurerm_netapp_volume_group_sap_hana", ImportSpec: "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/volumeGroups"},
+			},
+		},
+		"/MICROSOFT.NETWORK/APPLICATIONGATEWAYS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_application_gateway", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/applicationGateways"},
+			},
+		},
+		"/MICROSOFT.NETWORK/APPLICATIONGATEWAYS/BACKENDADDRESSPOOLS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "fake_azurerm_application_gateway_backend_address_pool", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/applicationGateways/backendAddressPools"},
+			},
+		},
+		"/MICROSOFT.NETWORK/APPLICATIONGATEWAYWEBAPPLICATIONFIREWALLPOLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_web_application_firewall_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/applicationGatewayWebApplicationFirewallPolicies"},
+			},
+		},
+		"/MICROSOFT.NETWORK/APPLICATIONSECURITYGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_application_security_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/applicationSecurityGroups"},
+			},
+		},
+		"/MICROSOFT.NETWORK/AZUREFIREWALLS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_firewall", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/azureFirewalls"},
+			},
+		},
+		"/MICROSOFT.NETWORK/AZUREFIREWALLS/APPLICATIONRULECOLLECTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_firewall_application_rule_collection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/azureFirewalls/applicationRuleCollections"},
+			},
+		},
+		"/MICROSOFT.NETWORK/AZUREFIREWALLS/NATRULECOLLECTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_firewall_nat_rule_collection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/azureFirewalls/natRuleCollections"},
+			},
+		},
+		"/MICROSOFT.NETWORK/AZUREFIREWALLS/NETWORKRULECOLLECTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_firewall_network_rule_collection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/azureFirewalls/networkRuleCollections"},
+			},
+		},
+		"/MICROSOFT.NETWORK/BASTIONHOSTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_bastion_host", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/bastionHosts"},
+			},
+		},
+		"/MICROSOFT.NETWORK/CONNECTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_network_gateway_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/connections"},
+			},
+		},
+		"/MICROSOFT.NETWORK/CUSTOMIPPREFIXES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_custom_ip_prefix", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/customIPPrefixes"},
+			},
+		},
+		"/MICROSOFT.NETWORK/DDOSPROTECTIONPLANS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_ddos_protection_plan", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/ddosProtectionPlans"},
+			},
+		},
+		"/MICROSOFT.NETWORK/DNSFORWARDINGRULESETS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_private_dns_resolver_dns_forwarding_ruleset", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/dnsForwardingRulesets"},
+			},
+		},
+		"/MICROSOFT.NETWORK/DNSFORWARDINGRULESETS/FORWARDINGRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_private_dns_resolver_forwarding_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/dnsForwardingRulesets/forwardingRules"},
+			},
+		},
+		"/MICROSOFT.NETWORK/DNSFORWARDINGRULESETS/VIRTUALNETWORKLINKS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_private_dns_resolver_virtual_network_link", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/dnsForwardingRulesets/virtualNetworkLinks"},
+			},
+		},
+		"/MICROSOFT.NETWORK/DNSRESOLVERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_private_dns_resolver", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/dnsResolvers"},
+			},
+		},
+		"/MICROSOFT.NETWORK/DNSRESOLVERS/INBOUNDENDPOINTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_private_dns_resolver_inbound_endpoint", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/dnsResolvers/inboundEndpoints"},
+			},
+		},
+		"/MICROSOFT.NETWORK/DNSRESOLVERS/OUTBOUNDENDPOINTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_private_dns_resolver_outbound_endpoint", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/dnsResolvers/outboundEndpoints"},
+			},
+		},
+		"/MICROSOFT.NETWORK/DNSZONES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dns_zone", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/dnsZones"},
+			},
+		},
+		"/MICROSOFT.NETWORK/DNSZONES/A": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dns_a_record", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/dnsZones/A"},
+			},
+		},
+		"/MICROSOFT.NETWORK/DNSZONES/AAAA": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dns_aaaa_record", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/dnsZones/AAAA"},
+			},
+		},
+		"/MICROSOFT.NETWORK/DNSZONES/CAA": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dns_caa_record", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/dnsZones/CAA"},
+			},
+		},
+		"/MICROSOFT.NETWORK/DNSZONES/CNAME": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dns_cname_record", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/dnsZones/CNAME"},
+			},
+		},
+		"/MICROSOFT.NETWORK/DNSZONES/MX": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dns_mx_record", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/dnsZones/MX"},
+			},
+		},
+		"/MICROSOFT.NETWORK/DNSZONES/NS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dns_ns_record", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/dnsZones/NS"},
+			},
+		},
+		"/MICROSOFT.NETWORK/DNSZONES/PTR": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dns_ptr_record", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/dnsZones/PTR"},
+			},
+		},
+		"/MICROSOFT.NETWORK/DNSZONES/SRV": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dns_srv_record", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/dnsZones/SRV"},
+			},
+		},
+		"/MICROSOFT.NETWORK/DNSZONES/TXT": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_dns_txt_record", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/dnsZones/TXT"},
+			},
+		},
+		"/MICROSOFT.NETWORK/EXPRESSROUTECIRCUITS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_express_route_circuit", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/expressRouteCircuits"},
+			},
+		},
+		"/MICROSOFT.NETWORK/EXPRESSROUTECIRCUITS/AUTHORIZATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_express_route_circuit_authorization", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/expressRouteCircuits/authorizations"},
+			},
+		},
+		"/MICROSOFT.NETWORK/EXPRESSROUTECIRCUITS/PEERINGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_express_route_circuit_peering", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/expressRouteCircuits/peerings"},
+			},
+		},
+		"/MICROSOFT.NETWORK/EXPRESSROUTECIRCUITS/PEERINGS/CONNECTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_express_route_circuit_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/expressRouteCircuits/peerings/connections"},
+			},
+		},
+		"/MICROSOFT.NETWORK/EXPRESSROUTEGATEWAYS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_express_route_gateway", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/expressRouteGateways"},
+			},
+		},
+		"/MICROSOFT.NETWORK/EXPRESSROUTEGATEWAYS/EXPRESSROUTECONNECTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_express_route_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/expressRouteGateways/expressRouteConnections"},
+			},
+		},
+		"/MICROSOFT.NETWORK/EXPRESSROUTEPORTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_express_route_port", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/expressRoutePorts"},
+			},
+		},
+		"/MICROSOFT.NETWORK/EXPRESSROUTEPORTS/AUTHORIZATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_express_route_port_authorization", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/expressRoutePorts/authorizations"},
+			},
+		},
+		"/MICROSOFT.NETWORK/FIREWALLPOLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_firewall_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/firewallPolicies"},
+			},
+		},
+		"/MICROSOFT.NETWORK/FIREWALLPOLICIES/RULECOLLECTIONGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_firewall_policy_rule_collection_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/firewallPolicies/ruleCollectionGroups"},
+			},
+		},
+		"/MICROSOFT.NETWORK/FRONTDOORS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_frontdoor", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/frontDoors"},
+			},
+		},
+		"/MICROSOFT.NETWORK/FRONTDOORS/CUSTOMHTTPSCONFIGURATION": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_frontdoor_custom_https_configuration", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/frontDoors/customHttpsConfiguration"},
+			},
+		},
+		"/MICROSOFT.NETWORK/FRONTDOORS/RULESENGINES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_frontdoor_rules_engine", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/frontdoors/rulesEngines"},
+			},
+		},
+		"/MICROSOFT.NETWORK/FRONTDOORWEBAPPLICATIONFIREWALLPOLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_cdn_frontdoor_firewall_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/frontDoorWebApplicationFirewallPolicies"},
+				{ResourceType: "azurerm_frontdoor_firewall_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/frontDoorWebApplicationFirewallPolicies"},
+			},
+		},
+		"/MICROSOFT.NETWORK/IPGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_ip_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/ipGroups"},
+			},
+		},
+		"/MICROSOFT.NETWORK/IPGROUPS/CIDRS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_ip_group_cidr", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/ipGroups/cidrs"},
+			},
+		},
+		"/MICROSOFT.NETWORK/LOADBALANCERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_lb", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/loadBalancers"},
+			},
+		},
+		"/MICROSOFT.NETWORK/LOADBALANCERS/BACKENDADDRESSPOOLS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_lb_backend_address_pool", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/backendAddressPools"},
+			},
+		},
+		"/MICROSOFT.NETWORK/LOADBALANCERS/BACKENDADDRESSPOOLS/ADDRESSES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_lb_backend_address_pool_address", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/backendAddressPools/addresses"},
+			},
+		},
+		"/MICROSOFT.NETWORK/LOADBALANCERS/INBOUNDNATPOOLS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_lb_nat_pool", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/inboundNatPools"},
+			},
+		},
+		"/MICROSOFT.NETWORK/LOADBALANCERS/INBOUNDNATRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_lb_nat_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/inboundNatRules"},
+			},
+		},
+		"/MICROSOFT.NETWORK/LOADBALANCERS/LOADBALANCINGRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_lb_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/loadBalancingRules"},
+			},
+		},
+		"/MICROSOFT.NETWORK/LOADBALANCERS/OUTBOUNDRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_lb_outbound_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/outboundRules"},
+			},
+		},
+		"/MICROSOFT.NETWORK/LOADBALANCERS/PROBES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_lb_probe", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/probes"},
+			},
+		},
+		"/MICROSOFT.NETWORK/LOCALNETWORKGATEWAYS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_local_network_gateway", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/localNetworkGateways"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NATGATEWAYS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_nat_gateway", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/natGateways"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NATGATEWAYS/PUBLICIPADDRESSES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_nat_gateway_public_ip_association"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NATGATEWAYS/PUBLICIPPREFIXES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_nat_gateway_public_ip_prefix_association"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKINTERFACES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_interface", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/networkInterfaces"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKINTERFACES/IPCONFIGURATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "fake_azurerm_network_interface_ipconfig", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/networkInterfaces/ipConfigurations"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKINTERFACES/IPCONFIGURATIONS/APPLICATIONGATEWAYBACKENDADDRESSPOOLS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_interface_application_gateway_backend_address_pool_association"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKINTERFACES/IPCONFIGURATIONS/APPLICATIONSECURITYGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_interface_application_security_group_association"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKINTERFACES/IPCONFIGURATIONS/LOADBALANCERBACKENDADDRESSPOOLS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_interface_backend_address_pool_association"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKINTERFACES/IPCONFIGURATIONS/LOADBALANCERINBOUNDNATRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_interface_nat_rule_association"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKINTERFACES/NETWORKSECURITYGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_interface_security_group_association"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKMANAGERCONNECTIONS": {
+			"/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS": {
+				{ResourceType: "azurerm_network_manager_management_group_connection", ImportSpec: "/Microsoft.Management/managementGroups/Microsoft.Network/networkManagerConnections"},
+			},
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_network_manager_subscription_connection", ImportSpec: "/subscriptions/Microsoft.Network/networkManagerConnections"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKMANAGERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_manager", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/networkManagers"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKMANAGERS/CONNECTIVITYCONFIGURATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_manager_connectivity_configuration", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/networkManagers/connectivityConfigurations"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKMANAGERS/IPAMPOOLS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_manager_ipam_pool", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/networkManagers/ipamPools"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKMANAGERS/LOCATIONS/TYPES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_manager_deployment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/networkManagers"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKMANAGERS/NETWORKGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_manager_network_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/networkManagers/networkGroups"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKMANAGERS/NETWORKGROUPS/STATICMEMBERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_manager_static_member", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/networkManagers/networkGroups/staticMembers"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKMANAGERS/SCOPECONNECTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_manager_scope_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/networkManagers/scopeConnections"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKMANAGERS/SECURITYADMINCONFIGURATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_manager_security_admin_configuration", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/networkManagers/securityAdminConfigurations"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKMANAGERS/SECURITYADMINCONFIGURATIONS/RULECOLLECTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_manager_admin_rule_collection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/networkManagers/securityAdminConfigurations/ruleCollections"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKMANAGERS/SECURITYADMINCONFIGURATIONS/RULECOLLECTIONS/RULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_manager_admin_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/networkManagers/securityAdminConfigurations/ruleCollections/rules"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKMANAGERS/VERIFIERWORKSPACES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_manager_verifier_workspace", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/networkManagers/verifierWorkspaces"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKPROFILES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_profile", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/networkProfiles"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKSECURITYGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_security_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/networkSecurityGroups"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKSECURITYGROUPS/SECURITYRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_security_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/networkSecurityGroups/securityRules"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKVIRTUALAPPLIANCES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_palo_alto_virtual_network_appliance", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/networkVirtualAppliances"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKWATCHERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_watcher", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/networkWatchers"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKWATCHERS/CONNECTIONMONITORS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_connection_monitor", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/networkWatchers/connectionMonitors"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKWATCHERS/FLOWLOGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_watcher_flow_log", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/networkWatchers/flowLogs"},
+			},
+		},
+		"/MICROSOFT.NETWORK/NETWORKWATCHERS/PACKETCAPTURES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_machine_packet_capture", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/networkWatchers/packetCaptures"},
+				{ResourceType: "azurerm_virtual_machine_scale_set_packet_capture", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/networkWatchers/packetCaptures"},
+			},
+		},
+		"/MICROSOFT.NETWORK/P2SVPNGATEWAYS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_point_to_site_vpn_gateway", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/p2sVpnGateways"},
+			},
+		},
+		"/MICROSOFT.NETWORK/PRIVATEDNSZONES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_private_dns_zone", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones"},
+			},
+		},
+		"/MICROSOFT.NETWORK/PRIVATEDNSZONES/A": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_private_dns_a_record", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/A"},
+			},
+		},
+		"/MICROSOFT.NETWORK/PRIVATEDNSZONES/AAAA": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_private_dns_aaaa_record", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/AAAA"},
+			},
+		},
+		"/MICROSOFT.NETWORK/PRIVATEDNSZONES/CNAME": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_private_dns_cname_record", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/CNAME"},
+			},
+		},
+		"/MICROSOFT.NETWORK/PRIVATEDNSZONES/MX": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_private_dns_mx_record", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/MX"},
+			},
+		},
+		"/MICROSOFT.NETWORK/PRIVATEDNSZONES/PTR": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_private_dns_ptr_record", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/PTR"},
+			},
+		},
+		"/MICROSOFT.NETWORK/PRIVATEDNSZONES/SRV": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_private_dns_srv_record", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/SRV"},
+			},
+		},
+		"/MICROSOFT.NETWORK/PRIVATEDNSZONES/TXT": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_private_dns_txt_record", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/TXT"},
+			},
+		},
+		"/MICROSOFT.NETWORK/PRIVATEDNSZONES/VIRTUALNETWORKLINKS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_private_dns_zone_virtual_network_link", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/virtualNetworkLinks"},
+			},
+		},
+		"/MICROSOFT.NETWORK/PRIVATEENDPOINTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_private_endpoint", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/privateEndpoints"},
+			},
+		},
+		"/MICROSOFT.NETWORK/PRIVATEENDPOINTS/APPLICATIONSECURITYGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_private_endpoint_application_security_group_association"},
+			},
+		},
+		"/MICROSOFT.NETWORK/PRIVATELINKSERVICES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_private_link_service", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/privateLinkServices"},
+			},
+		},
+		"/MICROSOFT.NETWORK/PUBLICIPADDRESSES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_public_ip", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/publicIPAddresses"},
+			},
+		},
+		"/MICROSOFT.NETWORK/PUBLICIPPREFIXES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_public_ip_prefix", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/publicIPPrefixes"},
+			},
+		},
+		"/MICROSOFT.NETWORK/ROUTEFILTERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_route_filter", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/routeFilters"},
+			},
+		},
+		"/MICROSOFT.NETWORK/ROUTETABLES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_route_table", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/routeTables"},
+			},
+		},
+		"/MICROSOFT.NETWORK/ROUTETABLES/ROUTES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_route", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/routeTables/routes"},
+			},
+		},
+		"/MICROSOFT.NETWORK/SECURITYPARTNERPROVIDERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_hub_security_partner_provider", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/securityPartnerProviders"},
+			},
+		},
+		"/MICROSOFT.NETWORK/SERVICEENDPOINTPOLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_subnet_service_endpoint_storage_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/serviceEndpointPolicies"},
+			},
+		},
+		"/MICROSOFT.NETWORK/TRAFFICMANAGERPROFILES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_traffic_manager_profile", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/trafficManagerProfiles"},
+			},
+		},
+		"/MICROSOFT.NETWORK/TRAFFICMANAGERPROFILES/AZUREENDPOINTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_traffic_manager_azure_endpoint", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/trafficManagerProfiles/AzureEndpoints"},
+			},
+		},
+		"/MICROSOFT.NETWORK/TRAFFICMANAGERPROFILES/EXTERNALENDPOINTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_traffic_manager_external_endpoint", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/trafficManagerProfiles/ExternalEndpoints"},
+			},
+		},
+		"/MICROSOFT.NETWORK/TRAFFICMANAGERPROFILES/NESTEDENDPOINTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_traffic_manager_nested_endpoint", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/trafficManagerProfiles/NestedEndpoints"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VIRTUALHUBS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_route_server", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/virtualHubs"},
+				{ResourceType: "azurerm_virtual_hub", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/virtualHubs"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VIRTUALHUBS/BGPCONNECTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_route_server_bgp_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/bgpConnections"},
+				{ResourceType: "azurerm_virtual_hub_bgp_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/bgpConnections"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VIRTUALHUBS/HUBROUTETABLES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_hub_route_table", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/hubRouteTables"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VIRTUALHUBS/HUBROUTETABLES/ROUTES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_hub_route_table_route", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/hubRouteTables/routes"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VIRTUALHUBS/HUBVIRTUALNETWORKCONNECTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_hub_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/hubVirtualNetworkConnections"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VIRTUALHUBS/IPCONFIGURATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_hub_ip", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/ipConfigurations"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VIRTUALHUBS/ROUTEMAPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_route_map", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/routeMaps"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VIRTUALHUBS/ROUTINGINTENT": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_hub_routing_intent", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/routingIntent"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VIRTUALNETWORKGATEWAYS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_network_gateway", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/virtualNetworkGateways"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VIRTUALNETWORKGATEWAYS/NATRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_network_gateway_nat_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/virtualNetworkGateways/natRules"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VIRTUALNETWORKS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_network", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/virtualNetworks"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VIRTUALNETWORKS/DNSSERVERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_network_dns_servers", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/virtualNetworks/dnsServers"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VIRTUALNETWORKS/SUBNETS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_subnet", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/virtualNetworks/subnets"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VIRTUALNETWORKS/SUBNETS/NATGATEWAYS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_subnet_nat_gateway_association"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VIRTUALNETWORKS/SUBNETS/NETWORKSECURITYGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_subnet_network_security_group_association"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VIRTUALNETWORKS/SUBNETS/ROUTETABLES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_subnet_route_table_association"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VIRTUALNETWORKS/VIRTUALNETWORKPEERINGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_network_peering", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/virtualNetworks/virtualNetworkPeerings"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VIRTUALWANS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_wan", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/virtualWans"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VPNGATEWAYS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_vpn_gateway", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/vpnGateways"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VPNGATEWAYS/NATRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_vpn_gateway_nat_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/vpnGateways/natRules"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VPNGATEWAYS/VPNCONNECTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_vpn_gateway_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/vpnGateways/vpnConnections"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VPNSERVERCONFIGURATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_vpn_server_configuration", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/vpnServerConfigurations"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VPNSERVERCONFIGURATIONS/CONFIGURATIONPOLICYGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_vpn_server_configuration_policy_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/vpnServerConfigurations/configurationPolicyGroups"},
+			},
+		},
+		"/MICROSOFT.NETWORK/VPNSITES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_vpn_site", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Network/vpnSites"},
+			},
+		},
+		"/MICROSOFT.NETWORKFUNCTION/AZURETRAFFICCOLLECTORS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_function_azure_traffic_collector", ImportSpec: "/subscriptions/resourceGroups/Microsoft.NetworkFunction/azureTrafficCollectors"},
+			},
+		},
+		"/MICROSOFT.NETWORKFUNCTION/AZURETRAFFICCOLLECTORS/COLLECTORPOLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_network_function_collector_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.NetworkFunction/azureTrafficCollectors/collectorPolicies"},
+			},
+		},
+		"/MICROSOFT.NOTIFICATIONHUBS/NAMESPACES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_notification_hub_namespace", ImportSpec: "/subscriptions/resourceGroups/Microsoft.NotificationHubs/namespaces"},
+			},
+		},
+		"/MICROSOFT.NOTIFICATIONHUBS/NAMESPACES/NOTIFICATIONHUBS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_notification_hub", ImportSpec: "/subscriptions/resourceGroups/Microsoft.NotificationHubs/namespaces/notificationHubs"},
+			},
+		},
+		"/MICROSOFT.NOTIFICATIONHUBS/NAMESPACES/NOTIFICATIONHUBS/AUTHORIZATIONRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_notification_hub_authorization_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.NotificationHubs/namespaces/notificationHubs/authorizationRules"},
+			},
+		},
+		"/MICROSOFT.OPERATIONALINSIGHTS/CLUSTERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_log_analytics_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/clusters"},
+			},
+		},
+		"/MICROSOFT.OPERATIONALINSIGHTS/QUERYPACKS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_log_analytics_query_pack", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/queryPacks"},
+			},
+		},
+		"/MICROSOFT.OPERATIONALINSIGHTS/QUERYPACKS/QUERIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_log_analytics_query_pack_query", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/queryPacks/queries"},
+			},
+		},
+		"/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_log_analytics_workspace", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"},
+			},
+		},
+		"/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES/DATAEXPORTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_log_analytics_data_export_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/dataExports"},
+			},
+		},
+		"/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES/DATASOURCES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_log_analytics_datasource_windows_event", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/dataSources"},
+				{ResourceType: "azurerm_log_analytics_datasource_windows_performance_counter", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/dataSources"},
+			},
+		},
+		"/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES/LINKEDSERVICES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_log_analytics_linked_service", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/linkedServices"},
+			},
+		},
+		"/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES/LINKEDSTORAGEACCOUNTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_log_analytics_linked_storage_account", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/linkedStorageAccounts"},
+			},
+		},
+		"/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES/SAVEDSEARCHES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_log_analytics_saved_search", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/savedSearches"},
+			},
+		},
+		"/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES/STORAGEINSIGHTCONFIGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_log_analytics_storage_insights", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/storageInsightConfigs"},
+			},
+		},
+		"/MICROSOFT.OPERATIONSMANAGEMENT/SOLUTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_log_analytics_solution", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationsManagement/solutions"},
+			},
+		},
+		"/MICROSOFT.ORBITAL/CONTACTPROFILES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_orbital_contact_profile", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Orbital/contactProfiles"},
+			},
+		},
+		"/MICROSOFT.ORBITAL/SPACECRAFTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_orbital_spacecraft", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Orbital/spacecrafts"},
+			},
+		},
+		"/MICROSOFT.ORBITAL/SPACECRAFTS/CONTACTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_orbital_contact", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Orbital/spacecrafts/contacts"},
+			},
+		},
+		"/MICROSOFT.POLICYINSIGHTS": {
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_resource_provider_registration", ImportSpec: "/subscriptions/Microsoft.PolicyInsights"},
+			},
+		},
+		"/MICROSOFT.POLICYINSIGHTS/REMEDIATIONS": {
+			"/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS": {
+				{ResourceType: "azurerm_management_group_policy_remediation", ImportSpec: "/Microsoft.Management/managementGroups/Microsoft.PolicyInsights/remediations"},
+			},
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_subscription_policy_remediation", ImportSpec: "/subscriptions/Microsoft.PolicyInsights/remediations"},
+			},
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_resource_group_policy_remediation", ImportSpec: "/subscriptions/resourceGroups/Microsoft.PolicyInsights/remediations"},
+			},
+			"ANY": {
+				{ResourceType: "azurerm_resource_policy_remediation"},
+			},
+		},
+		"/MICROSOFT.PORTAL/DASHBOARDS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_portal_dashboard", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Portal/dashboards"},
+			},
+		},
+		"/MICROSOFT.PORTAL/TENANTCONFIGURATIONS": {
+			"/": {
+				{ResourceType: "azurerm_portal_tenant_configuration", ImportSpec: "/Microsoft.Portal/tenantConfigurations"},
+			},
+		},
+		"/MICROSOFT.POWERBIDEDICATED/CAPACITIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_powerbi_embedded", ImportSpec: "/subscriptions/resourceGroups/Microsoft.PowerBIDedicated/capacities"},
+			},
+		},
+		"/MICROSOFT.PURVIEW/ACCOUNTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_purview_account", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Purview/accounts"},
+			},
+		},
+		"/MICROSOFT.RECOVERYSERVICES/VAULTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_recovery_services_vault", ImportSpec: "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults"},
+			},
+		},
+		"/MICROSOFT.RECOVERYSERVICES/VAULTS/BACKUPFABRICS/PROTECTIONCONTAINERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_backup_container_storage_account", ImportSpec: "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupFabrics/protectionContainers"},
+			},
+		},
+		"/MICROSOFT.RECOVERYSERVICES/VAULTS/BACKUPFABRICS/PROTECTIONCONTAINERS/PROTECTEDITEMS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_backup_protected_file_share", ImportSpec: "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupFabrics/protectionContainers/protectedItems"},
+				{ResourceType: "azurerm_backup_protected_vm", ImportSpec: "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupFabrics/protectionContainers/protectedItems"},
+			},
+		},
+		"/MICROSOFT.RECOVERYSERVICES/VAULTS/BACKUPPOLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_backup_policy_file_share", ImportSpec: "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupPolicies"},
+				{ResourceType: "azurerm_backup_policy_vm", ImportSpec: "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupPolicies"},
+				{ResourceType: "azurerm_backup_policy_vm_workload", ImportSpec: "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupPolicies"},
+			},
+		},
+		"/MICROSOFT.RECOVERYSERVICES/VAULTS/BACKUPRESOURCEGUARDPROXIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_recovery_services_vault_resource_guard_association", ImportSpec: "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupResourceGuardProxies"},
+			},
+		},
+		"/MICROSOFT.RECOVERYSERVICES/VAULTS/REPLICATIONFABRICS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_site_recovery_fabric", ImportSpec: "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics"},
+				{ResourceType: "azurerm_site_recovery_services_vault_hyperv_site", ImportSpec: "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics"},
+			},
+		},
+		"/MICROSOFT.RECOVERYSERVICES/VAULTS/REPLICATIONFABRICS/REPLICATIONNETWORKS/REPLICATIONNETWORKMAPPINGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_site_recovery_hyperv_network_mapping", ImportSpec: "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationNetworks/replicationNetworkMappings"},
+				{ResourceType: "azurerm_site_recovery_network_mapping", ImportSpec: "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationNetworks/replicationNetworkMappings"},
+			},
+		},
+		"/MICROSOFT.RECOVERYSERVICES/VAULTS/REPLICATIONFABRICS/REPLICATIONPROTECTIONCONTAINERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_site_recovery_protection_container", ImportSpec: "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers"},
+			},
+		},
+		"/MICROSOFT.RECOVERYSERVICES/VAULTS/REPLICATIONFABRICS/REPLICATIONPROTECTIONCONTAINERS/REPLICATIONPROTECTEDITEMS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_site_recovery_replicated_vm", ImportSpec: "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectedItems"},
+				{ResourceType: "azurerm_site_recovery_vmware_replicated_vm", ImportSpec: "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectedItems"},
+			},
+		},
+		"/MICROSOFT.RECOVERYSERVICES/VAULTS/REPLICATIONFABRICS/REPLICATIONPROTECTIONCONTAINERS/REPLICATIONPROTECTIONCONTAINERMAPPINGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_site_recovery_hyperv_replication_policy_association", ImportSpec: "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectionContainerMappings"},
+				{ResourceType: "azurerm_site_recovery_protection_container_mapping", ImportSpec: "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectionContainerMappings"},
+				{ResourceType: "azurerm_site_recovery_vmware_replication_policy_association", ImportSpec: "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectionContainerMappings"},
+			},
+		},
+		"/MICROSOFT.RECOVERYSERVICES/VAULTS/REPLICATIONPOLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_site_recovery_hyperv_replication_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationPolicies"},
+				{ResourceType: "azurerm_site_recovery_replication_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationPolicies"},
+				{ResourceType: "azurerm_site_recovery_vmware_replication_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationPolicies"},
+			},
+		},
+		"/MICROSOFT.RECOVERYSERVICES/VAULTS/REPLICATIONRECOVERYPLANS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_site_recovery_replication_recovery_plan", ImportSpec: "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationRecoveryPlans"},
+			},
+		},
+		"/MICROSOFT.REDHATOPENSHIFT/OPENSHIFTCLUSTERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_redhat_openshift_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.RedHatOpenShift/openShiftClusters"},
+			},
+		},
+		"/MICROSOFT.RELAY/NAMESPACES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_relay_namespace", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Relay/namespaces"},
+			},
+		},
+		"/MICROSOFT.RELAY/NAMESPACES/AUTHORIZATIONRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_relay_namespace_authorization_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Relay/namespaces/authorizationRules"},
+			},
+		},
+		"/MICROSOFT.RELAY/NAMESPACES/HYBRIDCONNECTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_relay_hybrid_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Relay/namespaces/hybridConnections"},
+			},
+		},
+		"/MICROSOFT.RELAY/NAMESPACES/HYBRIDCONNECTIONS/AUTHORIZATIONRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_relay_hybrid_connection_authorization_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Relay/namespaces/hybridConnections/authorizationRules"},
+			},
+		},
+		"/MICROSOFT.RESOURCECONNECTOR/APPLIANCES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_arc_resource_bridge_appliance", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ResourceConnector/appliances"},
+			},
+		},
+		"/MICROSOFT.RESOURCES/DEPLOYMENTS": {
+			"/": {
+				{ResourceType: "azurerm_tenant_template_deployment", ImportSpec: "/Microsoft.Resources/deployments"},
+			},
+			"/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS": {
+				{ResourceType: "azurerm_management_group_template_deployment", ImportSpec: "/Microsoft.Management/managementGroups/Microsoft.Resources/deployments"},
+			},
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_subscription_template_deployment", ImportSpec: "/subscriptions/Microsoft.Resources/deployments"},
+			},
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_resource_group_template_deployment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Resources/deployments"},
+			},
+		},
+		"/MICROSOFT.RESOURCES/DEPLOYMENTSCRIPTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_resource_deployment_script_azure_cli", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Resources/deploymentScripts"},
+				{ResourceType: "azurerm_resource_deployment_script_azure_power_shell", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Resources/deploymentScripts"},
+			},
+		},
+		"/MICROSOFT.SCVMM/AVAILABILITYSETS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_system_center_virtual_machine_manager_availability_set", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ScVmm/availabilitySets"},
+			},
+		},
+		"/MICROSOFT.SCVMM/CLOUDS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_system_center_virtual_machine_manager_cloud", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ScVmm/clouds"},
+			},
+		},
+		"/MICROSOFT.SCVMM/VIRTUALMACHINEINSTANCES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.HYBRIDCOMPUTE/MACHINES": {
+				{ResourceType: "azurerm_system_center_virtual_machine_manager_virtual_machine_instance", ImportSpec: "/subscriptions/resourceGroups/Microsoft.HybridCompute/machines/Microsoft.ScVmm/virtualMachineInstances"},
+			},
+		},
+		"/MICROSOFT.SCVMM/VIRTUALMACHINETEMPLATES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_system_center_virtual_machine_manager_virtual_machine_template", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ScVmm/virtualMachineTemplates"},
+			},
+		},
+		"/MICROSOFT.SCVMM/VIRTUALNETWORKS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_system_center_virtual_machine_manager_virtual_network", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ScVmm/virtualNetworks"},
+			},
+		},
+		"/MICROSOFT.SCVMM/VMMSERVERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_system_center_virtual_machine_manager_server", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ScVmm/vmmServers"},
+			},
+		},
+		"/MICROSOFT.SEARCH/SEARCHSERVICES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_search_service", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Search/searchServices"},
+			},
+		},
+		"/MICROSOFT.SEARCH/SEARCHSERVICES/SHAREDPRIVATELINKRESOURCES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_search_shared_private_link_service", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Search/searchServices/sharedPrivateLinkResources"},
+			},
+		},
+		"/MICROSOFT.SECURITY/ADVANCEDTHREATPROTECTIONSETTINGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.STORAGE/STORAGEACCOUNTS": {
+				{ResourceType: "azurerm_advanced_threat_protection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/Microsoft.Security/advancedThreatProtectionSettings"},
+			},
+		},
+		"/MICROSOFT.SECURITY/ASSESSMENTMETADATA": {
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_security_center_assessment_policy", ImportSpec: "/subscriptions/Microsoft.Security/assessmentMetadata"},
+			},
+		},
+		"/MICROSOFT.SECURITY/ASSESSMENTS": {
+			"ANY": {
+				{ResourceType: "azurerm_security_center_assessment"},
+			},
+		},
+		"/MICROSOFT.SECURITY/AUTOMATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_security_center_automation", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Security/automations"},
+			},
+		},
+		"/MICROSOFT.SECURITY/AUTOPROVISIONINGSETTINGS": {
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_security_center_auto_provisioning", ImportSpec: "/subscriptions/Microsoft.Security/autoProvisioningSettings"},
+			},
+		},
+		"/MICROSOFT.SECURITY/DEFENDERFORSTORAGESETTINGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.STORAGE/STORAGEACCOUNTS": {
+				{ResourceType: "azurerm_security_center_storage_defender", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/Microsoft.Security/defenderForStorageSettings"},
+			},
+		},
+		"/MICROSOFT.SECURITY/DEVICESECURITYGROUPS": {
+			"ANY": {
+				{ResourceType: "azurerm_iot_security_device_group"},
+			},
+		},
+		"/MICROSOFT.SECURITY/IOTSECURITYSOLUTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_iot_security_solution", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Security/iotSecuritySolutions"},
+			},
+		},
+		"/MICROSOFT.SECURITY/PRICINGS": {
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_security_center_subscription_pricing", ImportSpec: "/subscriptions/Microsoft.Security/pricings"},
+			},
+		},
+		"/MICROSOFT.SECURITY/SECURITYCONTACTS": {
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_security_center_contact", ImportSpec: "/subscriptions/Microsoft.Security/securityContacts"},
+			},
+		},
+		"/MICROSOFT.SECURITY/SERVERVULNERABILITYASSESSMENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.COMPUTE/VIRTUALMACHINES": {
+				{ResourceType: "azurerm_security_center_server_vulnerability_assessment_virtual_machine", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/Microsoft.Security/serverVulnerabilityAssessments"},
+			},
+		},
+		"/MICROSOFT.SECURITY/SERVERVULNERABILITYASSESSMENTSSETTINGS": {
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_security_center_server_vulnerability_assessments_setting", ImportSpec: "/subscriptions/Microsoft.Security/serverVulnerabilityAssessmentsSettings"},
+			},
+		},
+		"/MICROSOFT.SECURITY/SETTINGS": {
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_security_center_setting", ImportSpec: "/subscriptions/Microsoft.Security/settings"},
+			},
+		},
+		"/MICROSOFT.SECURITY/WORKSPACESETTINGS": {
+			"/SUBSCRIPTIONS": {
+				{ResourceType: "azurerm_security_center_workspace", ImportSpec: "/subscriptions/Microsoft.Security/workspaceSettings"},
+			},
+		},
+		"/MICROSOFT.SECURITYINSIGHTS/ALERTRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES": {
+				{ResourceType: "azurerm_sentinel_alert_rule_fusion", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"},
+				{ResourceType: "azurerm_sentinel_alert_rule_machine_learning_behavior_analytics", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"},
+				{ResourceType: "azurerm_sentinel_alert_rule_ms_security_incident", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"},
+				{ResourceType: "azurerm_sentinel_alert_rule_nrt", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"},
+				{ResourceType: "azurerm_sentinel_alert_rule_scheduled", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"},
+				{ResourceType: "azurerm_sentinel_alert_rule_threat_intelligence", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"},
+			},
+		},
+		"/MICROSOFT.SECURITYINSIGHTS/AUTOMATIONRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES": {
+				{ResourceType: "azurerm_sentinel_automation_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/automationRules"},
+			},
+		},
+		"/MICROSOFT.SECURITYINSIGHTS/DATACONNECTORS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES": {
+				{ResourceType: "azurerm_sentinel_data_connector_aws_cloud_trail", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+				{ResourceType: "azurerm_sentinel_data_connector_aws_s3", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+				{ResourceType: "azurerm_sentinel_data_connector_azure_active_directory", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+				{ResourceType: "azurerm_sentinel_data_connector_azure_advanced_threat_protection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+				{ResourceType: "azurerm_sentinel_data_connector_azure_security_center", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+				{ResourceType: "azurerm_sentinel_data_connector_dynamics_365", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+				{ResourceType: "azurerm_sentinel_data_connector_iot", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+				{ResourceType: "azurerm_sentinel_data_connector_microsoft_cloud_app_security", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+				{ResourceType: "azurerm_sentinel_data_connector_microsoft_defender_advanced_threat_protection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+				{ResourceType: "azurerm_sentinel_data_connector_microsoft_threat_intelligence", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+				{ResourceType: "azurerm_sentinel_data_connector_microsoft_threat_protection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+				{ResourceType: "azurerm_sentinel_data_connector_office_365", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+				{ResourceType: "azurerm_sentinel_data_connector_office_365_project", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+				{ResourceType: "azurerm_sentinel_data_connector_office_atp", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+				{ResourceType: "azurerm_sentinel_data_connector_office_irm", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+				{ResourceType: "azurerm_sentinel_data_connector_office_power_bi", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+				{ResourceType: "azurerm_sentinel_data_connector_threat_intelligence", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+				{ResourceType: "azurerm_sentinel_data_connector_threat_intelligence_taxii", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"},
+			},
+		},
+		"/MICROSOFT.SECURITYINSIGHTS/METADATA": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES": {
+				{ResourceType: "azurerm_sentinel_metadata", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/metadata"},
+			},
+		},
+		"/MICROSOFT.SECURITYINSIGHTS/ONBOARDINGSTATES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES": {
+				{ResourceType: "azurerm_sentinel_log_analytics_workspace_onboarding", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/onboardingStates"},
+			},
+		},
+		"/MICROSOFT.SECURITYINSIGHTS/SECURITYMLANALYTICSSETTINGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES": {
+				{ResourceType: "azurerm_sentinel_alert_rule_anomaly_built_in", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/securityMLAnalyticsSettings"},
+				{ResourceType: "azurerm_sentinel_alert_rule_anomaly_duplicate", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/securityMLAnalyticsSettings"},
+			},
+		},
+		"/MICROSOFT.SECURITYINSIGHTS/THREATINTELLIGENCE/INDICATORS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES": {
+				{ResourceType: "azurerm_sentinel_threat_intelligence_indicator", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/threatIntelligence/indicators"},
+			},
+		},
+		"/MICROSOFT.SECURITYINSIGHTS/WATCHLISTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES": {
+				{ResourceType: "azurerm_sentinel_watchlist", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/watchlists"},
+			},
+		},
+		"/MICROSOFT.SECURITYINSIGHTS/WATCHLISTS/WATCHLISTITEMS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES": {
+				{ResourceType: "azurerm_sentinel_watchlist_item", ImportSpec: "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/watchlists/watchlistItems"},
+			},
+		},
+		"/MICROSOFT.SERVICEBUS/NAMESPACES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_servicebus_namespace", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces"},
+			},
+		},
+		"/MICROSOFT.SERVICEBUS/NAMESPACES/AUTHORIZATIONRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_servicebus_namespace_authorization_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/authorizationRules"},
+			},
+		},
+		"/MICROSOFT.SERVICEBUS/NAMESPACES/DISASTERRECOVERYCONFIGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_servicebus_namespace_disaster_recovery_config", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/disasterRecoveryConfigs"},
+			},
+		},
+		"/MICROSOFT.SERVICEBUS/NAMESPACES/QUEUES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_servicebus_queue", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/queues"},
+			},
+		},
+		"/MICROSOFT.SERVICEBUS/NAMESPACES/QUEUES/AUTHORIZATIONRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_servicebus_queue_authorization_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/queues/authorizationRules"},
+			},
+		},
+		"/MICROSOFT.SERVICEBUS/NAMESPACES/TOPICS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_servicebus_topic", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/topics"},
+			},
+		},
+		"/MICROSOFT.SERVICEBUS/NAMESPACES/TOPICS/AUTHORIZATIONRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_servicebus_topic_authorization_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/topics/authorizationRules"},
+			},
+		},
+		"/MICROSOFT.SERVICEBUS/NAMESPACES/TOPICS/SUBSCRIPTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_servicebus_subscription", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/topics/subscriptions"},
+			},
+		},
+		"/MICROSOFT.SERVICEBUS/NAMESPACES/TOPICS/SUBSCRIPTIONS/RULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_servicebus_subscription_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/topics/subscriptions/rules"},
+			},
+		},
+		"/MICROSOFT.SERVICEFABRIC/CLUSTERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_service_fabric_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ServiceFabric/clusters"},
+			},
+		},
+		"/MICROSOFT.SERVICEFABRIC/MANAGEDCLUSTERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_service_fabric_managed_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ServiceFabric/managedClusters"},
+			},
+		},
+		"/MICROSOFT.SERVICELINKER/LINKERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.APPPLATFORM/SPRING/APPS/DEPLOYMENTS": {
+				{ResourceType: "azurerm_spring_cloud_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.AppPlatform/Spring/apps/deployments/Microsoft.ServiceLinker/linkers"},
+			},
+			"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.WEB/SITES": {
+				{ResourceType: "azurerm_app_service_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/sites/Microsoft.ServiceLinker/linkers"},
+				{ResourceType: "azurerm_function_app_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/sites/Microsoft.ServiceLinker/linkers"},
+			},
+		},
+		"/MICROSOFT.SERVICENETWORKING/TRAFFICCONTROLLERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_application_load_balancer", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ServiceNetworking/trafficControllers"},
+			},
+		},
+		"/MICROSOFT.SERVICENETWORKING/TRAFFICCONTROLLERS/ASSOCIATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_application_load_balancer_subnet_association", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ServiceNetworking/trafficControllers/associations"},
+			},
+		},
+		"/MICROSOFT.SERVICENETWORKING/TRAFFICCONTROLLERS/FRONTENDS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_application_load_balancer_frontend", ImportSpec: "/subscriptions/resourceGroups/Microsoft.ServiceNetworking/trafficControllers/frontends"},
+			},
+		},
+		"/MICROSOFT.SIGNALRSERVICE/SIGNALR": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_signalr_service", ImportSpec: "/subscriptions/resourceGroups/Microsoft.SignalRService/signalR"},
+			},
+		},
+		"/MICROSOFT.SIGNALRSERVICE/SIGNALR/CUSTOMCERTIFICATES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_signalr_service_custom_certificate", ImportSpec: "/subscriptions/resourceGroups/Microsoft.SignalRService/signalR/customCertificates"},
+			},
+		},
+		"/MICROSOFT.SIGNALRSERVICE/SIGNALR/CUSTOMDOMAINS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_signalr_service_custom_domain", ImportSpec: "/subscriptions/resourceGroups/Microsoft.SignalRService/signalR/customDomains"},
+			},
+		},
+		"/MICROSOFT.SIGNALRSERVICE/SIGNALR/SHAREDPRIVATELINKRESOURCES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_signalr_shared_private_link_resource", ImportSpec: "/subscriptions/resourceGroups/Microsoft.SignalRService/signalR/sharedPrivateLinkResources"},
+			},
+		},
+		"/MICROSOFT.SIGNALRSERVICE/WEBPUBSUB": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_web_pubsub", ImportSpec: "/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub"},
+				{ResourceType: "azurerm_web_pubsub_socketio", ImportSpec: "/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub"},
+			},
+		},
+		"/MICROSOFT.SIGNALRSERVICE/WEBPUBSUB/CUSTOMCERTIFICATES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_web_pubsub_custom_certificate", ImportSpec: "/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub/customCertificates"},
+			},
+		},
+		"/MICROSOFT.SIGNALRSERVICE/WEBPUBSUB/CUSTOMDOMAINS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_web_pubsub_custom_domain", ImportSpec: "/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub/customDomains"},
+			},
+		},
+		"/MICROSOFT.SIGNALRSERVICE/WEBPUBSUB/HUBS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_web_pubsub_hub", ImportSpec: "/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub/hubs"},
+			},
+		},
+		"/MICROSOFT.SIGNALRSERVICE/WEBPUBSUB/SHAREDPRIVATELINKRESOURCES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_web_pubsub_shared_private_link_resource", ImportSpec: "/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub/sharedPrivateLinkResources"},
+			},
+		},
+		"/MICROSOFT.SOLUTIONS/APPLICATIONDEFINITIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_managed_application_definition", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Solutions/applicationDefinitions"},
+			},
+		},
+		"/MICROSOFT.SOLUTIONS/APPLICATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_managed_application", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Solutions/applications"},
+			},
+		},
+		"/MICROSOFT.SQL/LOCATIONS/INSTANCEFAILOVERGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_managed_instance_failover_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/locations/instanceFailoverGroups"},
+			},
+		},
+		"/MICROSOFT.SQL/MANAGEDINSTANCES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_managed_instance", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/managedInstances"},
+			},
+		},
+		"/MICROSOFT.SQL/MANAGEDINSTANCES/ADMINISTRATORS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_managed_instance_active_directory_administrator", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/managedInstances/administrators"},
+			},
+		},
+		"/MICROSOFT.SQL/MANAGEDINSTANCES/DATABASES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_managed_database", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/managedInstances/databases"},
+			},
+		},
+		"/MICROSOFT.SQL/MANAGEDINSTANCES/ENCRYPTIONPROTECTOR": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_managed_instance_transparent_data_encryption", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/managedInstances/encryptionProtector"},
+			},
+		},
+		"/MICROSOFT.SQL/MANAGEDINSTANCES/SECURITYALERTPOLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_managed_instance_security_alert_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/managedInstances/securityAlertPolicies"},
+			},
+		},
+		"/MICROSOFT.SQL/MANAGEDINSTANCES/VULNERABILITYASSESSMENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_managed_instance_vulnerability_assessment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/managedInstances/vulnerabilityAssessments"},
+			},
+		},
+		"/MICROSOFT.SQL/SERVERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_server", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/servers"},
+			},
+		},
+		"/MICROSOFT.SQL/SERVERS/DATABASES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_database", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/servers/databases"},
+			},
+		},
+		"/MICROSOFT.SQL/SERVERS/DATABASES/EXTENDEDAUDITINGSETTINGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_database_extended_auditing_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/servers/databases/extendedAuditingSettings"},
+			},
+		},
+		"/MICROSOFT.SQL/SERVERS/DATABASES/VULNERABILITYASSESSMENTS/RULES/BASELINES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_database_vulnerability_assessment_rule_baseline", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/servers/databases/vulnerabilityAssessments/rules/baselines"},
+			},
+		},
+		"/MICROSOFT.SQL/SERVERS/DEVOPSAUDITINGSETTINGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_server_microsoft_support_auditing_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/servers/devOpsAuditingSettings"},
+			},
+		},
+		"/MICROSOFT.SQL/SERVERS/DNSALIASES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_server_dns_alias", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/servers/dnsAliases"},
+			},
+		},
+		"/MICROSOFT.SQL/SERVERS/ELASTICPOOLS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_elasticpool", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/servers/elasticPools"},
+			},
+		},
+		"/MICROSOFT.SQL/SERVERS/ENCRYPTIONPROTECTOR": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_server_transparent_data_encryption", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/servers/encryptionProtector"},
+			},
+		},
+		"/MICROSOFT.SQL/SERVERS/EXTENDEDAUDITINGSETTINGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_server_extended_auditing_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/servers/extendedAuditingSettings"},
+			},
+		},
+		"/MICROSOFT.SQL/SERVERS/FAILOVERGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_failover_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/servers/failoverGroups"},
+			},
+		},
+		"/MICROSOFT.SQL/SERVERS/FIREWALLRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_firewall_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/servers/firewallRules"},
+			},
+		},
+		"/MICROSOFT.SQL/SERVERS/JOBAGENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_job_agent", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents"},
+			},
+		},
+		"/MICROSOFT.SQL/SERVERS/JOBAGENTS/CREDENTIALS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_job_credential", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents/credentials"},
+			},
+		},
+		"/MICROSOFT.SQL/SERVERS/JOBAGENTS/JOBS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_job", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents/jobs"},
+			},
+		},
+		"/MICROSOFT.SQL/SERVERS/JOBAGENTS/JOBS/SCHEDULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_job_schedule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents/jobs"},
+			},
+		},
+		"/MICROSOFT.SQL/SERVERS/JOBAGENTS/JOBS/STEPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_job_step", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents/jobs/steps"},
+			},
+		},
+		"/MICROSOFT.SQL/SERVERS/JOBAGENTS/TARGETGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_job_target_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents/targetGroups"},
+			},
+		},
+		"/MICROSOFT.SQL/SERVERS/OUTBOUNDFIREWALLRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_outbound_firewall_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/servers/outboundFirewallRules"},
+			},
+		},
+		"/MICROSOFT.SQL/SERVERS/SECURITYALERTPOLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_server_security_alert_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/servers/securityAlertPolicies"},
+			},
+		},
+		"/MICROSOFT.SQL/SERVERS/VIRTUALNETWORKRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_virtual_network_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/servers/virtualNetworkRules"},
+			},
+		},
+		"/MICROSOFT.SQL/SERVERS/VULNERABILITYASSESSMENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_server_vulnerability_assessment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Sql/servers/vulnerabilityAssessments"},
+			},
+		},
+		"/MICROSOFT.SQLVIRTUALMACHINE/SQLVIRTUALMACHINEGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_virtual_machine_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.SqlVirtualMachine/sqlVirtualMachineGroups"},
+			},
+		},
+		"/MICROSOFT.SQLVIRTUALMACHINE/SQLVIRTUALMACHINEGROUPS/AVAILABILITYGROUPLISTENERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_virtual_machine_availability_group_listener", ImportSpec: "/subscriptions/resourceGroups/Microsoft.SqlVirtualMachine/sqlVirtualMachineGroups/availabilityGroupListeners"},
+			},
+		},
+		"/MICROSOFT.SQLVIRTUALMACHINE/SQLVIRTUALMACHINES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_mssql_virtual_machine", ImportSpec: "/subscriptions/resourceGroups/Microsoft.SqlVirtualMachine/sqlVirtualMachines"},
+			},
+		},
+		"/MICROSOFT.STANDBYPOOL/STANDBYVIRTUALMACHINEPOOLS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_virtual_machine_scale_set_standby_pool", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StandbyPool/standbyVirtualMachinePools"},
+			},
+		},
+		"/MICROSOFT.STORAGE/STORAGEACCOUNTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_account", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"},
+			},
+		},
+		"/MICROSOFT.STORAGE/STORAGEACCOUNTS/BLOBSERVICES/CONTAINERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_container", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/blobServices/containers"},
+			},
+		},
+		"/MICROSOFT.STORAGE/STORAGEACCOUNTS/BLOBSERVICES/CONTAINERS/BLOBS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_blob"},
+			},
+		},
+		"/MICROSOFT.STORAGE/STORAGEACCOUNTS/BLOBSERVICES/CONTAINERS/IMMUTABILITYPOLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_container_immutability_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/blobServices/containers/immutabilityPolicies"},
+			},
+		},
+		"/MICROSOFT.STORAGE/STORAGEACCOUNTS/DFS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_data_lake_gen2_filesystem"},
+			},
+		},
+		"/MICROSOFT.STORAGE/STORAGEACCOUNTS/DFS/PATHS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_data_lake_gen2_path"},
+			},
+		},
+		"/MICROSOFT.STORAGE/STORAGEACCOUNTS/ENCRYPTIONSCOPES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_encryption_scope", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/encryptionScopes"},
+			},
+		},
+		"/MICROSOFT.STORAGE/STORAGEACCOUNTS/FILESERVICES/SHARES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_share", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/fileServices/shares"},
+			},
+		},
+		"/MICROSOFT.STORAGE/STORAGEACCOUNTS/FILESERVICES/SHARES/DIRECTORIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_share_directory"},
+			},
+		},
+		"/MICROSOFT.STORAGE/STORAGEACCOUNTS/FILESERVICES/SHARES/FILES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_share_file"},
+			},
+		},
+		"/MICROSOFT.STORAGE/STORAGEACCOUNTS/INVENTORYPOLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_blob_inventory_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"},
+			},
+		},
+		"/MICROSOFT.STORAGE/STORAGEACCOUNTS/LOCALUSERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_account_local_user", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/localUsers"},
+			},
+		},
+		"/MICROSOFT.STORAGE/STORAGEACCOUNTS/MANAGEMENTPOLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_management_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/managementPolicies"},
+			},
+		},
+		"/MICROSOFT.STORAGE/STORAGEACCOUNTS/OBJECTREPLICATIONPOLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_object_replication"},
+			},
+		},
+		"/MICROSOFT.STORAGE/STORAGEACCOUNTS/QUEUESERVICES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_account_queue_properties", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"},
+			},
+		},
+		"/MICROSOFT.STORAGE/STORAGEACCOUNTS/QUEUESERVICES/QUEUES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_queue"},
+			},
+		},
+		"/MICROSOFT.STORAGE/STORAGEACCOUNTS/STATICWEBSITES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_account_static_website", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"},
+			},
+		},
+		"/MICROSOFT.STORAGE/STORAGEACCOUNTS/TABLESERVICES/TABLES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_table"},
+			},
+		},
+		"/MICROSOFT.STORAGE/STORAGEACCOUNTS/TABLESERVICES/TABLES/PARTITIONKEYS/ROWKEYS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_table_entity"},
+			},
+		},
+		"/MICROSOFT.STORAGECACHE/AMLFILESYSTEMS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_managed_lustre_file_system", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StorageCache/amlFilesystems"},
+			},
+		},
+		"/MICROSOFT.STORAGECACHE/CACHES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_hpc_cache", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StorageCache/caches"},
+			},
+		},
+		"/MICROSOFT.STORAGECACHE/CACHES/CACHEACCESSPOLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_hpc_cache_access_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StorageCache/caches/cacheAccessPolicies"},
+			},
+		},
+		"/MICROSOFT.STORAGECACHE/CACHES/STORAGETARGETS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_hpc_cache_blob_nfs_target", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StorageCache/caches/storageTargets"},
+				{ResourceType: "azurerm_hpc_cache_blob_target", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StorageCache/caches/storageTargets"},
+				{ResourceType: "azurerm_hpc_cache_nfs_target", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StorageCache/caches/storageTargets"},
+			},
+		},
+		"/MICROSOFT.STORAGEMOVER/STORAGEMOVERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_mover", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers"},
+			},
+		},
+		"/MICROSOFT.STORAGEMOVER/STORAGEMOVERS/AGENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_mover_agent", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers/agents"},
+			},
+		},
+		"/MICROSOFT.STORAGEMOVER/STORAGEMOVERS/ENDPOINTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_mover_source_endpoint", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers/endpoints"},
+				{ResourceType: "azurerm_storage_mover_target_endpoint", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers/endpoints"},
+			},
+		},
+		"/MICROSOFT.STORAGEMOVER/STORAGEMOVERS/PROJECTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_mover_project", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers/projects"},
+			},
+		},
+		"/MICROSOFT.STORAGEMOVER/STORAGEMOVERS/PROJECTS/JOBDEFINITIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_mover_job_definition", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers/projects/jobDefinitions"},
+			},
+		},
+		"/MICROSOFT.STORAGESYNC/STORAGESYNCSERVICES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_sync", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StorageSync/storageSyncServices"},
+			},
+		},
+		"/MICROSOFT.STORAGESYNC/STORAGESYNCSERVICES/SYNCGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_sync_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StorageSync/storageSyncServices/syncGroups"},
+			},
+		},
+		"/MICROSOFT.STORAGESYNC/STORAGESYNCSERVICES/SYNCGROUPS/CLOUDENDPOINTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_sync_cloud_endpoint", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StorageSync/storageSyncServices/syncGroups/cloudEndpoints"},
+			},
+		},
+		"/MICROSOFT.STORAGESYNC/STORAGESYNCSERVICES/SYNCGROUPS/SERVERENDPOINTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_storage_sync_server_endpoint", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StorageSync/storageSyncServices/syncGroups/serverEndpoints"},
+			},
+		},
+		"/MICROSOFT.STREAMANALYTICS/CLUSTERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_stream_analytics_cluster", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/clusters"},
+			},
+		},
+		"/MICROSOFT.STREAMANALYTICS/CLUSTERS/PRIVATEENDPOINTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_stream_analytics_managed_private_endpoint", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/clusters/privateEndpoints"},
+			},
+		},
+		"/MICROSOFT.STREAMANALYTICS/STREAMINGJOBS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_stream_analytics_job", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs"},
+			},
+		},
+		"/MICROSOFT.STREAMANALYTICS/STREAMINGJOBS/FUNCTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_stream_analytics_function_javascript_uda", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/functions"},
+				{ResourceType: "azurerm_stream_analytics_function_javascript_udf", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/functions"},
+			},
+		},
+		"/MICROSOFT.STREAMANALYTICS/STREAMINGJOBS/INPUTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_stream_analytics_reference_input_blob", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"},
+				{ResourceType: "azurerm_stream_analytics_reference_input_mssql", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"},
+				{ResourceType: "azurerm_stream_analytics_stream_input_blob", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"},
+				{ResourceType: "azurerm_stream_analytics_stream_input_eventhub", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"},
+				{ResourceType: "azurerm_stream_analytics_stream_input_eventhub_v2", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"},
+				{ResourceType: "azurerm_stream_analytics_stream_input_iothub", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"},
+			},
+		},
+		"/MICROSOFT.STREAMANALYTICS/STREAMINGJOBS/OUTPUTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_stream_analytics_output_blob", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"},
+				{ResourceType: "azurerm_stream_analytics_output_cosmosdb", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"},
+				{ResourceType: "azurerm_stream_analytics_output_eventhub", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"},
+				{ResourceType: "azurerm_stream_analytics_output_function", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"},
+				{ResourceType: "azurerm_stream_analytics_output_mssql", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"},
+				{ResourceType: "azurerm_stream_analytics_output_powerbi", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"},
+				{ResourceType: "azurerm_stream_analytics_output_servicebus_queue", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"},
+				{ResourceType: "azurerm_stream_analytics_output_servicebus_topic", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"},
+				{ResourceType: "azurerm_stream_analytics_output_synapse", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"},
+				{ResourceType: "azurerm_stream_analytics_output_table", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"},
+			},
+		},
+		"/MICROSOFT.STREAMANALYTICS/STREAMINGJOBS/SCHEDULE": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_stream_analytics_job_schedule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/schedule"},
+			},
+		},
+		"/MICROSOFT.STREAMANALYTICS/STREAMINGJOBS/STORAGEACCOUNTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_stream_analytics_job_storage_account", ImportSpec: "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs"},
+			},
+		},
+		"/MICROSOFT.SUBSCRIPTION/ALIASES": {
+			"/": {
+				{ResourceType: "azurerm_subscription", ImportSpec: "/Microsoft.Subscription/aliases"},
+			},
+		},
+		"/MICROSOFT.SYNAPSE/PRIVATELINKHUBS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_synapse_private_link_hub", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Synapse/privateLinkHubs"},
+			},
+		},
+		"/MICROSOFT.SYNAPSE/WORKSPACES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_synapse_workspace", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces"},
+			},
+		},
+		"/MICROSOFT.SYNAPSE/WORKSPACES/ADMINISTRATORS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_synapse_workspace_aad_admin", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/administrators"},
+			},
+		},
+		"/MICROSOFT.SYNAPSE/WORKSPACES/BIGDATAPOOLS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_synapse_spark_pool", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/bigDataPools"},
+			},
+		},
+		"/MICROSOFT.SYNAPSE/WORKSPACES/EXTENDEDAUDITINGSETTINGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_synapse_workspace_extended_auditing_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/extendedAuditingSettings"},
+			},
+		},
+		"/MICROSOFT.SYNAPSE/WORKSPACES/FIREWALLRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_synapse_firewall_rule", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/firewallRules"},
+			},
+		},
+		"/MICROSOFT.SYNAPSE/WORKSPACES/INTEGRATIONRUNTIMES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_synapse_integration_runtime_azure", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/integrationRuntimes"},
+				{ResourceType: "azurerm_synapse_integration_runtime_self_hosted", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/integrationRuntimes"},
+			},
+		},
+		"/MICROSOFT.SYNAPSE/WORKSPACES/KEYS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_synapse_workspace_key", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/keys"},
+			},
+		},
+		"/MICROSOFT.SYNAPSE/WORKSPACES/LINKEDSERVICES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_synapse_linked_service", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/linkedServices"},
+			},
+		},
+		"/MICROSOFT.SYNAPSE/WORKSPACES/MANAGEDVIRTUALNETWORKS/MANAGEDPRIVATEENDPOINTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_synapse_managed_private_endpoint", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/managedVirtualNetworks/managedPrivateEndpoints"},
+			},
+		},
+		"/MICROSOFT.SYNAPSE/WORKSPACES/ROLEASSIGNMENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_synapse_role_assignment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces"},
+			},
+		},
+		"/MICROSOFT.SYNAPSE/WORKSPACES/SECURITYALERTPOLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_synapse_workspace_security_alert_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/securityAlertPolicies"},
+			},
+		},
+		"/MICROSOFT.SYNAPSE/WORKSPACES/SQLPOOLS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_synapse_sql_pool", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools"},
+			},
+		},
+		"/MICROSOFT.SYNAPSE/WORKSPACES/SQLPOOLS/EXTENDEDAUDITINGSETTINGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_synapse_sql_pool_extended_auditing_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/extendedAuditingSettings"},
+			},
+		},
+		"/MICROSOFT.SYNAPSE/WORKSPACES/SQLPOOLS/SECURITYALERTPOLICIES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_synapse_sql_pool_security_alert_policy", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/securityAlertPolicies"},
+			},
+		},
+		"/MICROSOFT.SYNAPSE/WORKSPACES/SQLPOOLS/VULNERABILITYASSESSMENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_synapse_sql_pool_vulnerability_assessment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/vulnerabilityAssessments"},
+			},
+		},
+		"/MICROSOFT.SYNAPSE/WORKSPACES/SQLPOOLS/VULNERABILITYASSESSMENTS/RULES/BASELINES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_synapse_sql_pool_vulnerability_assessment_baseline", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/vulnerabilityAssessments/rules/baselines"},
+			},
+		},
+		"/MICROSOFT.SYNAPSE/WORKSPACES/SQLPOOLS/WORKLOADGROUPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_synapse_sql_pool_workload_group", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/workloadGroups"},
+			},
+		},
+		"/MICROSOFT.SYNAPSE/WORKSPACES/SQLPOOLS/WORKLOADGROUPS/WORKLOADCLASSIFIERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_synapse_sql_pool_workload_classifier", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/workloadGroups/workloadClassifiers"},
+			},
+		},
+		"/MICROSOFT.SYNAPSE/WORKSPACES/VULNERABILITYASSESSMENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_synapse_workspace_vulnerability_assessment", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/vulnerabilityAssessments"},
+			},
+		},
+		"/MICROSOFT.VIDEOINDEXER/ACCOUNTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_video_indexer_account", ImportSpec: "/subscriptions/resourceGroups/Microsoft.VideoIndexer/accounts"},
+			},
+		},
+		"/MICROSOFT.VOICESERVICES/COMMUNICATIONSGATEWAYS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_voice_services_communications_gateway", ImportSpec: "/subscriptions/resourceGroups/Microsoft.VoiceServices/communicationsGateways"},
+			},
+		},
+		"/MICROSOFT.VOICESERVICES/COMMUNICATIONSGATEWAYS/TESTLINES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_voice_services_communications_gateway_test_line", ImportSpec: "/subscriptions/resourceGroups/Microsoft.VoiceServices/communicationsGateways/testLines"},
+			},
+		},
+		"/MICROSOFT.WEB/CERTIFICATES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_app_service_certificate", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/certificates"},
+				{ResourceType: "azurerm_app_service_managed_certificate", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/certificates"},
+			},
+		},
+		"/MICROSOFT.WEB/CONNECTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_api_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/connections"},
+			},
+		},
+		"/MICROSOFT.WEB/HOSTINGENVIRONMENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_app_service_environment_v3", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/hostingEnvironments"},
+			},
+		},
+		"/MICROSOFT.WEB/SERVERFARMS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_service_plan", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/serverFarms"},
+			},
+		},
+		"/MICROSOFT.WEB/SITES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_function_app_flex_consumption", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/sites"},
+				{ResourceType: "azurerm_linux_function_app", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/sites"},
+				{ResourceType: "azurerm_linux_web_app", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/sites"},
+				{ResourceType: "azurerm_logic_app_standard", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/sites"},
+				{ResourceType: "azurerm_windows_function_app", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/sites"},
+				{ResourceType: "azurerm_windows_web_app", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/sites"},
+			},
+		},
+		"/MICROSOFT.WEB/SITES/FUNCTIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_function_app_function", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/sites/functions"},
+			},
+		},
+		"/MICROSOFT.WEB/SITES/HOSTNAMEBINDINGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_app_service_custom_hostname_binding", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/sites/hostNameBindings"},
+			},
+		},
+		"/MICROSOFT.WEB/SITES/HOSTNAMEBINDINGS/CERTIFICATES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_app_service_certificate_binding"},
+			},
+		},
+		"/MICROSOFT.WEB/SITES/HYBRIDCONNECTIONNAMESPACES/RELAYS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_function_app_hybrid_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/sites/hybridConnectionNamespaces/relays"},
+				{ResourceType: "azurerm_web_app_hybrid_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/sites/hybridConnectionNamespaces/relays"},
+			},
+		},
+		"/MICROSOFT.WEB/SITES/NETWORKCONFIG": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_app_service_virtual_network_swift_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/sites/config"},
+			},
+		},
+		"/MICROSOFT.WEB/SITES/PUBLICCERTIFICATES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_app_service_public_certificate", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/sites/publicCertificates"},
+			},
+		},
+		"/MICROSOFT.WEB/SITES/SLOTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_linux_function_app_slot", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/sites/slots"},
+				{ResourceType: "azurerm_linux_web_app_slot", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/sites/slots"},
+				{ResourceType: "azurerm_windows_function_app_slot", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/sites/slots"},
+				{ResourceType: "azurerm_windows_web_app_slot", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/sites/slots"},
+			},
+		},
+		"/MICROSOFT.WEB/SITES/SLOTS/HOSTNAMEBINDINGS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_app_service_slot_custom_hostname_binding", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/sites/slots/hostNameBindings"},
+			},
+		},
+		"/MICROSOFT.WEB/SITES/SLOTS/NETWORKCONFIG": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_app_service_slot_virtual_network_swift_connection", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/sites/slots/config"},
+			},
+		},
+		"/MICROSOFT.WEB/SOURCECONTROLS": {
+			"/": {
+				{ResourceType: "azurerm_source_control_token", ImportSpec: "/Microsoft.Web/sourceControls"},
+			},
+		},
+		"/MICROSOFT.WEB/STATICSITES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_static_web_app", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/staticSites"},
+			},
+		},
+		"/MICROSOFT.WEB/STATICSITES/CUSTOMDOMAINS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_static_web_app_custom_domain", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/staticSites/customDomains"},
+			},
+		},
+		"/MICROSOFT.WEB/STATICSITES/USERPROVIDEDFUNCTIONAPPS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_static_web_app_function_app_registration", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Web/staticSites/userProvidedFunctionApps"},
+			},
+		},
+		"/MICROSOFT.WORKLOADS/SAPVIRTUALINSTANCES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_workloads_sap_discovery_virtual_instance", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Workloads/sapVirtualInstances"},
+				{ResourceType: "azurerm_workloads_sap_single_node_virtual_instance", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Workloads/sapVirtualInstances"},
+				{ResourceType: "azurerm_workloads_sap_three_tier_virtual_instance", ImportSpec: "/subscriptions/resourceGroups/Microsoft.Workloads/sapVirtualInstances"},
+			},
+		},
+		"/NEWRELIC.OBSERVABILITY/MONITORS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_new_relic_monitor", ImportSpec: "/subscriptions/resourceGroups/NewRelic.Observability/monitors"},
+			},
+		},
+		"/NEWRELIC.OBSERVABILITY/MONITORS/TAGRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_new_relic_tag_rule", ImportSpec: "/subscriptions/resourceGroups/NewRelic.Observability/monitors/tagRules"},
+			},
+		},
+		"/NGINX.NGINXPLUS/NGINXDEPLOYMENTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_nginx_deployment", ImportSpec: "/subscriptions/resourceGroups/Nginx.NginxPlus/nginxDeployments"},
+			},
+		},
+		"/NGINX.NGINXPLUS/NGINXDEPLOYMENTS/CERTIFICATES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_nginx_certificate", ImportSpec: "/subscriptions/resourceGroups/Nginx.NginxPlus/nginxDeployments/certificates"},
+			},
+		},
+		"/NGINX.NGINXPLUS/NGINXDEPLOYMENTS/CONFIGURATIONS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_nginx_configuration", ImportSpec: "/subscriptions/resourceGroups/Nginx.NginxPlus/nginxDeployments/configurations"},
+			},
+		},
+		"/ORACLE.DATABASE/AUTONOMOUSDATABASES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_oracle_autonomous_database", ImportSpec: "/subscriptions/resourceGroups/Oracle.Database/autonomousDatabases"},
+			},
+		},
+		"/ORACLE.DATABASE/CLOUDEXADATAINFRASTRUCTURES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_oracle_exadata_infrastructure", ImportSpec: "/subscriptions/resourceGroups/Oracle.Database/cloudExadataInfrastructures"},
+			},
+		},
+		"/ORACLE.DATABASE/CLOUDVMCLUSTERS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_oracle_cloud_vm_cluster", ImportSpec: "/subscriptions/resourceGroups/Oracle.Database/cloudVmClusters"},
+			},
+		},
+		"/PALOALTONETWORKS.CLOUDNGFW/FIREWALLS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_palo_alto_next_generation_firewall_virtual_hub_local_rulestack", ImportSpec: "/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/firewalls"},
+				{ResourceType: "azurerm_palo_alto_next_generation_firewall_virtual_hub_panorama", ImportSpec: "/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/firewalls"},
+				{ResourceType: "azurerm_palo_alto_next_generation_firewall_virtual_network_local_rulestack", ImportSpec: "/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/firewalls"},
+				{ResourceType: "azurerm_palo_alto_next_generation_firewall_virtual_network_panorama", ImportSpec: "/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/firewalls"},
+			},
+		},
+		"/PALOALTONETWORKS.CLOUDNGFW/LOCALRULESTACKS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_palo_alto_local_rulestack", ImportSpec: "/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/localRulestacks"},
+			},
+		},
+		"/PALOALTONETWORKS.CLOUDNGFW/LOCALRULESTACKS/CERTIFICATES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_palo_alto_local_rulestack_certificate", ImportSpec: "/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/localRulestacks/certificates"},
+			},
+		},
+		"/PALOALTONETWORKS.CLOUDNGFW/LOCALRULESTACKS/FQDNLISTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_palo_alto_local_rulestack_fqdn_list", ImportSpec: "/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/localRulestacks/fqdnLists"},
+			},
+		},
+		"/PALOALTONETWORKS.CLOUDNGFW/LOCALRULESTACKS/LOCALRULES": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_palo_alto_local_rulestack_rule", ImportSpec: "/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/localRulestacks/localRules"},
+			},
+		},
+		"/PALOALTONETWORKS.CLOUDNGFW/LOCALRULESTACKS/PREFIXLISTS": {
+			"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+				{ResourceType: "azurerm_palo_alto_local_rulestack_prefix_list", ImportSpec: "/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/localRulestacks/prefixLists"},
+			},
+		},
+		"/SUBSCRIPTIONS/RESOURCEGROUPS": {
+			"": {
+				{ResourceType: "azurerm_resource_group"},
+			},
+		},
+	}
+}
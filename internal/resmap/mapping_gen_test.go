@@ -0,0 +1,18 @@
+package resmap
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedMappingUpToDate(t *testing.T) {
+	b, err := os.ReadFile("map.json")
+	require.NoError(t, err)
+	expect, err := GenerateSource(b)
+	require.NoError(t, err)
+	actual, err := os.ReadFile("mapping_gen.go")
+	require.NoError(t, err)
+	require.True(t, string(expect) == string(actual), `mapping_gen.go is out of date, run "go generate ./internal/resmap" to update it`)
+}
@@ -0,0 +1,175 @@
+package resmap
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// TF2ARMIdMapOverrides maps from TF resource type to its override on top of the generated mapping (i.e. map_gen.json).
+type TF2ARMIdMapOverrides map[string]TF2ARMIdMapOverride
+
+// TF2ARMIdMapOverride overrides a TF2ARMIdMapItem. Only the non-nil fields take effect.
+// If the resource type doesn't exist in the generated mapping, the override adds it, in which case the management plane provider and types are required.
+type TF2ARMIdMapOverride struct {
+	// Delete deletes the resource type from the generated mapping. It can't be used together with the other fields.
+	Delete bool `json:"delete,omitempty"`
+
+	IsRemoved    *bool   `json:"is_removed,omitempty"`
+	RemoveReason *string `json:"remove_reason,omitempty"`
+
+	ManagementPlane *MapManagementPlaneOverride `json:"management_plane,omitempty"`
+}
+
+// MapManagementPlaneOverride overrides a MapManagementPlane. Only the non-nil fields take effect, an empty list clears the field.
+type MapManagementPlaneOverride struct {
+	ParentScopes *[]string `json:"scopes,omitempty"`
+	Provider     *string   `json:"provider,omitempty"`
+	Types        *[]string `json:"types,omitempty"`
+	ImportSpecs  *[]string `json:"import_specs,omitempty"`
+}
+
+const (
+	MergeIssueConflict = "conflict"
+	MergeIssueStale    = "stale"
+)
+
+// MergeIssue is an issue found when merging the overrides into the generated mapping.
+type MergeIssue struct {
+	ResourceType string
+	// Kind is either MergeIssueConflict or MergeIssueStale.
+	Kind    string
+	Message string
+}
+
+func (issue MergeIssue) String() string {
+	return fmt.Sprintf("%s: %s: %s", issue.Kind, issue.ResourceType, issue.Message)
+}
+
+// Merge merges the overrides into the generated base mapping, returns the merged mapping and the issues found, which are sorted by the resource type.
+// A conflict means the override can't be applied, in which case the resource type is left untouched.
+// A stale override means (part of) the override has no effect any more (e.g. the generated mapping already has the same value), which is still merged.
+func Merge(base TF2ARMIdMapType, overrides TF2ARMIdMapOverrides) (TF2ARMIdMapType, []MergeIssue) {
+	out := TF2ARMIdMapType{}
+	for rt, item := range base {
+		out[rt] = item
+	}
+
+	var issues []MergeIssue
+	for rt, ov := range overrides {
+		nIssues := len(issues)
+		baseItem, inBase := base[rt]
+		conflict := func(format string, a ...interface{}) {
+			issues = append(issues, MergeIssue{ResourceType: rt, Kind: MergeIssueConflict, Message: fmt.Sprintf(format, a...)})
+		}
+		stale := func(format string, a ...interface{}) {
+			issues = append(issues, MergeIssue{ResourceType: rt, Kind: MergeIssueStale, Message: fmt.Sprintf(format, a...)})
+		}
+
+		if ov.Delete {
+			if ov.IsRemoved != nil || ov.RemoveReason != nil || ov.ManagementPlane != nil {
+				conflict("delete can't be used together with the other fields")
+				continue
+			}
+			if !inBase {
+				stale("deleting a resource type that doesn't exist in the generated mapping")
+				continue
+			}
+			delete(out, rt)
+			continue
+		}
+
+		if !inBase {
+			if ov.ManagementPlane == nil || ov.ManagementPlane.Provider == nil || ov.ManagementPlane.Types == nil {
+				conflict("adding a resource type that doesn't exist in the generated mapping requires the management plane provider and types")
+				continue
+			}
+		}
+
+		item := TF2ARMIdMapItem{
+			IsRemoved:    baseItem.IsRemoved,
+			RemoveReason: baseItem.RemoveReason,
+		}
+		if ov.IsRemoved != nil {
+			if inBase && *ov.IsRemoved == baseItem.IsRemoved {
+				stale("is_removed is the same as the generated one")
+			}
+			item.IsRemoved = *ov.IsRemoved
+		}
+		if ov.RemoveReason != nil {
+			if inBase && *ov.RemoveReason == baseItem.RemoveReason {
+				stale("remove_reason is the same as the generated one")
+			}
+			item.RemoveReason = *ov.RemoveReason
+		}
+
+		var baseMP MapManagementPlane
+		if baseItem.ManagementPlane != nil {
+			baseMP = *baseItem.ManagementPlane
+		}
+		mp := baseMP
+		if ovMP := ov.ManagementPlane; ovMP != nil {
+			if ovMP.ParentScopes != nil {
+				mp.ParentScopes = nilIfEmpty(*ovMP.ParentScopes)
+				if inBase && reflect.DeepEqual(mp.ParentScopes, baseMP.ParentScopes) {
+					stale("management_plane.scopes is the same as the generated one")
+				}
+			}
+			if ovMP.Provider != nil {
+				mp.Provider = *ovMP.Provider
+				if inBase && mp.Provider == baseMP.Provider {
+					stale("management_plane.provider is the same as the generated one")
+				}
+			}
+			if ovMP.Types != nil {
+				mp.Types = nilIfEmpty(*ovMP.Types)
+				if inBase && reflect.DeepEqual(mp.Types, baseMP.Types) {
+					stale("management_plane.types is the same as the generated one")
+				}
+			}
+			if ovMP.ImportSpecs != nil {
+				mp.ImportSpecs = nilIfEmpty(*ovMP.ImportSpecs)
+				if inBase && reflect.DeepEqual(mp.ImportSpecs, baseMP.ImportSpecs) {
+					stale("management_plane.import_specs is the same as the generated one")
+				}
+			}
+		}
+		if baseItem.ManagementPlane != nil || ov.ManagementPlane != nil {
+			item.ManagementPlane = &mp
+		}
+
+		if inBase && len(issues) == nIssues && reflect.DeepEqual(item, baseItem) {
+			stale("the override has no effect")
+		}
+		out[rt] = item
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].ResourceType < issues[j].ResourceType
+	})
+	return out, issues
+}
+
+// Diff returns the resource types whose items are different in the two mappings, sorted.
+func Diff(m1, m2 TF2ARMIdMapType) []string {
+	var out []string
+	for rt, item := range m1 {
+		if item2, ok := m2[rt]; !ok || !reflect.DeepEqual(item, item2) {
+			out = append(out, rt)
+		}
+	}
+	for rt := range m2 {
+		if _, ok := m1[rt]; !ok {
+			out = append(out, rt)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+func nilIfEmpty(l []string) []string {
+	if len(l) == 0 {
+		return nil
+	}
+	return l
+}
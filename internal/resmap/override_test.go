@@ -0,0 +1,183 @@
+package resmap
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergedMappingUpToDate(t *testing.T) {
+	var base TF2ARMIdMapType
+	b, err := os.ReadFile("map_gen.json")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(b, &base))
+
+	var overrides TF2ARMIdMapOverrides
+	b, err = os.ReadFile("map_override.json")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(b, &overrides))
+
+	merged, issues := Merge(base, overrides)
+	require.Empty(t, issues)
+
+	expect, err := json.MarshalIndent(merged, "", "  ")
+	require.NoError(t, err)
+	expect = append(expect, '\n')
+	actual, err := os.ReadFile("map.json")
+	require.NoError(t, err)
+	require.True(t, string(expect) == string(actual), `map.json is out of date, run "go generate ./internal/resmap" to update it`)
+}
+
+func TestMerge(t *testing.T) {
+	strPtr := func(v string) *string { return &v }
+	boolPtr := func(v bool) *bool { return &v }
+	strsPtr := func(v []string) *[]string { return &v }
+	rgItem := TF2ARMIdMapItem{
+		ManagementPlane: &MapManagementPlane{
+			ParentScopes: []string{"/subscriptions/resourceGroups"},
+			Provider:     "Microsoft.Foo",
+			Types:        []string{"foos"},
+			ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Foo/foos"},
+		},
+	}
+	base := TF2ARMIdMapType{
+		"azurerm_foo": rgItem,
+	}
+
+	cases := []struct {
+		name         string
+		overrides    TF2ARMIdMapOverrides
+		expect       TF2ARMIdMapType
+		expectIssues []MergeIssue
+	}{
+		{
+			name:   "no override",
+			expect: base,
+		},
+		{
+			name: "delete",
+			overrides: TF2ARMIdMapOverrides{
+				"azurerm_foo": {Delete: true},
+			},
+			expect: TF2ARMIdMapType{},
+		},
+		{
+			name: "delete together with other fields",
+			overrides: TF2ARMIdMapOverrides{
+				"azurerm_foo": {Delete: true, IsRemoved: boolPtr(true)},
+			},
+			expect: base,
+			expectIssues: []MergeIssue{
+				{ResourceType: "azurerm_foo", Kind: MergeIssueConflict, Message: "delete can't be used together with the other fields"},
+			},
+		},
+		{
+			name: "delete a nonexistent type",
+			overrides: TF2ARMIdMapOverrides{
+				"azurerm_bar": {Delete: true},
+			},
+			expect: base,
+			expectIssues: []MergeIssue{
+				{ResourceType: "azurerm_bar", Kind: MergeIssueStale, Message: "deleting a resource type that doesn't exist in the generated mapping"},
+			},
+		},
+		{
+			name: "add",
+			overrides: TF2ARMIdMapOverrides{
+				"azurerm_bar": {
+					ManagementPlane: &MapManagementPlaneOverride{
+						Provider: strPtr("Microsoft.Bar"),
+						Types:    strsPtr([]string{"bars"}),
+					},
+				},
+			},
+			expect: TF2ARMIdMapType{
+				"azurerm_foo": rgItem,
+				"azurerm_bar": {
+					ManagementPlane: &MapManagementPlane{
+						Provider: "Microsoft.Bar",
+						Types:    []string{"bars"},
+					},
+				},
+			},
+		},
+		{
+			name: "add without types",
+			overrides: TF2ARMIdMapOverrides{
+				"azurerm_bar": {
+					ManagementPlane: &MapManagementPlaneOverride{
+						Provider: strPtr("Microsoft.Bar"),
+					},
+				},
+			},
+			expect: base,
+			expectIssues: []MergeIssue{
+				{ResourceType: "azurerm_bar", Kind: MergeIssueConflict, Message: "adding a resource type that doesn't exist in the generated mapping requires the management plane provider and types"},
+			},
+		},
+		{
+			name: "override and clear",
+			overrides: TF2ARMIdMapOverrides{
+				"azurerm_foo": {
+					IsRemoved:    boolPtr(true),
+					RemoveReason: strPtr("deprecated"),
+					ManagementPlane: &MapManagementPlaneOverride{
+						ParentScopes: strsPtr([]string{ScopeAny}),
+						ImportSpecs:  strsPtr([]string{}),
+					},
+				},
+			},
+			expect: TF2ARMIdMapType{
+				"azurerm_foo": {
+					IsRemoved:    true,
+					RemoveReason: "deprecated",
+					ManagementPlane: &MapManagementPlane{
+						ParentScopes: []string{ScopeAny},
+						Provider:     "Microsoft.Foo",
+						Types:        []string{"foos"},
+					},
+				},
+			},
+		},
+		{
+			name: "stale",
+			overrides: TF2ARMIdMapOverrides{
+				"azurerm_foo": {
+					ManagementPlane: &MapManagementPlaneOverride{
+						Provider: strPtr("Microsoft.Foo"),
+					},
+				},
+			},
+			expect: base,
+			expectIssues: []MergeIssue{
+				{ResourceType: "azurerm_foo", Kind: MergeIssueStale, Message: "management_plane.provider is the same as the generated one"},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, issues := Merge(base, tt.overrides)
+			require.Equal(t, tt.expect, actual)
+			require.Equal(t, tt.expectIssues, issues)
+			require.Empty(t, Diff(tt.expect, actual))
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	m1 := TF2ARMIdMapType{
+		"azurerm_a": {IsRemoved: true},
+		"azurerm_b": {},
+		"azurerm_c": {},
+	}
+	m2 := TF2ARMIdMapType{
+		"azurerm_a": {},
+		"azurerm_b": {},
+		"azurerm_d": {},
+	}
+	require.Equal(t, []string{"azurerm_a", "azurerm_c", "azurerm_d"}, Diff(m1, m2))
+	require.Empty(t, Diff(m1, m1))
+}
@@ -1,42 +1,48 @@
 package resmap
 
 import (
-	_ "embed"
-	"encoding/json"
 	"strings"
 	"sync"
 )
 
-var (
-	//go:embed map.json
-	mappingContent []byte
+// The map.json is the merge result of the generated map_gen.json and the hand-maintained map_override.json.
+// The mapping_gen.go is then generated from the map.json, which builds the maps without parsing the JSON at runtime.
+//go:generate go run ../../tool/aztft-map-merge -base map_gen.json -overrides map_override.json -out map.json
+//go:generate go run ../../tool/aztft-map-codegen -in map.json -out mapping_gen.go
 
+var (
 	TF2ARMIdMap TF2ARMIdMapType
 	ARMId2TFMap ARMId2TFMapType
 
 	once sync.Once
+	// mu guards the TF2ARMIdMap and ARMId2TFMap against the loads of the extension files, which update them.
+	mu sync.RWMutex
 )
 
 func Init() {
 	once.Do(func() {
-		if err := json.Unmarshal(mappingContent, &TF2ARMIdMap); err != nil {
-			panic(err.Error())
-		}
-		var err error
-		if ARMId2TFMap, err = TF2ARMIdMap.toARM2TFMap(); err != nil {
-			panic(err.Error())
-		}
+		TF2ARMIdMap = genTF2ARMIdMap()
+		ARMId2TFMap = genARMId2TFMap()
 	})
 }
 
+// RLock initializes the mapping, and locks it for reading until the returned function is called, so that the queries can run concurrently with
+// the loads of the extension files. It must not be called again (e.g. by the callees) before unlocking, nor shall the extension files be loaded.
+func RLock() (unlock func()) {
+	Init()
+	mu.RLock()
+	return mu.RUnlock
+}
+
 // TF2ARMIdMapType maps from TF resource type to the ARM item
 type TF2ARMIdMapType map[string]TF2ARMIdMapItem
 
 type TF2ARMIdMapItem struct {
-	ManagementPlane *MapManagementPlane `json:"management_plane,omitempty"`
-
 	// Indicates whether this TF resource is removed/deprecated
-	IsRemoved bool `json:"is_removed,omitempty"`
+	IsRemoved    bool   `json:"is_removed,omitempty"`
+	RemoveReason string `json:"remove_reason,omitempty"`
+
+	ManagementPlane *MapManagementPlane `json:"management_plane,omitempty"`
 }
 
 const ScopeAny string = "any"
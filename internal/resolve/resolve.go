@@ -4,8 +4,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
-	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/magodo/armid"
 	"github.com/magodo/aztft/internal/client"
 )
@@ -222,6 +220,80 @@ var Resolvers = map[string]map[string]resolver{
 	},
 }
 
+// ReadActions are the ARM actions that the resolver of each routing key calls, which are checked against the Resolvers by TestReadActions.
+// Most resolvers read the resource being resolved, while some read its parent resource instead.
+// An empty list means the resolver doesn't call any API.
+var ReadActions = map[string][]string{
+	"/MICROSOFT.COMPUTE/VIRTUALMACHINES":                                                                              {"Microsoft.Compute/virtualMachines/read"},
+	"/MICROSOFT.COMPUTE/VIRTUALMACHINESCALESETS":                                                                      {"Microsoft.Compute/virtualMachineScaleSets/read"},
+	"/MICROSOFT.DEVTESTLAB/LABS/VIRTUALMACHINES":                                                                      {"Microsoft.DevTestLab/labs/virtualMachines/read"},
+	"/MICROSOFT.APIMANAGEMENT/SERVICE/IDENTITYPROVIDERS":                                                              {},
+	"/MICROSOFT.RECOVERYSERVICES/VAULTS/BACKUPPOLICIES":                                                               {"Microsoft.RecoveryServices/vaults/backupPolicies/read"},
+	"/MICROSOFT.RECOVERYSERVICES/VAULTS/BACKUPFABRICS/PROTECTIONCONTAINERS/PROTECTEDITEMS":                            {"Microsoft.RecoveryServices/vaults/backupFabrics/protectionContainers/protectedItems/read"},
+	"/MICROSOFT.RECOVERYSERVICES/VAULTS/REPLICATIONFABRICS/REPLICATIONPROTECTIONCONTAINERS/REPLICATIONPROTECTEDITEMS": {"Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectedItems/read"},
+	"/MICROSOFT.DATAPROTECTION/BACKUPVAULTS/BACKUPPOLICIES":                                                           {"Microsoft.DataProtection/backupVaults/backupPolicies/read"},
+	"/MICROSOFT.DATAPROTECTION/BACKUPVAULTS/BACKUPINSTANCES":                                                          {"Microsoft.DataProtection/backupVaults/backupInstances/read"},
+	"/MICROSOFT.SYNAPSE/WORKSPACES/INTEGRATIONRUNTIMES":                                                               {"Microsoft.Synapse/workspaces/integrationRuntimes/read"},
+	"/MICROSOFT.DIGITALTWINS/DIGITALTWINSINSTANCES/ENDPOINTS":                                                         {"Microsoft.DigitalTwins/digitalTwinsInstances/endpoints/read"},
+	"/MICROSOFT.DATAFACTORY/FACTORIES/TRIGGERS":                                                                       {"Microsoft.DataFactory/factories/triggers/read"},
+	"/MICROSOFT.DATAFACTORY/FACTORIES/DATASETS":                                                                       {"Microsoft.DataFactory/factories/datasets/read"},
+	"/MICROSOFT.DATAFACTORY/FACTORIES/DATAFLOWS":                                                                      {"Microsoft.DataFactory/factories/dataflows/read"},
+	"/MICROSOFT.DATAFACTORY/FACTORIES/LINKEDSERVICES":                                                                 {"Microsoft.DataFactory/factories/linkedservices/read"},
+	"/MICROSOFT.DATAFACTORY/FACTORIES/INTEGRATIONRUNTIMES":                                                            {"Microsoft.DataFactory/factories/integrationruntimes/read"},
+	"/MICROSOFT.DATAFACTORY/FACTORIES/CREDENTIALS":                                                                    {"Microsoft.DataFactory/factories/credentials/read"},
+	"/MICROSOFT.KUSTO/CLUSTERS/DATABASES/DATACONNECTIONS":                                                             {"Microsoft.Kusto/clusters/databases/dataConnections/read"},
+	"/MICROSOFT.MACHINELEARNINGSERVICES/WORKSPACES/COMPUTES":                                                          {"Microsoft.MachineLearningServices/workspaces/computes/read"},
+	"/MICROSOFT.MACHINELEARNINGSERVICES/WORKSPACES/DATASTORES":                                                        {"Microsoft.MachineLearningServices/workspaces/dataStores/read"},
+	"/MICROSOFT.MACHINELEARNINGSERVICES/WORKSPACES/OUTBOUNDRULES":                                                     {"Microsoft.MachineLearningServices/workspaces/outboundRules/read"},
+	"/MICROSOFT.STORAGECACHE/CACHES/STORAGETARGETS":                                                                   {"Microsoft.StorageCache/caches/storageTargets/read"},
+	"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/CONNECTIONS":                                                            {"Microsoft.Automation/automationAccounts/connections/read"},
+	"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/VARIABLES":                                                              {"Microsoft.Automation/automationAccounts/variables/read"},
+	"/MICROSOFT.BOTSERVICE/BOTSERVICES":                                                                               {"Microsoft.BotService/botServices/read"},
+	"/MICROSOFT.BOTSERVICE/BOTSERVICES/CHANNELS":                                                                      {"Microsoft.BotService/botServices/channels/read"},
+	"/MICROSOFT.SECURITYINSIGHTS/DATACONNECTORS":                                                                      {"Microsoft.SecurityInsights/dataConnectors/read"},
+	"/MICROSOFT.SECURITYINSIGHTS/ALERTRULES":                                                                          {"Microsoft.SecurityInsights/alertRules/read"},
+	"/MICROSOFT.SECURITYINSIGHTS/SECURITYMLANALYTICSSETTINGS":                                                         {"Microsoft.SecurityInsights/securityMLAnalyticsSettings/read"},
+	"/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES/DATASOURCES":                                                           {"Microsoft.OperationalInsights/workspaces/dataSources/read"},
+	"/MICROSOFT.APPPLATFORM/SPRING/APPS/BINDINGS":                                                                     {"Microsoft.AppPlatform/spring/apps/bindings/read"},
+	"/MICROSOFT.APPPLATFORM/SPRING/APPS/DEPLOYMENTS":                                                                  {"Microsoft.AppPlatform/spring/apps/deployments/read"},
+	"/MICROSOFT.DATASHARE/ACCOUNTS/SHARES/DATASETS":                                                                   {"Microsoft.DataShare/accounts/shares/dataSets/read"},
+	"/MICROSOFT.HDINSIGHT/CLUSTERS":                                                                                   {"Microsoft.HDInsight/clusters/read"},
+	"/MICROSOFT.STREAMANALYTICS/STREAMINGJOBS/INPUTS":                                                                 {"Microsoft.StreamAnalytics/streamingjobs/inputs/read"},
+	"/MICROSOFT.STREAMANALYTICS/STREAMINGJOBS/OUTPUTS":                                                                {"Microsoft.StreamAnalytics/streamingJobs/outputs/read"},
+	"/MICROSOFT.STREAMANALYTICS/STREAMINGJOBS/FUNCTIONS":                                                              {"Microsoft.StreamAnalytics/streamingJobs/functions/read"},
+	"/MICROSOFT.INSIGHTS/SCHEDULEDQUERYRULES":                                                                         {"Microsoft.Insights/scheduledQueryRules/read"},
+	"/MICROSOFT.CDN/PROFILES":                                                                                         {"Microsoft.Cdn/profiles/read"},
+	"/MICROSOFT.WEB/CERTIFICATES":                                                                                     {"Microsoft.Web/certificates/read"},
+	"/MICROSOFT.WEB/SITES":                                                                                            {"Microsoft.Web/sites/read"},
+	"/MICROSOFT.WEB/SITES/SLOTS":                                                                                      {"Microsoft.Web/sites/slots/read"},
+	"/MICROSOFT.WEB/SITES/HYBRIDCONNECTIONNAMESPACES/RELAYS":                                                          {"Microsoft.Web/sites/read"},
+	"/MICROSOFT.ALERTSMANAGEMENT/ACTIONRULES":                                                                         {"Microsoft.AlertsManagement/actionRules/read"},
+	"/MICROSOFT.NETWORK/VIRTUALHUBS":                                                                                  {"Microsoft.Network/virtualHubs/read"},
+	"/MICROSOFT.NETWORK/VIRTUALHUBS/BGPCONNECTIONS":                                                                   {"Microsoft.Network/virtualHubs/read"},
+	"/MICROSOFT.NETWORK/FRONTDOORWEBAPPLICATIONFIREWALLPOLICIES":                                                      {"Microsoft.Network/frontDoorWebApplicationFirewallPolicies/read"},
+	"/MICROSOFT.NETWORK/NETWORKWATCHERS/PACKETCAPTURES":                                                               {"Microsoft.Network/networkWatchers/packetCaptures/read"},
+	"/MICROSOFT.RESOURCES/DEPLOYMENTSCRIPTS":                                                                          {"Microsoft.Resources/deploymentScripts/read"},
+	"/MICROSOFT.RECOVERYSERVICES/VAULTS/REPLICATIONPOLICIES":                                                          {"Microsoft.RecoveryServices/vaults/replicationPolicies/read"},
+	"/MICROSOFT.RECOVERYSERVICES/VAULTS/REPLICATIONFABRICS":                                                           {"Microsoft.RecoveryServices/vaults/replicationFabrics/read"},
+	"/MICROSOFT.RECOVERYSERVICES/VAULTS/REPLICATIONFABRICS/REPLICATIONPROTECTIONCONTAINERS/REPLICATIONPROTECTIONCONTAINERMAPPINGS": {"Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectionContainerMappings/read"},
+	"/MICROSOFT.RECOVERYSERVICES/VAULTS/REPLICATIONFABRICS/REPLICATIONNETWORKS/REPLICATIONNETWORKMAPPINGS":                         {"Microsoft.RecoveryServices/vaults/replicationFabrics/replicationNetworks/replicationNetworkMappings/read"},
+	"/MICROSOFT.STORAGEMOVER/STORAGEMOVERS/ENDPOINTS":                                                                              {"Microsoft.StorageMover/storageMovers/endpoints/read"},
+	"/MICROSOFT.COSTMANAGEMENT/SCHEDULEDACTIONS":                                                                                   {"Microsoft.CostManagement/scheduledActions/read"},
+	"/MICROSOFT.INSIGHTS/WEBTESTS":                                                                                                 {"Microsoft.Insights/webTests/read"},
+	"/MICROSOFT.LOGIC/WORKFLOWS/ACTIONS":                                                                                           {"Microsoft.Logic/workflows/read"},
+	"/MICROSOFT.LOGIC/WORKFLOWS/TRIGGERS":                                                                                          {"Microsoft.Logic/workflows/read"},
+	"/PALOALTONETWORKS.CLOUDNGFW/FIREWALLS":                                                                                        {"PaloAltoNetworks.Cloudngfw/firewalls/read"},
+	"/MICROSOFT.SERVICELINKER/LINKERS":                                                                                             {"Microsoft.Web/sites/read"},
+	"/MICROSOFT.APPPLATFORM/SPRING/APMS":                                                                                           {"Microsoft.AppPlatform/spring/apms/read"},
+	"/MICROSOFT.WORKLOADS/SAPVIRTUALINSTANCES":                                                                                     {"Microsoft.Workloads/sapVirtualInstances/read"},
+	"/MICROSOFT.COMPUTE/VIRTUALMACHINES/DATADISKS":                                                                                 {"Microsoft.Compute/virtualMachines/read"},
+	"/MICROSOFT.COGNITIVESERVICES/ACCOUNTS":                                                                                        {"Microsoft.CognitiveServices/accounts/read"},
+	"/MICROSOFT.KUBERNETES/CONNECTEDCLUSTERS":                                                                                      {"Microsoft.Kubernetes/connectedClusters/read"},
+	"/MICROSOFT.NETAPP/NETAPPACCOUNTS/VOLUMEGROUPS":                                                                                {"Microsoft.NetApp/netAppAccounts/volumeGroups/read"},
+	"/MICROSOFT.MACHINELEARNINGSERVICES/WORKSPACES":                                                                                {"Microsoft.MachineLearningServices/workspaces/read"},
+	"/MICROSOFT.SIGNALRSERVICE/WEBPUBSUB":                                                                                          {"Microsoft.SignalRService/webPubSub/read"},
+}
+
 type ResolveError struct {
 	ResourceId armid.ResourceId
 	Err        error
@@ -253,10 +325,7 @@ func NeedsAPI(id armid.ResourceId) bool {
 }
 
 // Resolve resolves a given resource id via Azure API to disambiguate and return a single matched TF resource type.
-func Resolve(id armid.ResourceId, cred azcore.TokenCredential, clientOpt arm.ClientOptions) (string, error) {
-	// Ensure the API client can be built.
-	b := &client.ClientBuilder{Cred: cred, ClientOpt: clientOpt}
-
+func Resolve(id armid.ResourceId, b *client.ClientBuilder) (string, error) {
 	resolver, ok := getResolver(id)
 	if !ok {
 		return "", ResolveError{ResourceId: id, Err: fmt.Errorf("no resolver found for %q", id)}
@@ -1,7 +1,6 @@
 package resolve
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/alertsmanagement/armalertsmanagement"
@@ -24,7 +23,7 @@ func (alertsManagementProcessingRulesResolver) Resolve(b *client.ClientBuilder,
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.GetByName(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.GetByName(b.Context(), resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
 		return "", fmt.Errorf("retrieving %q: %v", id, err)
 	}
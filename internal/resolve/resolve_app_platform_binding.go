@@ -1,7 +1,6 @@
 package resolve
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/magodo/armid"
@@ -20,7 +19,7 @@ func (appPlatformBindingsResolver) Resolve(b *client.ClientBuilder, id armid.Res
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], id.Names()[2], nil)
+	resp, err := client.Get(b.Context(), resourceGroupId.Name, id.Names()[0], id.Names()[1], id.Names()[2], nil)
 	if err != nil {
 		return "", fmt.Errorf("retrieving %q: %v", id, err)
 	}
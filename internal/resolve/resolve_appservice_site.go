@@ -1,7 +1,6 @@
 package resolve
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
@@ -30,7 +29,7 @@ func (appServiceSitesResolver) Resolve(b *client.ClientBuilder, id armid.Resourc
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.Get(b.Context(), resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
 		return "", fmt.Errorf("retrieving %q: %v", id, err)
 	}
@@ -38,6 +37,11 @@ func (appServiceSitesResolver) Resolve(b *client.ClientBuilder, id armid.Resourc
 	if kind == nil {
 		return "", fmt.Errorf("unexpected nil kind in response")
 	}
+	return AppServiceSiteTypeByKind(*kind)
+}
+
+// AppServiceSiteTypeByKind returns the TF resource type of the app service site by its kind.
+func AppServiceSiteTypeByKind(kind string) (string, error) {
 	// The value of kind for different resource are listed below:
 	//
 	// azurerm_logic_app_standard	: functionapp,workflowapp or functionapp,linux,container,workflowapp
@@ -46,7 +50,7 @@ func (appServiceSitesResolver) Resolve(b *client.ClientBuilder, id armid.Resourc
 	// azurerm_linux_web_app		: app,linux
 	// azurerm_windows_web_app		: app,container,windows
 
-	kinds := strings.Split(*kind, ",")
+	kinds := strings.Split(kind, ",")
 	m := map[string]bool{}
 	for _, k := range kinds {
 		m[strings.ToLower(k)] = true
@@ -71,5 +75,5 @@ func (appServiceSitesResolver) Resolve(b *client.ClientBuilder, id armid.Resourc
 		return "azurerm_windows_web_app", nil
 	}
 
-	return "", fmt.Errorf("unknown kind: %s", *kind)
+	return "", fmt.Errorf("unknown kind: %s", kind)
 }
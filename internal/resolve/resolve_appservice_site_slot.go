@@ -1,7 +1,6 @@
 package resolve
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
@@ -26,7 +25,7 @@ func (appServiceSiteSlotsResolver) Resolve(b *client.ClientBuilder, id armid.Res
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.GetSlot(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.GetSlot(b.Context(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
 		return "", fmt.Errorf("retrieving %q: %v", id, err)
 	}
@@ -34,6 +33,11 @@ func (appServiceSiteSlotsResolver) Resolve(b *client.ClientBuilder, id armid.Res
 	if kind == nil {
 		return "", fmt.Errorf("unexpected nil kind in response")
 	}
+	return AppServiceSiteSlotTypeByKind(*kind)
+}
+
+// AppServiceSiteSlotTypeByKind returns the TF resource type of the app service site slot by its kind.
+func AppServiceSiteSlotTypeByKind(kind string) (string, error) {
 	// The value of kind for different resource are listed below:
 	//
 	// azurerm_windows_function_app_slot: functionapp
@@ -41,7 +45,7 @@ func (appServiceSiteSlotsResolver) Resolve(b *client.ClientBuilder, id armid.Res
 	// azurerm_windows_web_app_slot		: app
 	// azurerm_linux_web_app_slot		: app,linux
 
-	kinds := strings.Split(*kind, ",")
+	kinds := strings.Split(kind, ",")
 	m := map[string]bool{}
 	for _, k := range kinds {
 		m[strings.ToLower(k)] = true
@@ -61,5 +65,5 @@ func (appServiceSiteSlotsResolver) Resolve(b *client.ClientBuilder, id armid.Res
 		return "azurerm_windows_web_app_slot", nil
 	}
 
-	return "", fmt.Errorf("unknown kind: %s", *kind)
+	return "", fmt.Errorf("unknown kind: %s", kind)
 }
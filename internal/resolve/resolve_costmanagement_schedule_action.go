@@ -1,7 +1,6 @@
 package resolve
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
@@ -17,11 +16,12 @@ func (costmanagementScheduleActionsResolver) ResourceTypes() []string {
 }
 
 func (costmanagementScheduleActionsResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
-	client, err := b.NewCostManagementScheduledActionsClient()
+	subscriptionId := id.RootScope().(*armid.SubscriptionId)
+	client, err := b.NewCostManagementScheduledActionsClient(subscriptionId.Id)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.GetByScope(context.Background(), strings.TrimPrefix(id.ParentScope().String(), "/"), id.Names()[0], nil)
+	resp, err := client.GetByScope(b.Context(), strings.TrimPrefix(id.ParentScope().String(), "/"), id.Names()[0], nil)
 	if err != nil {
 		return "", fmt.Errorf("retrieving %q: %v", id, err)
 	}
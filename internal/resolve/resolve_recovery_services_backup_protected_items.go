@@ -1,7 +1,6 @@
 package resolve
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservicesbackup"
@@ -21,7 +20,7 @@ func (recoveryServicesBackupProtectedItemsResolver) Resolve(b *client.ClientBuil
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), id.Names()[0], resourceGroupId.Name, id.Names()[1], id.Names()[2], id.Names()[3], nil)
+	resp, err := client.Get(b.Context(), id.Names()[0], resourceGroupId.Name, id.Names()[1], id.Names()[2], id.Names()[3], nil)
 	if err != nil {
 		return "", fmt.Errorf("retrieving %q: %v", id, err)
 	}
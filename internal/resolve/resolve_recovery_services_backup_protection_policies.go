@@ -1,7 +1,6 @@
 package resolve
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservicesbackup"
@@ -25,7 +24,7 @@ func (recoveryServicesBackupProtectionPoliciesResolver) Resolve(b *client.Client
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), id.Names()[0], resourceGroupId.Name, id.Names()[1], nil)
+	resp, err := client.Get(b.Context(), id.Names()[0], resourceGroupId.Name, id.Names()[1], nil)
 	if err != nil {
 		return "", fmt.Errorf("retrieving %q: %v", id, err)
 	}
@@ -1,7 +1,6 @@
 package resolve
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/magodo/armid"
@@ -20,7 +19,7 @@ func (recoveryServicesReplicationProtectedItemsResolver) Resolve(b *client.Clien
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), id.Names()[1], id.Names()[2], id.Names()[3], nil)
+	resp, err := client.Get(b.Context(), id.Names()[1], id.Names()[2], id.Names()[3], nil)
 	if err != nil {
 		return "", fmt.Errorf("retrieving %q: %v", id, err)
 	}
@@ -1,7 +1,6 @@
 package resolve
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/magodo/armid"
@@ -21,11 +20,12 @@ func (springApmsResolver) ResourceTypes() []string {
 }
 
 func (springApmsResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
-	c, err := b.NewRawClient()
+	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
+	c, err := b.NewRawClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := c.Get(context.Background(), id.String(), "2023-11-01-preview")
+	resp, err := c.Get(b.Context(), id.String(), "2023-11-01-preview")
 	if err != nil {
 		return "", err
 	}
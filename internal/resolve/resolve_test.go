@@ -0,0 +1,43 @@
+package resolve
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/client/clienttest"
+	"github.com/magodo/aztft/internal/exampleid"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReadActions runs each resolver against an empty response of every request, to check the ARM actions it calls are declared in ReadActions.
+// The resolvers may fail to resolve the empty response, but the requests made until then are checked.
+func TestReadActions(t *testing.T) {
+	for k1, m := range Resolvers {
+		for k2, resolver := range m {
+			k1, k2, resolver := k1, k2, resolver
+			t.Run(k1+" in scope of "+k2, func(t *testing.T) {
+				declared := map[string]bool{}
+				for _, action := range ReadActions[k1] {
+					declared[strings.ToLower(action)] = true
+				}
+
+				id := exampleid.FromRouteScope(k1).(*armid.ScopedResourceId)
+				id.AttrParentScope = exampleid.FromRouteScope(k2)
+				transport := &clienttest.Transport{Default: "{}"}
+				resolver.Resolve(clienttest.NewClientBuilder(transport), id)
+
+				reqs := transport.Requests()
+				if len(declared) == 0 {
+					require.Empty(t, reqs, "the resolver calls API, but declares no read action")
+					return
+				}
+				require.NotEmpty(t, reqs, "the resolver declares read actions, but calls no API")
+				for _, req := range reqs {
+					action := clienttest.ReadAction(req)
+					require.True(t, declared[strings.ToLower(action)], "%s %s calls the undeclared action %q", req.Method, req.URL, action)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package tfid
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/client"
+)
+
+// AppConfigurationFeaturePrefix is the key prefix of the feature flags among the key-values of the App Configuration store.
+const AppConfigurationFeaturePrefix = ".appconfig.featureflag/"
+
+// appConfigurationNullLabel is how the App Configuration data plane API denotes the empty (i.e. null) label.
+const appConfigurationNullLabel = "\x00"
+
+// EncodeAppConfigurationName encodes the key (or feature name) and label of the App Configuration store as the name segment of the pseudo resource id, i.e.
+// "<store id>/AppConfigurationKey/<key>/Label/<label>" or "<store id>/AppConfigurationFeature/<feature name>/Label/<label>".
+// The name is base64 URL encoded without padding, as it can contain "/" or be empty (for the label). The empty label is encoded as the null label (i.e. "AA").
+func EncodeAppConfigurationName(name string) string {
+	if name == "" {
+		name = appConfigurationNullLabel
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(name))
+}
+
+func decodeAppConfigurationName(name string) (string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(name)
+	if err != nil {
+		return "", err
+	}
+	if string(b) == appConfigurationNullLabel {
+		return "", nil
+	}
+	return string(b), nil
+}
+
+func buildAppConfigurationKey(b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
+	return buildAppConfigurationKeyValue(b, id, "")
+}
+
+func buildAppConfigurationFeature(b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
+	return buildAppConfigurationKeyValue(b, id, AppConfigurationFeaturePrefix)
+}
+
+// buildAppConfigurationKeyValue builds the id of the key-value, which is of the form "<endpoint>/kv/<escaped prefix and key>?label=<label>".
+func buildAppConfigurationKeyValue(b *client.ClientBuilder, id armid.ResourceId, prefix string) (string, error) {
+	names := id.Names()
+	key, err := decodeAppConfigurationName(names[1])
+	if err != nil {
+		return "", fmt.Errorf("decoding the key %q: %v", names[1], err)
+	}
+	label, err := decodeAppConfigurationName(names[2])
+	if err != nil {
+		return "", fmt.Errorf("decoding the label %q: %v", names[2], err)
+	}
+
+	storeId := id.Parent().Parent()
+	endpoint, err := AppConfigurationEndpoint(b, storeId)
+	if err != nil {
+		return "", err
+	}
+	return appConfigurationKeyValueUrl(endpoint, prefix+key, label), nil
+}
+
+// appConfigurationKeyValueUrl formats the url of the key-value, whose key (including the prefix, e.g. of the feature flags) is escaped as a whole,
+// e.g. "https://store1.azconfig.io/kv/.appconfig.featureflag%2Ffeature1?label=label1". The empty label is left blank.
+func appConfigurationKeyValueUrl(endpoint, key, label string) string {
+	return fmt.Sprintf("%s/kv/%s?label=%s", strings.TrimSuffix(endpoint, "/"), url.PathEscape(key), url.QueryEscape(label))
+}
+
+// AppConfigurationEndpoint retrieves the data plane endpoint of the App Configuration store via the raw client, as there is no SDK for it in use.
+func AppConfigurationEndpoint(b *client.ClientBuilder, storeId armid.ResourceId) (string, error) {
+	resourceGroupId := storeId.RootScope().(*armid.ResourceGroup)
+	c, err := b.NewRawClient(resourceGroupId.SubscriptionId)
+	if err != nil {
+		return "", err
+	}
+	resp, err := c.Get(b.Context(), storeId.String(), "2023-03-01")
+	if err != nil {
+		return "", fmt.Errorf("retrieving %q: %v", storeId, err)
+	}
+	m, ok := resp.(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("retrieving %q: response is not a map: %T", storeId, resp)
+	}
+	props, ok := m["properties"].(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("unexpected nil property in response")
+	}
+	endpoint, _ := props["endpoint"].(string)
+	if endpoint == "" {
+		return "", fmt.Errorf("unexpected empty properties.endpoint in response")
+	}
+	return endpoint, nil
+}
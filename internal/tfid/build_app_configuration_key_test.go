@@ -0,0 +1,98 @@
+package tfid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppConfigurationName(t *testing.T) {
+	cases := []struct {
+		name    string
+		input   string
+		encoded string
+	}{
+		{
+			name:    "key",
+			input:   "color",
+			encoded: "Y29sb3I",
+		},
+		{
+			name:    "key containing slash",
+			input:   "app/color",
+			encoded: "YXBwL2NvbG9y",
+		},
+		{
+			name:    "empty label",
+			input:   "",
+			encoded: "AA",
+		},
+		{
+			name:    "feature name",
+			input:   "beta",
+			encoded: "YmV0YQ",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded := EncodeAppConfigurationName(tt.input)
+			require.Equal(t, tt.encoded, encoded)
+			decoded, err := decodeAppConfigurationName(encoded)
+			require.NoError(t, err)
+			require.Equal(t, tt.input, decoded)
+		})
+	}
+
+	_, err := decodeAppConfigurationName("not base64!")
+	require.Error(t, err)
+}
+
+func TestAppConfigurationKeyValueUrl(t *testing.T) {
+	cases := []struct {
+		name     string
+		endpoint string
+		key      string
+		label    string
+		expect   string
+	}{
+		{
+			name:     "key",
+			endpoint: "https://store1.azconfig.io",
+			key:      "color",
+			label:    "prod",
+			expect:   "https://store1.azconfig.io/kv/color?label=prod",
+		},
+		{
+			name:     "key containing slash",
+			endpoint: "https://store1.azconfig.io/",
+			key:      "app/color",
+			label:    "prod",
+			expect:   "https://store1.azconfig.io/kv/app%2Fcolor?label=prod",
+		},
+		{
+			name:     "empty label",
+			endpoint: "https://store1.azconfig.io",
+			key:      "color",
+			expect:   "https://store1.azconfig.io/kv/color?label=",
+		},
+		{
+			name:     "label needs escaping",
+			endpoint: "https://store1.azconfig.io",
+			key:      "color",
+			label:    "a&b",
+			expect:   "https://store1.azconfig.io/kv/color?label=a%26b",
+		},
+		{
+			name:     "feature flag",
+			endpoint: "https://store1.azconfig.io",
+			key:      AppConfigurationFeaturePrefix + "beta",
+			label:    "prod",
+			expect:   "https://store1.azconfig.io/kv/.appconfig.featureflag%2Fbeta?label=prod",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expect, appConfigurationKeyValueUrl(tt.endpoint, tt.key, tt.label))
+		})
+	}
+}
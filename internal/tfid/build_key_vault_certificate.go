@@ -1,7 +1,6 @@
 package tfid
 
 import (
-	"context"
 	"fmt"
 	"net/url"
 	"strings"
@@ -17,7 +16,7 @@ func buildKeyVaultCertificate(b *client.ClientBuilder, id armid.ResourceId, spec
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(b.Context(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
 		return "", fmt.Errorf("retrieving %q: %v", id, err)
 	}
@@ -5,11 +5,10 @@ import (
 	"net/url"
 
 	"github.com/magodo/armid"
-	"github.com/magodo/aztft/internal/client"
 )
 
-func buildKeyVaultStorageAccountSasTokenDefinition(b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
-	storageId, err := buildKeyVaultStorageAccount(b, id.Parent(), spec)
+func buildKeyVaultStorageAccountSasTokenDefinition(getEndpoint keyVaultEndpointFunc, id armid.ResourceId) (string, error) {
+	storageId, err := buildKeyVaultStorageAccount(getEndpoint, id.Parent())
 	if err != nil {
 		return "", err
 	}
@@ -1,46 +1,27 @@
 package tfid
 
 import (
-	"context"
 	"fmt"
 	"net/url"
 
 	"github.com/magodo/armid"
-	"github.com/magodo/aztft/internal/client"
 )
 
-func buildStorageContainerEndpoint(b *client.ClientBuilder, id armid.ResourceId, _ string) (string, error) {
-	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
-	client, err := b.NewStorageAccountsClient(resourceGroupId.SubscriptionId)
+func buildStorageContainerEndpoint(getEndpoint storageEndpointFunc, id armid.ResourceId) (string, error) {
+	blobEndpoint, err := getEndpoint(id, storageServiceBlob)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.GetProperties(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	uri, err := url.Parse(blobEndpoint)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
-	}
-	props := resp.Account.Properties
-	if props == nil {
-		return "", fmt.Errorf("unexpected nil property in response")
-	}
-	endpoints := props.PrimaryEndpoints
-	if endpoints == nil {
-		return "", fmt.Errorf("unexpected nil properties.primaryEndpoints in response")
-	}
-	blobEndpoint := endpoints.Blob
-	if blobEndpoint == nil {
-		return "", fmt.Errorf("unexpected nil properties.primaryEndpoints.blob in response")
-	}
-	uri, err := url.Parse(*blobEndpoint)
-	if err != nil {
-		return "", fmt.Errorf("failed to parse url %s: %v", *blobEndpoint, err)
+		return "", fmt.Errorf("failed to parse url %s: %v", blobEndpoint, err)
 	}
 	uri = uri.JoinPath(id.Names()[2])
 	return uri.String(), nil
 }
 
-func buildStorageBlob(b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
-	containerUrl, err := buildStorageContainerEndpoint(b, id.Parent(), spec)
+func buildStorageBlob(getEndpoint storageEndpointFunc, id armid.ResourceId) (string, error) {
+	containerUrl, err := buildStorageContainerEndpoint(getEndpoint, id.Parent())
 	if err != nil {
 		return "", err
 	}
@@ -6,11 +6,10 @@ import (
 	"strings"
 
 	"github.com/magodo/armid"
-	"github.com/magodo/aztft/internal/client"
 )
 
-func buildStorageDfsPath(b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
-	dfsId, err := buildStorageDfs(b, id.Parent(), spec)
+func buildStorageDfsPath(getEndpoint storageEndpointFunc, id armid.ResourceId) (string, error) {
+	dfsId, err := buildStorageDfs(getEndpoint, id.Parent())
 	if err != nil {
 		return "", err
 	}
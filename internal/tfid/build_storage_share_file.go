@@ -6,11 +6,10 @@ import (
 	"strings"
 
 	"github.com/magodo/armid"
-	"github.com/magodo/aztft/internal/client"
 )
 
-func buildStorageShareFile(b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
-	shareId, err := buildStorageShare(b, id.Parent(), spec)
+func buildStorageShareFile(getEndpoint storageEndpointFunc, id armid.ResourceId) (string, error) {
+	shareId, err := buildStorageShare(getEndpoint, id.Parent())
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,167 @@
+package tfid
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/client"
+)
+
+type storageService string
+
+const (
+	storageServiceBlob  storageService = "blob"
+	storageServiceQueue storageService = "queue"
+	storageServiceTable storageService = "table"
+	storageServiceFile  storageService = "file"
+	storageServiceDfs   storageService = "dfs"
+)
+
+// DataPlaneOption configures how the data plane only resource ids are built without calling the Azure API.
+type DataPlaneOption struct {
+	// Cloud is the cloud configuration, which is used to derive the data plane endpoint suffixes.
+	// The zero value means the Azure public cloud.
+	Cloud cloud.Configuration
+
+	// StorageDNSZone is the DNS zone (e.g. "z24") of the storage accounts that use the Azure DNS zone endpoints.
+	// If specified, the storage endpoints are built as "https://<account>.<zone>.<service>.storage.azure.net/".
+	StorageDNSZone string
+
+	// StorageEndpointSuffix is the storage endpoint suffix (e.g. "core.windows.net").
+	// If not specified, it is derived from the Cloud, which only works for the well-known clouds.
+	StorageEndpointSuffix string
+
+	// KeyVaultDNSSuffix is the key vault DNS suffix (e.g. "vault.azure.net").
+	// If not specified, it is derived from the Cloud, which only works for the well-known clouds.
+	KeyVaultDNSSuffix string
+}
+
+func (opt DataPlaneOption) storageEndpointSuffix() (string, error) {
+	if opt.StorageEndpointSuffix != "" {
+		return opt.StorageEndpointSuffix, nil
+	}
+	switch strings.TrimSuffix(opt.Cloud.ActiveDirectoryAuthorityHost, "/") {
+	case "", strings.TrimSuffix(cloud.AzurePublic.ActiveDirectoryAuthorityHost, "/"):
+		return "core.windows.net", nil
+	case strings.TrimSuffix(cloud.AzureChina.ActiveDirectoryAuthorityHost, "/"):
+		return "core.chinacloudapi.cn", nil
+	case strings.TrimSuffix(cloud.AzureGovernment.ActiveDirectoryAuthorityHost, "/"):
+		return "core.usgovcloudapi.net", nil
+	default:
+		return "", fmt.Errorf("unknown storage endpoint suffix for the cloud with authority host %q", opt.Cloud.ActiveDirectoryAuthorityHost)
+	}
+}
+
+// storageEndpointFunc returns the primary endpoint of the specified service of the storage account, that the id belongs to.
+type storageEndpointFunc func(id armid.ResourceId, svc storageService) (string, error)
+
+type storageBuilderFunc func(storageEndpointFunc, armid.ResourceId) (string, error)
+
+func storageEndpointOffline(opt DataPlaneOption) storageEndpointFunc {
+	return func(id armid.ResourceId, svc storageService) (string, error) {
+		accountName := id.Names()[0]
+		if opt.StorageDNSZone != "" {
+			return fmt.Sprintf("https://%s.%s.%s.storage.azure.net/", accountName, opt.StorageDNSZone, svc), nil
+		}
+		suffix, err := opt.storageEndpointSuffix()
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("https://%s.%s.%s/", accountName, svc, suffix), nil
+	}
+}
+
+func storageEndpointFromAPI(b *client.ClientBuilder) storageEndpointFunc {
+	return func(id armid.ResourceId, svc storageService) (string, error) {
+		resourceGroupId := id.RootScope().(*armid.ResourceGroup)
+		client, err := b.NewStorageAccountsClient(resourceGroupId.SubscriptionId)
+		if err != nil {
+			return "", err
+		}
+		resp, err := client.GetProperties(b.Context(), resourceGroupId.Name, id.Names()[0], nil)
+		if err != nil {
+			return "", fmt.Errorf("retrieving %q: %v", id, err)
+		}
+		props := resp.Account.Properties
+		if props == nil {
+			return "", fmt.Errorf("unexpected nil property in response")
+		}
+		endpoints := props.PrimaryEndpoints
+		if endpoints == nil {
+			return "", fmt.Errorf("unexpected nil properties.primaryEndpoints in response")
+		}
+		var endpoint *string
+		switch svc {
+		case storageServiceBlob:
+			endpoint = endpoints.Blob
+		case storageServiceQueue:
+			endpoint = endpoints.Queue
+		case storageServiceTable:
+			endpoint = endpoints.Table
+		case storageServiceFile:
+			endpoint = endpoints.File
+		case storageServiceDfs:
+			endpoint = endpoints.Dfs
+		}
+		if endpoint == nil {
+			return "", fmt.Errorf("unexpected nil properties.primaryEndpoints.%s in response", svc)
+		}
+		return *endpoint, nil
+	}
+}
+
+func (opt DataPlaneOption) keyVaultDNSSuffix() (string, error) {
+	if opt.KeyVaultDNSSuffix != "" {
+		return opt.KeyVaultDNSSuffix, nil
+	}
+	switch strings.TrimSuffix(opt.Cloud.ActiveDirectoryAuthorityHost, "/") {
+	case "", strings.TrimSuffix(cloud.AzurePublic.ActiveDirectoryAuthorityHost, "/"):
+		return "vault.azure.net", nil
+	case strings.TrimSuffix(cloud.AzureChina.ActiveDirectoryAuthorityHost, "/"):
+		return "vault.azure.cn", nil
+	case strings.TrimSuffix(cloud.AzureGovernment.ActiveDirectoryAuthorityHost, "/"):
+		return "vault.usgovcloudapi.net", nil
+	default:
+		return "", fmt.Errorf("unknown key vault DNS suffix for the cloud with authority host %q", opt.Cloud.ActiveDirectoryAuthorityHost)
+	}
+}
+
+// keyVaultEndpointFunc returns the vault URI of the key vault, that the id belongs to.
+type keyVaultEndpointFunc func(id armid.ResourceId) (string, error)
+
+type keyVaultBuilderFunc func(keyVaultEndpointFunc, armid.ResourceId) (string, error)
+
+func keyVaultEndpointOffline(opt DataPlaneOption) keyVaultEndpointFunc {
+	return func(id armid.ResourceId) (string, error) {
+		suffix, err := opt.keyVaultDNSSuffix()
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("https://%s.%s/", id.Names()[0], suffix), nil
+	}
+}
+
+func keyVaultEndpointFromAPI(b *client.ClientBuilder) keyVaultEndpointFunc {
+	return func(id armid.ResourceId) (string, error) {
+		resourceGroupId := id.RootScope().(*armid.ResourceGroup)
+		client, err := b.NewKeyVaultVaultsClient(resourceGroupId.SubscriptionId)
+		if err != nil {
+			return "", err
+		}
+		resp, err := client.Get(b.Context(), resourceGroupId.Name, id.Names()[0], nil)
+		if err != nil {
+			return "", fmt.Errorf("retrieving %q: %v", id, err)
+		}
+		props := resp.Vault.Properties
+		if props == nil {
+			return "", fmt.Errorf("unexpected nil property in response")
+		}
+		puri := props.VaultURI
+		if puri == nil {
+			return "", fmt.Errorf("unexpected nil properties.vaultUri in response")
+		}
+		return *puri, nil
+	}
+}
@@ -3,10 +3,9 @@ package tfid
 import (
 	"encoding/base64"
 	"fmt"
+	"sort"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
-	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/magodo/armid"
 	"github.com/magodo/aztft/internal/client"
 	"github.com/magodo/aztft/internal/resmap"
@@ -15,33 +14,101 @@ import (
 type builderFunc func(*client.ClientBuilder, armid.ResourceId, string) (string, error)
 
 var dynamicBuilders = map[string]builderFunc{
-	"azurerm_active_directory_domain_service":                        buildActiveDirectoryDomainService,
-	"azurerm_storage_object_replication":                             buildStorageObjectReplication,
-	"azurerm_storage_queue":                                          buildStorageQueue,
-	"azurerm_storage_table":                                          buildStorageTable,
-	"azurerm_key_vault_key":                                          buildKeyVaultKey,
-	"azurerm_key_vault_secret":                                       buildKeyVaultSecret,
-	"azurerm_key_vault_certificate":                                  buildKeyVaultCertificate,
+	"azurerm_active_directory_domain_service": buildActiveDirectoryDomainService,
+	"azurerm_storage_object_replication":      buildStorageObjectReplication,
+	"azurerm_key_vault_key":                   buildKeyVaultKey,
+	"azurerm_key_vault_secret":                buildKeyVaultSecret,
+	"azurerm_key_vault_certificate":           buildKeyVaultCertificate,
+	"azurerm_api_management_api":              buildApiManagementApi,
+	"azurerm_automation_job_schedule":         buildAutomationJobSchedule,
+	"azurerm_app_configuration_key":           buildAppConfigurationKey,
+	"azurerm_app_configuration_feature":       buildAppConfigurationFeature,
+}
+
+// storageBuilders are the builders for the storage data plane resources, which only need to know the storage account's endpoints.
+// The endpoints are either derived offline, or retrieved via the Azure API.
+var storageBuilders = map[string]storageBuilderFunc{
+	"azurerm_storage_queue":                     buildStorageQueue,
+	"azurerm_storage_table":                     buildStorageTable,
+	"azurerm_storage_blob":                      buildStorageBlob,
+	"azurerm_storage_share_directory":           buildStorageShareDirectory,
+	"azurerm_storage_share_file":                buildStorageShareFile,
+	"azurerm_storage_table_entity":              buildStorageTableEntity,
+	"azurerm_storage_data_lake_gen2_filesystem": buildStorageDfs,
+	"azurerm_storage_data_lake_gen2_path":       buildStorageDfsPath,
+}
+
+// keyVaultBuilders are the builders for the key vault data plane resources, which only need to know the key vault's vault URI.
+// The vault URI is either derived offline, or retrieved via the Azure API.
+var keyVaultBuilders = map[string]keyVaultBuilderFunc{
 	"azurerm_key_vault_certificate_contacts":                         buildKeyVaultCertificateContacts,
 	"azurerm_key_vault_certificate_issuer":                           buildKeyVaultCertificateIssuer,
 	"azurerm_key_vault_managed_storage_account":                      buildKeyVaultStorageAccount,
 	"azurerm_key_vault_managed_storage_account_sas_token_definition": buildKeyVaultStorageAccountSasTokenDefinition,
-	"azurerm_storage_blob":                                           buildStorageBlob,
-	"azurerm_storage_share_directory":                                buildStorageShareDirectory,
-	"azurerm_storage_share_file":                                     buildStorageShareFile,
-	"azurerm_storage_table_entity":                                   buildStorageTableEntity,
-	"azurerm_storage_data_lake_gen2_filesystem":                      buildStorageDfs,
-	"azurerm_storage_data_lake_gen2_path":                            buildStorageDfsPath,
-	"azurerm_api_management_api":                                     buildApiManagementApi,
-	"azurerm_automation_job_schedule":                                buildAutomationJobSchedule,
+}
+
+// ReadActions are the ARM actions that DynamicBuild calls for each resource type, which are checked against the builders by TestReadActions.
+// The data plane resources only call the API to retrieve the endpoint when it is not built offline.
+var ReadActions = map[string][]string{
+	"azurerm_active_directory_domain_service": {"Microsoft.AAD/domainServices/read"},
+	"azurerm_storage_object_replication":      {},
+	"azurerm_key_vault_key":                   {"Microsoft.KeyVault/vaults/keys/read"},
+	"azurerm_key_vault_secret":                {"Microsoft.KeyVault/vaults/secrets/read"},
+	// The certificate is built via the key client, as it is a combination of a key and secret of the same name.
+	"azurerm_key_vault_certificate":     {"Microsoft.KeyVault/vaults/keys/read"},
+	"azurerm_api_management_api":        {"Microsoft.ApiManagement/service/apis/read"},
+	"azurerm_automation_job_schedule":   {"Microsoft.Automation/automationAccounts/jobSchedules/read"},
+	"azurerm_app_configuration_key":     {"Microsoft.AppConfiguration/configurationStores/read"},
+	"azurerm_app_configuration_feature": {"Microsoft.AppConfiguration/configurationStores/read"},
+
+	"azurerm_storage_queue":                     {"Microsoft.Storage/storageAccounts/read"},
+	"azurerm_storage_table":                     {"Microsoft.Storage/storageAccounts/read"},
+	"azurerm_storage_blob":                      {"Microsoft.Storage/storageAccounts/read"},
+	"azurerm_storage_share_directory":           {"Microsoft.Storage/storageAccounts/read"},
+	"azurerm_storage_share_file":                {"Microsoft.Storage/storageAccounts/read"},
+	"azurerm_storage_table_entity":              {"Microsoft.Storage/storageAccounts/read"},
+	"azurerm_storage_data_lake_gen2_filesystem": {"Microsoft.Storage/storageAccounts/read"},
+	"azurerm_storage_data_lake_gen2_path":       {"Microsoft.Storage/storageAccounts/read"},
+
+	"azurerm_key_vault_certificate_contacts":                         {"Microsoft.KeyVault/vaults/read"},
+	"azurerm_key_vault_certificate_issuer":                           {"Microsoft.KeyVault/vaults/read"},
+	"azurerm_key_vault_managed_storage_account":                      {"Microsoft.KeyVault/vaults/read"},
+	"azurerm_key_vault_managed_storage_account_sas_token_definition": {"Microsoft.KeyVault/vaults/read"},
+}
+
+// BuilderTypes returns the resource types that are built by DynamicBuild (and maybe OfflineBuild), instead of StaticBuild.
+func BuilderTypes() []string {
+	var out []string
+	for rt := range dynamicBuilders {
+		out = append(out, rt)
+	}
+	for rt := range storageBuilders {
+		out = append(out, rt)
+	}
+	for rt := range keyVaultBuilders {
+		out = append(out, rt)
+	}
+	sort.Strings(out)
+	return out
 }
 
 func NeedsAPI(rt string) bool {
-	_, ok := dynamicBuilders[rt]
+	if _, ok := dynamicBuilders[rt]; ok {
+		return true
+	}
+	return CanBuildOffline(rt)
+}
+
+// CanBuildOffline tells whether the resource type, though NeedsAPI, can also be built by OfflineBuild.
+func CanBuildOffline(rt string) bool {
+	if _, ok := storageBuilders[rt]; ok {
+		return true
+	}
+	_, ok := keyVaultBuilders[rt]
 	return ok
 }
 
-func DynamicBuild(id armid.ResourceId, rt string, cred azcore.TokenCredential, clientOpt arm.ClientOptions) (string, error) {
+func DynamicBuild(id armid.ResourceId, rt string, b *client.ClientBuilder) (string, error) {
 	id = id.Clone()
 
 	importSpec, err := GetImportSpec(id, rt)
@@ -49,17 +116,36 @@ func DynamicBuild(id armid.ResourceId, rt string, cred azcore.TokenCredential, c
 		return "", fmt.Errorf("getting import spec for %s as %s: %v", id, rt, err)
 	}
 
+	if builder, ok := storageBuilders[rt]; ok {
+		return builder(storageEndpointFromAPI(b), id)
+	}
+	if builder, ok := keyVaultBuilders[rt]; ok {
+		return builder(keyVaultEndpointFromAPI(b), id)
+	}
+
 	builder, ok := dynamicBuilders[rt]
 	if !ok {
 		return "", fmt.Errorf("unknown resource type: %q", rt)
 	}
 
-	b := &client.ClientBuilder{
-		Cred:      cred,
-		ClientOpt: clientOpt,
+	return builder(b, id, importSpec)
+}
+
+// OfflineBuild builds the id for the resource types that CanBuildOffline, without calling the Azure API.
+func OfflineBuild(id armid.ResourceId, rt string, opt DataPlaneOption) (string, error) {
+	id = id.Clone()
+
+	if _, err := GetImportSpec(id, rt); err != nil {
+		return "", fmt.Errorf("getting import spec for %s as %s: %v", id, rt, err)
 	}
 
-	return builder(b, id, importSpec)
+	if builder, ok := storageBuilders[rt]; ok {
+		return builder(storageEndpointOffline(opt), id)
+	}
+	if builder, ok := keyVaultBuilders[rt]; ok {
+		return builder(keyVaultEndpointOffline(opt), id)
+	}
+	return "", fmt.Errorf("resource type %q can't be built offline", rt)
 }
 
 func StaticBuild(id armid.ResourceId, rt string) (string, error) {
@@ -89,6 +175,20 @@ func StaticBuild(id armid.ResourceId, rt string) (string, error) {
 		id = id.ParentScope()
 		return id.String() + "|" + rid.Names()[0], nil
 
+	case "azurerm_chaos_studio_target",
+		"azurerm_chaos_studio_capability":
+		// input: <target resource id>/providers/Microsoft.Chaos/targets/target1[/capabilities/cap1]
+		// tfid : <normalized target resource id>/providers/Microsoft.Chaos/targets/target1[/capabilities/cap1]
+		if err := normalizeScopeResource(rid.AttrParentScope); err != nil {
+			return "", fmt.Errorf("normalizing the target resource of %q: %v", id.String(), err)
+		}
+		rid.AttrProvider = "Microsoft.Chaos"
+		rid.AttrTypes[0] = "targets"
+		if rt == "azurerm_chaos_studio_capability" {
+			rid.AttrTypes[1] = "capabilities"
+		}
+		return rid.String(), nil
+
 	case "azurerm_synapse_role_assignment":
 		pid := id.Parent()
 		if err := pid.Normalize(importSpec); err != nil {
@@ -104,6 +204,10 @@ func StaticBuild(id armid.ResourceId, rt string) (string, error) {
 		return managerId.String() + "/commit|" + id.Names()[1] + "|" + id.Names()[2], nil
 
 	// Porperty-like resources
+	case "azurerm_app_service_certificate_binding":
+		return buildIdForPropertyLikeResource(id.Parent(), lastItem(id.Names()), "azurerm_app_service_custom_hostname_binding", "azurerm_app_service_certificate", "|")
+	case "azurerm_communication_service_email_domain_association":
+		return buildIdForPropertyLikeResource(id.Parent(), lastItem(id.Names()), "azurerm_communication_service", "azurerm_email_communication_service_domain", "|")
 	case "azurerm_nat_gateway_public_ip_association":
 		return buildIdForPropertyLikeResource(id.Parent(), lastItem(id.Names()), "azurerm_nat_gateway", "azurerm_public_ip", "|")
 	case "azurerm_nat_gateway_public_ip_prefix_association":
@@ -118,6 +222,13 @@ func StaticBuild(id armid.ResourceId, rt string) (string, error) {
 		return buildIdForPropertyLikeResource(id.Parent(), lastItem(id.Names()), "fake_azurerm_network_interface_ipconfig", "azurerm_lb_nat_rule", "|")
 	case "azurerm_network_interface_security_group_association":
 		return buildIdForPropertyLikeResource(id.Parent(), lastItem(id.Names()), "azurerm_network_interface", "azurerm_network_security_group", "|")
+	case "azurerm_private_endpoint_application_security_group_association":
+		return buildIdForPropertyLikeResource(id.Parent(), lastItem(id.Names()), "azurerm_private_endpoint", "azurerm_application_security_group", "|")
+	case "azurerm_virtual_machine_gallery_application_assignment":
+		// The virtual machine can be either a linux or a windows one, which share the same import spec.
+		return buildIdForPropertyLikeResource(id.Parent(), lastItem(id.Names()), "azurerm_linux_virtual_machine", "azurerm_gallery_application_version", "|")
+	case "azurerm_virtual_desktop_scaling_plan_host_pool_association":
+		return buildIdForPropertyLikeResource(id.Parent(), lastItem(id.Names()), "azurerm_virtual_desktop_scaling_plan", "azurerm_virtual_desktop_host_pool", "|")
 	case "azurerm_virtual_desktop_workspace_application_group_association":
 		return buildIdForPropertyLikeResource(id.Parent(), lastItem(id.Names()), "azurerm_virtual_desktop_workspace", "azurerm_virtual_desktop_application_group", "|")
 	case "azurerm_role_management_policy":
@@ -209,6 +320,34 @@ func GetImportSpec(id armid.ResourceId, rt string) (string, error) {
 	}
 }
 
+// normalizeScopeResource normalizes the resource that is the scope of an extension resource, through the import spec of its own resource type.
+// The scope is left as is if it is not a resource, or its resource type is unknown, or none of the import specs applies.
+func normalizeScopeResource(scope armid.ResourceId) error {
+	if _, ok := scope.(*armid.ScopedResourceId); !ok {
+		return nil
+	}
+	resmap.Init()
+	b, ok := resmap.ARMId2TFMap[strings.ToUpper(scope.RouteScopeString())]
+	if !ok {
+		return nil
+	}
+	l, ok := b[strings.ToUpper(scope.ParentScope().ScopeString())]
+	if !ok {
+		l = b[strings.ToUpper(resmap.ScopeAny)]
+	}
+	for _, item := range l {
+		if item.ImportSpec == "" {
+			continue
+		}
+		// Normalizing a clone first, as the import spec might be of a different resource (e.g. the parent resource), which fails to apply.
+		if err := scope.Clone().Normalize(item.ImportSpec); err != nil {
+			continue
+		}
+		return scope.Normalize(item.ImportSpec)
+	}
+	return nil
+}
+
 func buildIdForPropertyLikeResource(mainId armid.ResourceId, secondaryIdEnc string, mainRt, propRt, sep string) (string, error) {
 	mainTFId, err := StaticBuild(mainId, mainRt)
 	if err != nil {
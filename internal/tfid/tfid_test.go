@@ -0,0 +1,44 @@
+package tfid
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/magodo/aztft/internal/client/clienttest"
+	"github.com/magodo/aztft/internal/exampleid"
+	"github.com/magodo/aztft/internal/resmap"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReadActions runs DynamicBuild of each resource type against an empty response of every request, to check the ARM actions it calls are declared in ReadActions.
+// The builders may fail to build from the empty response, but the requests made until then are checked. The data plane requests are not ARM actions.
+func TestReadActions(t *testing.T) {
+	resmap.Init()
+	for _, rt := range BuilderTypes() {
+		rt := rt
+		t.Run(rt, func(t *testing.T) {
+			declared := map[string]bool{}
+			for _, action := range ReadActions[rt] {
+				declared[strings.ToLower(action)] = true
+			}
+
+			transport := &clienttest.Transport{Default: "{}"}
+			DynamicBuild(exampleid.DefaultId(rt), rt, clienttest.NewClientBuilder(transport))
+
+			var actions []string
+			for _, req := range transport.Requests() {
+				action := clienttest.ReadAction(req)
+				if action == "" {
+					continue
+				}
+				require.True(t, declared[strings.ToLower(action)], "%s %s calls the undeclared action %q", req.Method, req.URL, action)
+				actions = append(actions, action)
+			}
+			if len(declared) == 0 {
+				require.Empty(t, actions, "the builder calls API, but declares no read action")
+			} else {
+				require.NotEmpty(t, actions, "the builder declares read actions, but calls no API")
+			}
+		})
+	}
+}
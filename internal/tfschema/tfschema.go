@@ -0,0 +1,67 @@
+package tfschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/magodo/aztft/internal/loadonce"
+)
+
+// ResourceTypes is the set of the resource types of the AzureRM provider schema.
+type ResourceTypes map[string]bool
+
+// List returns the resource types, sorted.
+func (rts ResourceTypes) List() []string {
+	var out []string
+	for rt := range rts {
+		out = append(out, rt)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// schemaFiles caches the resource types of each loaded schema file.
+var schemaFiles loadonce.Files[ResourceTypes]
+
+// providerSchemas is the subset of the output of "terraform providers schema -json" that is used.
+type providerSchemas struct {
+	ProviderSchemas map[string]struct {
+		ResourceSchemas map[string]json.RawMessage `json:"resource_schemas"`
+	} `json:"provider_schemas"`
+}
+
+// Load loads the resource types of the AzureRM provider from the file, which is the output of "terraform providers schema -json".
+// The provider is identified by its source address ending with "/azurerm", so that a provider fork under another namespace also works.
+// The file is only parsed by the first call, whose result is reused by the later queries.
+func Load(path string) (ResourceTypes, error) {
+	return schemaFiles.Load(path, load)
+}
+
+func load(path string) (ResourceTypes, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading provider schema file %s: %v", path, err)
+	}
+	var schemas providerSchemas
+	if err := json.Unmarshal(b, &schemas); err != nil {
+		return nil, fmt.Errorf("unmarshalling provider schema file %s: %v", path, err)
+	}
+	var found bool
+	rts := ResourceTypes{}
+	for addr, schema := range schemas.ProviderSchemas {
+		if !strings.HasSuffix(addr, "/azurerm") {
+			continue
+		}
+		found = true
+		for rt := range schema.ResourceSchemas {
+			rts[rt] = true
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("no azurerm provider found in the provider schema file %s", path)
+	}
+	return rts, nil
+}
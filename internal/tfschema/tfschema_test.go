@@ -0,0 +1,85 @@
+package tfschema
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeSchemaFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "schema.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	cases := []struct {
+		name   string
+		schema string
+		expect []string
+		err    string
+	}{
+		{
+			name: "official provider",
+			schema: `{"provider_schemas": {
+				"registry.terraform.io/hashicorp/azurerm": {"resource_schemas": {"azurerm_resource_group": {}}}
+			}}`,
+			expect: []string{"azurerm_resource_group"},
+		},
+		{
+			name: "provider fork under another namespace",
+			schema: `{"provider_schemas": {
+				"registry.terraform.io/myorg/azurerm": {"resource_schemas": {"azurerm_resource_group": {}, "azurerm_private_resource": {}}}
+			}}`,
+			expect: []string{"azurerm_private_resource", "azurerm_resource_group"},
+		},
+		{
+			name: "other providers are ignored",
+			schema: `{"provider_schemas": {
+				"registry.terraform.io/hashicorp/azurerm": {"resource_schemas": {"azurerm_resource_group": {}}},
+				"registry.terraform.io/hashicorp/azuread": {"resource_schemas": {"azuread_user": {}}},
+				"registry.terraform.io/myorg/notazurerm": {"resource_schemas": {"notazurerm_resource": {}}}
+			}}`,
+			expect: []string{"azurerm_resource_group"},
+		},
+		{
+			name: "no azurerm provider",
+			schema: `{"provider_schemas": {
+				"registry.terraform.io/hashicorp/azuread": {"resource_schemas": {"azuread_user": {}}}
+			}}`,
+			err: "no azurerm provider found",
+		},
+		{
+			name:   "invalid schema",
+			schema: `{`,
+			err:    "unmarshalling provider schema file",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			rts, err := Load(writeSchemaFile(t, tt.schema))
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expect, rts.List())
+		})
+	}
+}
+
+func TestLoadOnce(t *testing.T) {
+	path := writeSchemaFile(t, `{"provider_schemas": {"registry.terraform.io/hashicorp/azurerm": {"resource_schemas": {"azurerm_resource_group": {}}}}}`)
+	rts, err := Load(path)
+	require.NoError(t, err)
+	require.Equal(t, []string{"azurerm_resource_group"}, rts.List())
+
+	// The later loads return the result of the first load, even if the file has changed.
+	require.NoError(t, os.WriteFile(path, []byte(`{`), 0644))
+	rts, err = Load(path)
+	require.NoError(t, err)
+	require.Equal(t, []string{"azurerm_resource_group"}, rts.List())
+}
@@ -9,37 +9,42 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/magodo/aztft/aztft"
+	"github.com/magodo/aztft/internal/cloudenv"
 	"github.com/urfave/cli/v2"
 )
 
 func main() {
 	var (
-		flagEnvironment    string
+		optFlags           optionFlags
 		flagSubscriptionId string
-		flagAPI            bool
 		flagImport         bool
+		flagDataSource     bool
 	)
 
 	app := &cli.App{
 		Name:      "aztft",
 		Version:   getVersion(),
 		Usage:     "Find Azure resource's Terraform AzureRM provider resource type or/and id, together with any property-like resources, by its Azure resource ID",
-		UsageText: "aztft [option] <ID>",
+		UsageText: "aztft [option] <ID>\n\naztft [option] serve [--listen <addr>]\n\naztft [option] permissions [<TF type>...]\n\naztft --provider-schema <file> [option] schema-gaps",
 		Flags: []cli.Flag{
 			&cli.StringFlag{
 				Name:        "env",
-				EnvVars:     []string{"AZTFT_ENV"},
-				Usage:       `The environment. Can be one of "public", "china", "usgovernment".`,
-				Destination: &flagEnvironment,
+				EnvVars:     []string{"AZTFT_ENV", "ARM_ENVIRONMENT"},
+				Usage:       `The environment. Can be one of "public", "china", "usgovernment", "custom". The ARM_METADATA_HOSTNAME, if set, implies "custom" with the metadata from that host, unless "--cloud-config" is specified`,
+				Destination: &optFlags.environment,
 				Value:       "public",
 			},
+			&cli.StringFlag{
+				Name:        "cloud-config",
+				EnvVars:     []string{"AZTFT_CLOUD_CONFIG"},
+				Usage:       `The ARM metadata endpoint URL, or a local file of the same content, that describes the custom environment. Only used when "--env" is "custom"`,
+				Destination: &optFlags.cloudConfig,
+			},
 			&cli.StringFlag{
 				Name:        "subscription-id",
 				EnvVars:     []string{"AZTFT_SUBSCRIPTION_ID", "ARM_SUBSCRIPTION_ID"},
 				Aliases:     []string{"s"},
-				Required:    true,
 				Usage:       "The subscription id",
 				Destination: &flagSubscriptionId,
 			},
@@ -47,9 +52,39 @@ func main() {
 				Name:        "api",
 				EnvVars:     []string{"AZTFT_API"},
 				Usage:       `Allow to use Azure API to disambiguate matching results (e.g. whether a VM is a Linux VM or Windows VM)`,
-				Destination: &flagAPI,
+				Destination: &optFlags.api,
 				Value:       false,
 			},
+			&cli.StringFlag{
+				Name:        "auth",
+				EnvVars:     []string{"AZTFT_AUTH"},
+				Usage:       fmt.Sprintf(`The authentication method, used together with "--api". Can be one of %q. If not specified, it is determined by the same "ARM_*" environment variables as the Terraform AzureRM provider, falling back to "cli" (unless ARM_USE_CLI is false)`, authMethods),
+				Destination: &optFlags.auth,
+			},
+			&cli.StringFlag{
+				Name:        "tenant-mapping-file",
+				EnvVars:     []string{"AZTFT_TENANT_MAPPING_FILE"},
+				Usage:       `The JSON file that maps from the subscription id to its tenant, and from the tenant id to its authentication method, used together with "--api" to access the subscriptions in other tenants`,
+				Destination: &optFlags.tenantMapping,
+			},
+			&cli.StringFlag{
+				Name:        "mapping-file",
+				EnvVars:     []string{"AZTFT_MAPPING_FILE"},
+				Usage:       `The JSON file of the extra resource mappings (e.g. for a private provider fork), in the same format as the built-in mapping. The entries can't overlap with the routing scopes of the existing resource types, unless "allow_overlap" is set`,
+				Destination: &optFlags.mappingFile,
+			},
+			&cli.StringFlag{
+				Name:        "provider-schema",
+				EnvVars:     []string{"AZTFT_PROVIDER_SCHEMA"},
+				Usage:       `The output of "terraform providers schema -json" of the provider version in use. The resource types that don't exist in it are flagged`,
+				Destination: &optFlags.providerSchema,
+			},
+			&cli.BoolFlag{
+				Name:        "filter-by-schema",
+				EnvVars:     []string{"AZTFT_FILTER_BY_SCHEMA"},
+				Usage:       `Filter out the resource types that don't exist in the provider schema, instead of flagging them. Used together with "--provider-schema"`,
+				Destination: &optFlags.filterBySchema,
+			},
 			&cli.BoolFlag{
 				Name:        "import",
 				EnvVars:     []string{"AZTFT_IMPORT"},
@@ -57,6 +92,45 @@ func main() {
 				Destination: &flagImport,
 				Value:       false,
 			},
+			&cli.BoolFlag{
+				Name:        "data-source",
+				EnvVars:     []string{"AZTFT_DATA_SOURCE"},
+				Usage:       `Print the TF data source blocks that read the resource, instead of the resource types`,
+				Destination: &flagDataSource,
+				Value:       false,
+			},
+			&cli.StringFlag{
+				Name:        "storage-dns-zone",
+				EnvVars:     []string{"AZTFT_STORAGE_DNS_ZONE"},
+				Usage:       `The DNS zone (e.g. "z24") of the storage accounts that use the Azure DNS zone endpoints. Used to build the ids of the storage data plane resources`,
+				Destination: &optFlags.storageDNSZone,
+			},
+			&cli.BoolFlag{
+				Name:        "data-plane-endpoint-from-api",
+				EnvVars:     []string{"AZTFT_DATA_PLANE_ENDPOINT_FROM_API"},
+				Usage:       `Retrieve the data plane endpoints (e.g. storage account endpoints) via Azure API (requires "--api"), instead of deriving them from the environment, to build the ids of the data plane resources`,
+				Destination: &optFlags.dataPlaneEndpointFromAPI,
+				Value:       false,
+			},
+			&cli.BoolFlag{
+				Name:        "extensions",
+				EnvVars:     []string{"AZTFT_EXTENSIONS"},
+				Usage:       `Also list the extension resources (e.g. diagnostic settings, management locks, role assignments) scoped to the resource (requires "--api")`,
+				Destination: &optFlags.extensions,
+				Value:       false,
+			},
+		},
+		Before: func(ctx *cli.Context) error {
+			// Load the extra mappings before running any command, so that an invalid mapping file fails fast.
+			if optFlags.mappingFile == "" {
+				return nil
+			}
+			return aztft.LoadMappingFile(optFlags.mappingFile)
+		},
+		Commands: []*cli.Command{
+			newServeCommand(&optFlags),
+			newPermissionsCommand(&optFlags, &flagSubscriptionId),
+			newSchemaGapsCommand(&optFlags),
 		},
 		Action: func(ctx *cli.Context) error {
 			if ctx.NArg() == 0 {
@@ -66,80 +140,59 @@ func main() {
 				return fmt.Errorf("More than one IDs specified")
 			}
 
-			var opt *aztft.APIOption
-			if flagAPI {
-				cloudCfg := cloud.AzurePublic
-				switch strings.ToLower(flagEnvironment) {
-				case "public":
-					cloudCfg = cloud.AzurePublic
-				case "usgovernment":
-					cloudCfg = cloud.AzureGovernment
-				case "china":
-					cloudCfg = cloud.AzureChina
-				default:
-					return fmt.Errorf("unknown environment specified: %q", flagEnvironment)
-				}
+			if flagImport && flagDataSource {
+				return fmt.Errorf(`"--import" and "--data-source" are mutually exclusive`)
+			}
 
-				if v, ok := os.LookupEnv("ARM_TENANT_ID"); ok {
-					os.Setenv("AZURE_TENANT_ID", v)
-				}
-				if v, ok := os.LookupEnv("ARM_CLIENT_ID"); ok {
-					os.Setenv("AZURE_CLIENT_ID", v)
-				}
-				if v, ok := os.LookupEnv("ARM_CLIENT_SECRET"); ok {
-					os.Setenv("AZURE_CLIENT_SECRET", v)
-				}
-				if v, ok := os.LookupEnv("ARM_CLIENT_CERTIFICATE_PATH"); ok {
-					os.Setenv("AZURE_CLIENT_CERTIFICATE_PATH", v)
-				}
+			if flagSubscriptionId == "" {
+				return fmt.Errorf(`Required flag "subscription-id" not set`)
+			}
 
-				clientOpt := arm.ClientOptions{
-					ClientOptions: policy.ClientOptions{
-						Cloud: cloudCfg,
-						Telemetry: policy.TelemetryOptions{
-							ApplicationID: "aztft",
-							Disabled:      false,
-						},
-						Logging: policy.LogOptions{
-							IncludeBody: true,
-						},
-					},
-				}
+			opt, err := optFlags.buildAPIOption()
+			if err != nil {
+				return err
+			}
 
-				cred, err := azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{
-					ClientOptions: clientOpt.ClientOptions,
-					TenantID:      os.Getenv("ARM_TENANT_ID"),
-				})
+			id, err := aztft.NormalizeId(ctx.Args().First())
+			if err != nil {
+				return err
+			}
+			var output []string
+			switch {
+			case flagDataSource:
+				dataSources, _, err := aztft.QueryDataSource(id, opt)
 				if err != nil {
-					return fmt.Errorf("failed to obtain a credential: %v", err)
+					log.Fatal(err)
 				}
-
-				opt = &aztft.APIOption{
-					Cred:         cred,
-					ClientOption: clientOpt,
+				for _, ds := range dataSources {
+					output = append(output, ds.HCL("example"))
 				}
-			}
-
-			id := ctx.Args().First()
-			var output []string
-			if flagImport {
+			case flagImport:
 				types, ids, _, err := aztft.QueryTypeAndId(id, opt)
 				if err != nil {
 					log.Fatal(err)
 				}
 				for i := 0; i < len(types); i++ {
-					output = append(output, fmt.Sprintf("terraform import %s.example %s", types[i].TFType, ids[i]))
+					output = append(output, fmt.Sprintf("terraform import %s.example %s", types[i].TFType, ids[i])+notInSchemaNote(types[i]))
 				}
-			} else {
+			default:
 				rts, _, err := aztft.QueryType(id, opt)
 				if err != nil {
 					log.Fatal(err)
 				}
 				for _, t := range rts {
-					output = append(output, t.TFType)
+					output = append(output, t.TFType+notInSchemaNote(t))
 				}
 			}
+			if len(output) == 0 && flagDataSource {
+				fmt.Println("No data source")
+				return nil
+			}
 			if len(output) == 0 {
+				if suggestion := aztft.SuggestRouteScope(id); suggestion != "" {
+					fmt.Printf("No match (did you mean the resource type %s?)\n", suggestion)
+					return nil
+				}
 				fmt.Println("No match")
 				return nil
 			}
@@ -156,3 +209,120 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// optionFlags are the flags that are used to build the aztft.APIOption.
+type optionFlags struct {
+	environment   string
+	cloudConfig   string
+	api           bool
+	auth          string
+	tenantMapping string
+
+	storageDNSZone           string
+	dataPlaneEndpointFromAPI bool
+
+	extensions bool
+
+	mappingFile string
+
+	providerSchema string
+	filterBySchema bool
+}
+
+func (f optionFlags) buildAPIOption() (*aztft.APIOption, error) {
+	if f.dataPlaneEndpointFromAPI && !f.api {
+		return nil, fmt.Errorf(`"--data-plane-endpoint-from-api" requires "--api"`)
+	}
+	if f.extensions && !f.api {
+		return nil, fmt.Errorf(`"--extensions" requires "--api"`)
+	}
+	if f.filterBySchema && f.providerSchema == "" {
+		return nil, fmt.Errorf(`"--filter-by-schema" requires "--provider-schema"`)
+	}
+
+	var (
+		cloudCfg              = cloud.AzurePublic
+		storageEndpointSuffix string
+		keyVaultDNSSuffix     string
+	)
+	environment, cloudConfig := f.environment, f.cloudConfig
+	// As the Terraform AzureRM provider, the metadata host (e.g. of the Azure Stack Hub) takes precedence over the environment name.
+	if host := os.Getenv("ARM_METADATA_HOSTNAME"); host != "" && cloudConfig == "" {
+		environment, cloudConfig = "custom", "https://"+host
+	}
+	switch strings.ToLower(environment) {
+	case "public":
+		cloudCfg = cloud.AzurePublic
+	case "usgovernment":
+		cloudCfg = cloud.AzureGovernment
+	case "china":
+		cloudCfg = cloud.AzureChina
+	case "custom":
+		if cloudConfig == "" {
+			return nil, fmt.Errorf(`"--cloud-config" (or ARM_METADATA_HOSTNAME) is required for the custom environment`)
+		}
+		env, err := cloudenv.Load(cloudConfig)
+		if err != nil {
+			return nil, fmt.Errorf("loading the custom environment: %v", err)
+		}
+		cloudCfg = env.Cloud
+		storageEndpointSuffix = env.StorageEndpointSuffix
+		keyVaultDNSSuffix = env.KeyVaultDNSSuffix
+	default:
+		return nil, fmt.Errorf("unknown environment specified: %q", environment)
+	}
+
+	clientOpt := arm.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Cloud: cloudCfg,
+			Telemetry: policy.TelemetryOptions{
+				ApplicationID: "aztft",
+				Disabled:      false,
+			},
+			Logging: policy.LogOptions{
+				IncludeBody: true,
+			},
+		},
+	}
+
+	opt := &aztft.APIOption{
+		ClientOption:             clientOpt,
+		StorageDNSZone:           f.storageDNSZone,
+		StorageEndpointSuffix:    storageEndpointSuffix,
+		KeyVaultDNSSuffix:        keyVaultDNSSuffix,
+		DataPlaneEndpointFromAPI: f.dataPlaneEndpointFromAPI,
+		ProviderSchemaFile:       f.providerSchema,
+		FilterBySchema:           f.filterBySchema,
+		PopulateExtensions:       f.extensions,
+	}
+
+	if f.api {
+		authCfg, err := authConfigFromEnv()
+		if err != nil {
+			return nil, fmt.Errorf("reading the authentication configuration: %v", err)
+		}
+		cred, err := buildCredential(strings.ToLower(f.auth), *authCfg, clientOpt.ClientOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to obtain a credential: %v", err)
+		}
+
+		opt.Cred = cred
+
+		if f.tenantMapping != "" {
+			m, err := loadTenantMapping(f.tenantMapping)
+			if err != nil {
+				return nil, fmt.Errorf("loading the tenant mapping file: %v", err)
+			}
+			opt.CredResolver = newCredentialResolver(m, cred, strings.ToLower(f.auth), *authCfg, clientOpt.ClientOptions)
+		}
+	}
+
+	return opt, nil
+}
+
+func notInSchemaNote(t aztft.Type) string {
+	if t.NotInSchema {
+		return " (not in the provider schema)"
+	}
+	return ""
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildAPIOptionEnvironment(t *testing.T) {
+	metadataFile := filepath.Join(t.TempDir(), "metadata.json")
+	require.NoError(t, os.WriteFile(metadataFile, []byte(`{
+  "name": "MyCloud",
+  "resourceManager": "https://management.mycloud.example/",
+  "authentication": {
+    "loginEndpoint": "https://login.mycloud.example/",
+    "audiences": ["https://management.mycloud.example/"]
+  },
+  "suffixes": {
+    "storage": "core.mycloud.example",
+    "keyVaultDns": "vault.mycloud.example"
+  }
+}`), 0644))
+
+	cases := []struct {
+		name           string
+		flags          optionFlags
+		metadataHost   string
+		expectEndpoint string
+		err            string
+	}{
+		{
+			name:           "well-known environment",
+			flags:          optionFlags{environment: "china"},
+			expectEndpoint: cloud.AzureChina.Services[cloud.ResourceManager].Endpoint,
+		},
+		{
+			name:           "custom environment",
+			flags:          optionFlags{environment: "custom", cloudConfig: metadataFile},
+			expectEndpoint: "https://management.mycloud.example/",
+		},
+		{
+			name:         "metadata host implies custom environment",
+			flags:        optionFlags{environment: "public"},
+			metadataHost: "127.0.0.1:1",
+			err:          "fetching metadata from https://127.0.0.1:1",
+		},
+		{
+			name:           "cloud config takes precedence over metadata host",
+			flags:          optionFlags{environment: "custom", cloudConfig: metadataFile},
+			metadataHost:   "127.0.0.1:1",
+			expectEndpoint: "https://management.mycloud.example/",
+		},
+		{
+			name:  "custom environment without cloud config",
+			flags: optionFlags{environment: "custom"},
+			err:   `"--cloud-config" (or ARM_METADATA_HOSTNAME) is required`,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ARM_METADATA_HOSTNAME", tt.metadataHost)
+			opt, err := tt.flags.buildAPIOption()
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectEndpoint, opt.ClientOption.Cloud.Services[cloud.ResourceManager].Endpoint)
+		})
+	}
+}
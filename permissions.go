@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/magodo/aztft/aztft"
+	"github.com/urfave/cli/v2"
+)
+
+func newPermissionsCommand(optFlags *optionFlags, flagSubscriptionId *string) *cli.Command {
+	var (
+		flagFromFile         string
+		flagName             string
+		flagAssignableScopes cli.StringSlice
+	)
+	return &cli.Command{
+		Name:      "permissions",
+		Usage:     "Generate a least-privilege custom role definition to query (with Azure API) and import the specified resource types",
+		UsageText: "aztft [option] permissions [--from-file <file>] [--name <name>] [--assignable-scope <scope>]... [<TF type>...]",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "from-file",
+				Usage:       `A scan result file of the Azure resource IDs, one per line, whose matching resource types are included. Lines that are empty or start with "#" are ignored`,
+				Destination: &flagFromFile,
+			},
+			&cli.StringFlag{
+				Name:        "name",
+				Usage:       "The name of the role definition",
+				Destination: &flagName,
+				Value:       "aztft importer",
+			},
+			&cli.StringSliceFlag{
+				Name:        "assignable-scope",
+				Usage:       `The assignable scope of the role definition. Defaults to the subscription specified by "--subscription-id"`,
+				Destination: &flagAssignableScopes,
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			rts := ctx.Args().Slice()
+			if flagFromFile != "" {
+				l, err := scanResultTypes(flagFromFile)
+				if err != nil {
+					return err
+				}
+				rts = append(rts, l...)
+			}
+			if len(rts) == 0 {
+				return fmt.Errorf(`No resource type specified, neither as arguments nor via "--from-file"`)
+			}
+
+			scopes := flagAssignableScopes.Value()
+			if len(scopes) == 0 {
+				if *flagSubscriptionId == "" {
+					return fmt.Errorf(`Either "--assignable-scope" or "--subscription-id" is required`)
+				}
+				scopes = []string{"/subscriptions/" + *flagSubscriptionId}
+			}
+
+			perms, err := aztft.QueryPermissions(rts, &aztft.APIOption{
+				DataPlaneEndpointFromAPI: optFlags.dataPlaneEndpointFromAPI,
+				PopulateExtensions:       optFlags.extensions,
+			})
+			if err != nil {
+				return err
+			}
+
+			b, err := json.MarshalIndent(roleDefinition{
+				Name:             flagName,
+				IsCustom:         true,
+				Description:      fmt.Sprintf("Read the Azure resources of %d resource types to query and import them", len(uniqueSorted(rts))),
+				Actions:          perms.Actions,
+				NotActions:       []string{},
+				DataActions:      perms.DataActions,
+				NotDataActions:   []string{},
+				AssignableScopes: scopes,
+			}, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(b))
+			return nil
+		},
+	}
+}
+
+// roleDefinition is the custom role definition, in the format accepted by "az role definition create".
+type roleDefinition struct {
+	Name             string
+	IsCustom         bool
+	Description      string
+	Actions          []string
+	NotActions       []string
+	DataActions      []string
+	NotDataActions   []string
+	AssignableScopes []string
+}
+
+// scanResultTypes returns the TF resource types that match the Azure resource IDs in the scan result file, without calling Azure API.
+// The ambiguous resource IDs result in all the candidate types, as the resolver that disambiguates them is covered by any of them.
+func scanResultTypes(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var rts []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		types, _, err := aztft.QueryType(line, nil)
+		if err != nil {
+			return nil, fmt.Errorf("querying type of %s: %v", line, err)
+		}
+		if len(types) == 0 {
+			return nil, fmt.Errorf("no resource type matches %s", line)
+		}
+		for _, t := range types {
+			rts = append(rts, t.TFType)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading %s: %v", path, err)
+	}
+	return rts, nil
+}
+
+func uniqueSorted(l []string) []string {
+	m := map[string]bool{}
+	var out []string
+	for _, s := range l {
+		if !m[s] {
+			m[s] = true
+			out = append(out, s)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/magodo/aztft/aztft"
+	"github.com/urfave/cli/v2"
+)
+
+func newSchemaGapsCommand(optFlags *optionFlags) *cli.Command {
+	return &cli.Command{
+		Name:      "schema-gaps",
+		Usage:     `List the resource types that exist in the provider schema (specified by "--provider-schema"), but are missing from the mapping`,
+		UsageText: "aztft --provider-schema <file> [option] schema-gaps",
+		Action: func(ctx *cli.Context) error {
+			if ctx.NArg() != 0 {
+				return fmt.Errorf("schema-gaps doesn't accept any argument")
+			}
+			if optFlags.providerSchema == "" {
+				return fmt.Errorf(`"--provider-schema" is required`)
+			}
+			rts, err := aztft.MissingTypes(&aztft.APIOption{
+				ProviderSchemaFile: optFlags.providerSchema,
+			})
+			if err != nil {
+				return err
+			}
+			for _, rt := range rts {
+				fmt.Println(rt)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,389 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/aztft"
+	"github.com/magodo/aztft/internal/populate"
+	"github.com/magodo/aztft/internal/resmap"
+	"github.com/magodo/aztft/internal/tfid"
+	"github.com/urfave/cli/v2"
+)
+
+// maxRequestBodySize limits the size of the request body, which only contains resource ids.
+const maxRequestBodySize = 1 << 20
+
+func newServeCommand(optFlags *optionFlags) *cli.Command {
+	var (
+		flagListen  string
+		flagTimeout time.Duration
+	)
+	return &cli.Command{
+		Name:      "serve",
+		Usage:     "Serve the queries as a JSON API over HTTP",
+		UsageText: "aztft [option] serve [--listen <addr>] [--timeout <duration>]",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "listen",
+				EnvVars:     []string{"AZTFT_LISTEN"},
+				Usage:       "The address to listen on",
+				Destination: &flagListen,
+				Value:       ":8080",
+			},
+			&cli.DurationFlag{
+				Name:        "timeout",
+				EnvVars:     []string{"AZTFT_REQUEST_TIMEOUT"},
+				Usage:       "The timeout of each request",
+				Destination: &flagTimeout,
+				Value:       time.Minute,
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			if ctx.NArg() != 0 {
+				return fmt.Errorf("serve doesn't accept any argument")
+			}
+			opt, err := optFlags.buildAPIOption()
+			if err != nil {
+				return err
+			}
+
+			// Parse the mapping once before serving, instead of on the first request. The extensions are already loaded before running any command.
+			resmap.Init()
+
+			srv := &http.Server{
+				Addr:              flagListen,
+				Handler:           newServeHandler(opt, flagTimeout),
+				ReadHeaderTimeout: 10 * time.Second,
+			}
+
+			sigCtx, stop := signal.NotifyContext(ctx.Context, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			go func() {
+				<-sigCtx.Done()
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), flagTimeout)
+				defer cancel()
+				// nolint:errcheck
+				srv.Shutdown(shutdownCtx)
+			}()
+
+			log.Printf("Listening on %s", flagListen)
+			if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
+// server serves the aztft queries. The same APIOption (and thus the credentials, and the Azure API clients shared by ShareClients) is shared among all the requests.
+type server struct {
+	opt *aztft.APIOption
+}
+
+// requestOption returns a copy of the shared APIOption for the request, whose Azure API calls are made with the request's context,
+// so that they are cancelled together with the request (e.g. on timeout). The failures of these calls are recorded by the returned upstreamRecorder.
+func (s server) requestOption(r *http.Request) (*aztft.APIOption, *upstreamRecorder) {
+	rec := &upstreamRecorder{}
+	opt := *s.opt
+	opt.Context = context.WithValue(r.Context(), upstreamRecorderKey{}, rec)
+	return &opt, rec
+}
+
+type upstreamRecorderKey struct{}
+
+// upstreamPolicy records the failures of the Azure API calls (including the authentication) to the upstreamRecorder of the request's context, if any.
+// It is part of the pipelines shared by all the requests.
+type upstreamPolicy struct{}
+
+func (upstreamPolicy) Do(req *policy.Request) (*http.Response, error) {
+	resp, err := req.Next()
+	if rec, ok := req.Raw().Context().Value(upstreamRecorderKey{}).(*upstreamRecorder); ok && (err != nil || resp.StatusCode >= http.StatusBadRequest) {
+		rec.failed.Store(true)
+	}
+	return resp, err
+}
+
+// upstreamRecorder records whether any of the Azure API calls of a request has failed.
+type upstreamRecorder struct {
+	failed atomic.Bool
+}
+
+// errorCode returns the status code of a query error, which happens after the input is validated.
+// It is a bad gateway if any of the Azure API calls has failed, otherwise an internal server error.
+func (rec *upstreamRecorder) errorCode() int {
+	if rec.failed.Load() {
+		return http.StatusBadGateway
+	}
+	return http.StatusInternalServerError
+}
+
+func newServeHandler(opt *aztft.APIOption, timeout time.Duration) http.Handler {
+	sharedOpt := *opt
+	sharedOpt.ClientOption.PerCallPolicies = append(append([]policy.Policy{}, opt.ClientOption.PerCallPolicies...), upstreamPolicy{})
+	sharedOpt.ShareClients()
+	s := server{opt: &sharedOpt}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", s.handleHealth)
+	mux.Handle("/query-type", postOnly(s.handleQueryType))
+	mux.Handle("/query-id", postOnly(s.handleQueryId))
+	mux.Handle("/query-type-and-id", postOnly(s.handleQueryTypeAndId))
+	mux.Handle("/batch", postOnly(s.handleBatch))
+	mux.HandleFunc("/describe-type", s.handleDescribeType)
+
+	return http.TimeoutHandler(mux, timeout, `{"error":"request timeout"}`)
+}
+
+type queryRequest struct {
+	Id string `json:"id"`
+	// Type is the TF resource type, only used by the query-id endpoint.
+	Type string `json:"type,omitempty"`
+}
+
+type queryResult struct {
+	AzureId string `json:"azure_id"`
+	TFType  string `json:"tf_type"`
+	TFId    string `json:"tf_id,omitempty"`
+	// NotInSchema indicates the TF resource type doesn't exist in the provider schema, if specified.
+	NotInSchema bool `json:"not_in_schema,omitempty"`
+}
+
+type queryResponse struct {
+	Id      string        `json:"id,omitempty"`
+	Results []queryResult `json:"results"`
+	Exact   bool          `json:"exact"`
+	Error   string        `json:"error,omitempty"`
+}
+
+type batchRequest struct {
+	Ids []string `json:"ids"`
+	// WithId indicates to also query the TF resource id of each result.
+	WithId bool `json:"with_id,omitempty"`
+}
+
+type batchResponse struct {
+	Items []queryResponse `json:"items"`
+}
+
+type typeDescription struct {
+	Type             string   `json:"type"`
+	Provider         string   `json:"provider,omitempty"`
+	Types            []string `json:"types,omitempty"`
+	Scopes           []string `json:"scopes,omitempty"`
+	ImportSpecs      []string `json:"import_specs,omitempty"`
+	IsRemoved        bool     `json:"is_removed"`
+	IdNeedsAPI       bool     `json:"id_needs_api"`
+	IdOffline        bool     `json:"id_offline"`
+	PopulateNeedsAPI bool     `json:"populate_needs_api"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func (s server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func (s server) handleQueryType(w http.ResponseWriter, r *http.Request) {
+	var req queryRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	resp, code := s.query(r, req.Id, false)
+	if resp.Error != "" {
+		writeError(w, code, resp.Error)
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s server) handleQueryTypeAndId(w http.ResponseWriter, r *http.Request) {
+	var req queryRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	resp, code := s.query(r, req.Id, true)
+	if resp.Error != "" {
+		writeError(w, code, resp.Error)
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s server) handleQueryId(w http.ResponseWriter, r *http.Request) {
+	var req queryRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	if req.Type == "" {
+		writeError(w, http.StatusBadRequest, `"type" is required`)
+		return
+	}
+	azureId, err := parseId(req.Id)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if _, err := tfid.GetImportSpec(azureId, req.Type); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	opt, rec := s.requestOption(r)
+	id, err := aztft.QueryId(req.Id, req.Type, opt)
+	if err != nil {
+		writeError(w, rec.errorCode(), err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, queryResult{
+		AzureId: req.Id,
+		TFType:  req.Type,
+		TFId:    id,
+	})
+}
+
+func (s server) handleBatch(w http.ResponseWriter, r *http.Request) {
+	var req batchRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	resp := batchResponse{Items: []queryResponse{}}
+	for _, id := range req.Ids {
+		if err := r.Context().Err(); err != nil {
+			return
+		}
+		item, _ := s.query(r, id, req.WithId)
+		resp.Items = append(resp.Items, item)
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s server) handleDescribeType(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "only GET is allowed")
+		return
+	}
+	rt := r.URL.Query().Get("type")
+	if rt == "" {
+		writeError(w, http.StatusBadRequest, `query parameter "type" is required`)
+		return
+	}
+	unlock := resmap.RLock()
+	item, ok := resmap.TF2ARMIdMap[rt]
+	unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("unknown resource type %q", rt))
+		return
+	}
+	desc := typeDescription{
+		Type:             rt,
+		IsRemoved:        item.IsRemoved,
+		IdNeedsAPI:       tfid.NeedsAPI(rt),
+		IdOffline:        tfid.CanBuildOffline(rt),
+		PopulateNeedsAPI: populate.NeedsAPI(rt),
+	}
+	if mm := item.ManagementPlane; mm != nil {
+		desc.Provider = mm.Provider
+		desc.Types = mm.Types
+		desc.Scopes = mm.ParentScopes
+		desc.ImportSpecs = mm.ImportSpecs
+	}
+	writeJSON(w, http.StatusOK, desc)
+}
+
+// query queries the types (and ids if withId is true) of the resource id. Errors are reported in the response, so that they can be reported per item in a batch,
+// together with the status code, which is a bad request only for the invalid input.
+func (s server) query(r *http.Request, id string, withId bool) (queryResponse, int) {
+	resp := queryResponse{Id: id, Results: []queryResult{}}
+	if _, err := parseId(id); err != nil {
+		resp.Error = err.Error()
+		return resp, http.StatusBadRequest
+	}
+	opt, rec := s.requestOption(r)
+	if withId {
+		types, ids, exact, err := aztft.QueryTypeAndId(id, opt)
+		if err != nil {
+			resp.Error = err.Error()
+			return resp, rec.errorCode()
+		}
+		for i, t := range types {
+			resp.Results = append(resp.Results, queryResult{
+				AzureId:     t.AzureId.String(),
+				TFType:      t.TFType,
+				TFId:        ids[i],
+				NotInSchema: t.NotInSchema,
+			})
+		}
+		resp.Exact = exact
+		return resp, http.StatusOK
+	}
+	types, exact, err := aztft.QueryType(id, opt)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, rec.errorCode()
+	}
+	for _, t := range types {
+		resp.Results = append(resp.Results, queryResult{
+			AzureId:     t.AzureId.String(),
+			TFType:      t.TFType,
+			NotInSchema: t.NotInSchema,
+		})
+	}
+	resp.Exact = exact
+	return resp, http.StatusOK
+}
+
+// parseId validates the resource id of the request, whose failure is a bad request.
+func parseId(id string) (armid.ResourceId, error) {
+	if id == "" {
+		return nil, fmt.Errorf(`"id" is required`)
+	}
+	azureId, err := armid.ParseResourceId(id)
+	if err != nil {
+		return nil, fmt.Errorf("parsing id: %v", err)
+	}
+	return azureId, nil
+}
+
+func postOnly(h http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			writeError(w, http.StatusMethodNotAllowed, "only POST is allowed")
+			return
+		}
+		h(w, r)
+	})
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodySize))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("decoding request body: %v", err))
+		return false
+	}
+	return true
+}
+
+func writeError(w http.ResponseWriter, code int, msg string) {
+	writeJSON(w, code, errorResponse{Error: msg})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	// nolint:errcheck
+	json.NewEncoder(w).Encode(v)
+}
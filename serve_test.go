@@ -0,0 +1,270 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/magodo/aztft/aztft"
+	"github.com/stretchr/testify/require"
+)
+
+type staticCredential struct{}
+
+func (staticCredential) GetToken(context.Context, policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: "token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+// newUpstreamOption returns the APIOption whose Azure API calls are sent to the upstream handler.
+func newUpstreamOption(t *testing.T, upstream http.HandlerFunc) *aztft.APIOption {
+	srv := httptest.NewTLSServer(upstream)
+	t.Cleanup(srv.Close)
+	return &aztft.APIOption{
+		Cred: staticCredential{},
+		ClientOption: arm.ClientOptions{
+			ClientOptions: policy.ClientOptions{
+				Cloud: cloud.Configuration{
+					ActiveDirectoryAuthorityHost: srv.URL,
+					Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+						cloud.ResourceManager: {
+							Endpoint: srv.URL,
+							Audience: srv.URL,
+						},
+					},
+				},
+				Transport: srv.Client(),
+				Retry:     policy.RetryOptions{MaxRetries: -1},
+			},
+			DisableRPRegistration: true,
+		},
+	}
+}
+
+func doRequest(t *testing.T, h http.Handler, method, path, body string) (int, map[string]interface{}) {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	var out map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &out), w.Body.String())
+	return w.Code, out
+}
+
+func TestServeHandler(t *testing.T) {
+	h := newServeHandler(&aztft.APIOption{}, time.Minute)
+
+	cases := []struct {
+		name        string
+		method      string
+		path        string
+		body        string
+		expectCode  int
+		expectError bool
+	}{
+		{
+			name:       "health",
+			method:     http.MethodGet,
+			path:       "/healthz",
+			expectCode: http.StatusOK,
+		},
+		{
+			name:        "query type with GET",
+			method:      http.MethodGet,
+			path:        "/query-type",
+			expectCode:  http.StatusMethodNotAllowed,
+			expectError: true,
+		},
+		{
+			name:       "query type",
+			method:     http.MethodPost,
+			path:       "/query-type",
+			body:       `{"id": "/subscriptions/sub1/resourceGroups/rg1"}`,
+			expectCode: http.StatusOK,
+		},
+		{
+			name:        "query type with invalid id",
+			method:      http.MethodPost,
+			path:        "/query-type",
+			body:        `{"id": "/subscriptions/sub1/resourceGroups/rg1/foos"}`,
+			expectCode:  http.StatusBadRequest,
+			expectError: true,
+		},
+		{
+			name:        "query type without id",
+			method:      http.MethodPost,
+			path:        "/query-type",
+			body:        `{}`,
+			expectCode:  http.StatusBadRequest,
+			expectError: true,
+		},
+		{
+			name:        "query type with unknown field",
+			method:      http.MethodPost,
+			path:        "/query-type",
+			body:        `{"foo": "bar"}`,
+			expectCode:  http.StatusBadRequest,
+			expectError: true,
+		},
+		{
+			name:       "query type and id",
+			method:     http.MethodPost,
+			path:       "/query-type-and-id",
+			body:       `{"id": "/subscriptions/sub1/resourceGroups/rg1"}`,
+			expectCode: http.StatusOK,
+		},
+		{
+			name:       "query id",
+			method:     http.MethodPost,
+			path:       "/query-id",
+			body:       `{"id": "/subscriptions/sub1/resourceGroups/rg1", "type": "azurerm_resource_group"}`,
+			expectCode: http.StatusOK,
+		},
+		{
+			name:        "query id without type",
+			method:      http.MethodPost,
+			path:        "/query-id",
+			body:        `{"id": "/subscriptions/sub1/resourceGroups/rg1"}`,
+			expectCode:  http.StatusBadRequest,
+			expectError: true,
+		},
+		{
+			name:        "query id with unknown type",
+			method:      http.MethodPost,
+			path:        "/query-id",
+			body:        `{"id": "/subscriptions/sub1/resourceGroups/rg1", "type": "azurerm_foo"}`,
+			expectCode:  http.StatusBadRequest,
+			expectError: true,
+		},
+		{
+			name:        "query id that needs API",
+			method:      http.MethodPost,
+			path:        "/query-id",
+			body:        `{"id": "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.KeyVault/vaults/vault1/secrets/secret1", "type": "azurerm_key_vault_secret"}`,
+			expectCode:  http.StatusInternalServerError,
+			expectError: true,
+		},
+		{
+			name:       "batch",
+			method:     http.MethodPost,
+			path:       "/batch",
+			body:       `{"ids": ["/subscriptions/sub1/resourceGroups/rg1", "/foo"], "with_id": true}`,
+			expectCode: http.StatusOK,
+		},
+		{
+			name:       "describe type",
+			method:     http.MethodGet,
+			path:       "/describe-type?type=azurerm_resource_group",
+			expectCode: http.StatusOK,
+		},
+		{
+			name:        "describe unknown type",
+			method:      http.MethodGet,
+			path:        "/describe-type?type=azurerm_foo",
+			expectCode:  http.StatusNotFound,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			code, body := doRequest(t, h, tt.method, tt.path, tt.body)
+			require.Equal(t, tt.expectCode, code, body)
+			_, hasError := body["error"]
+			require.Equal(t, tt.expectError, hasError, body)
+		})
+	}
+}
+
+func TestServeHandlerQueryResults(t *testing.T) {
+	h := newServeHandler(&aztft.APIOption{}, time.Minute)
+
+	_, body := doRequest(t, h, http.MethodPost, "/query-type-and-id", `{"id": "/SUBSCRIPTIONS/sub1/RESOURCEGROUPS/rg1"}`)
+	require.Equal(t, map[string]interface{}{
+		"id": "/SUBSCRIPTIONS/sub1/RESOURCEGROUPS/rg1",
+		"results": []interface{}{
+			map[string]interface{}{
+				"azure_id": "/subscriptions/sub1/resourceGroups/rg1",
+				"tf_type":  "azurerm_resource_group",
+				"tf_id":    "/subscriptions/sub1/resourceGroups/rg1",
+			},
+		},
+		"exact": true,
+	}, body)
+
+	_, body = doRequest(t, h, http.MethodPost, "/batch", `{"ids": ["/subscriptions/sub1/resourceGroups/rg1", "/foo"]}`)
+	items := body["items"].([]interface{})
+	require.Len(t, items, 2)
+	require.NotContains(t, items[0], "error")
+	require.Contains(t, items[1], "error")
+}
+
+func TestServeHandlerUpstreamFailure(t *testing.T) {
+	opt := newUpstreamOption(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error": {"code": "AuthorizationFailed", "message": "no access"}}`))
+	})
+	h := newServeHandler(opt, time.Minute)
+
+	// The VM is ambiguous (Linux or Windows), which is resolved by calling the Azure API.
+	code, body := doRequest(t, h, http.MethodPost, "/query-type", `{"id": "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/vm1"}`)
+	require.Equal(t, http.StatusBadGateway, code, body)
+
+	// The input errors are still bad requests.
+	code, body = doRequest(t, h, http.MethodPost, "/query-type", `{"id": "/foo"}`)
+	require.Equal(t, http.StatusBadRequest, code, body)
+}
+
+func TestServeHandlerSharedClients(t *testing.T) {
+	opt := newUpstreamOption(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error": {"code": "AuthorizationFailed", "message": "no access"}}`))
+	})
+	var resolved atomic.Int32
+	opt.CredResolver = func(subscriptionId string) (azcore.TokenCredential, error) {
+		resolved.Add(1)
+		return staticCredential{}, nil
+	}
+	h := newServeHandler(opt, time.Minute)
+
+	// The clients are built once for the subscription and shared by the requests, while the failures are still recorded per request.
+	for i := 0; i < 3; i++ {
+		code, body := doRequest(t, h, http.MethodPost, "/query-type", `{"id": "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/vm1"}`)
+		require.Equal(t, http.StatusBadGateway, code, body)
+	}
+	require.EqualValues(t, 1, resolved.Load())
+
+	code, body := doRequest(t, h, http.MethodPost, "/query-type", `{"id": "/subscriptions/sub1/resourceGroups/rg1"}`)
+	require.Equal(t, http.StatusOK, code, body)
+}
+
+func TestServeHandlerTimeout(t *testing.T) {
+	cancelled := make(chan struct{})
+	opt := newUpstreamOption(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+			close(cancelled)
+		case <-time.After(10 * time.Second):
+		}
+	})
+	h := newServeHandler(opt, 100*time.Millisecond)
+
+	req := httptest.NewRequest(http.MethodPost, "/query-type", strings.NewReader(`{"id": "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/vm1"}`))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	// The Azure API call is cancelled together with the request.
+	select {
+	case <-cancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the Azure API call is not cancelled on timeout")
+	}
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/magodo/aztft/aztft"
+)
+
+// tenantAuth describes how to authenticate to a tenant, which overrides the authConfig read from the environment.
+type tenantAuth struct {
+	// Auth is the authentication method. Defaults to the one specified by "--auth".
+	Auth                  string `json:"auth,omitempty"`
+	ClientId              string `json:"client_id,omitempty"`
+	ClientSecretFilePath  string `json:"client_secret_file_path,omitempty"`
+	ClientCertificatePath string `json:"client_certificate_path,omitempty"`
+}
+
+// subscriptionAuth describes the tenant of a subscription, and optionally how to authenticate to it, which overrides the tenantAuth of the tenant.
+type subscriptionAuth struct {
+	TenantId string `json:"tenant_id"`
+	tenantAuth
+}
+
+// tenantMapping maps from the subscription id to its tenant, and from the tenant id to how to authenticate to it.
+type tenantMapping struct {
+	Subscriptions map[string]subscriptionAuth `json:"subscriptions"`
+	Tenants       map[string]tenantAuth       `json:"tenants"`
+}
+
+// loadTenantMapping loads the tenant mapping file, e.g.
+//
+//	{
+//	  "subscriptions": {
+//	    "00000000-0000-0000-0000-000000000000": {
+//	      "tenant_id": "11111111-1111-1111-1111-111111111111"
+//	    }
+//	  },
+//	  "tenants": {
+//	    "11111111-1111-1111-1111-111111111111": {
+//	      "auth": "sp-secret"
+//	    }
+//	  }
+//	}
+//
+// The authentication methods are validated when loading.
+func loadTenantMapping(path string) (*tenantMapping, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %v", path, err)
+	}
+	var m tenantMapping
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("unmarshalling %s: %v", path, err)
+	}
+	out := &tenantMapping{
+		Subscriptions: map[string]subscriptionAuth{},
+		Tenants:       map[string]tenantAuth{},
+	}
+	for tenantId, ta := range m.Tenants {
+		if err := ta.validate(); err != nil {
+			return nil, fmt.Errorf("tenant %s: %v", tenantId, err)
+		}
+		out.Tenants[strings.ToLower(tenantId)] = ta
+	}
+	for subId, sa := range m.Subscriptions {
+		if sa.TenantId == "" {
+			return nil, fmt.Errorf("subscription %s: tenant_id is required", subId)
+		}
+		if err := sa.validate(); err != nil {
+			return nil, fmt.Errorf("subscription %s: %v", subId, err)
+		}
+		out.Subscriptions[strings.ToLower(subId)] = sa
+	}
+	return out, nil
+}
+
+func (ta tenantAuth) validate() error {
+	if ta.Auth == "" {
+		return nil
+	}
+	for _, method := range authMethods {
+		if strings.EqualFold(ta.Auth, method) {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown authentication method %q, must be one of %v", ta.Auth, authMethods)
+}
+
+// merge returns the tenantAuth, whose fields are overridden by the non-empty ones of the other.
+func (ta tenantAuth) merge(other tenantAuth) tenantAuth {
+	if other.Auth != "" {
+		ta.Auth = other.Auth
+	}
+	if other.ClientId != "" {
+		ta.ClientId = other.ClientId
+	}
+	if other.ClientSecretFilePath != "" {
+		ta.ClientSecretFilePath = other.ClientSecretFilePath
+	}
+	if other.ClientCertificatePath != "" {
+		ta.ClientCertificatePath = other.ClientCertificatePath
+	}
+	return ta
+}
+
+// newCredentialResolver returns a credential resolver, which builds (and caches) the credential for the subscriptions in the mapping,
+// by the authentication of their tenants, and uses the default credential for the others.
+func newCredentialResolver(m *tenantMapping, defaultCred azcore.TokenCredential, defaultMethod string, baseCfg authConfig, clientOpt policy.ClientOptions) aztft.CredentialResolver {
+	type credKey struct {
+		tenantId string
+		auth     tenantAuth
+	}
+	var (
+		mu    sync.Mutex
+		creds = map[credKey]azcore.TokenCredential{}
+	)
+	return func(subscriptionId string) (azcore.TokenCredential, error) {
+		sa, ok := m.Subscriptions[strings.ToLower(subscriptionId)]
+		if !ok {
+			return defaultCred, nil
+		}
+		key := credKey{
+			tenantId: strings.ToLower(sa.TenantId),
+			auth:     m.Tenants[strings.ToLower(sa.TenantId)].merge(sa.tenantAuth),
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if cred, ok := creds[key]; ok {
+			return cred, nil
+		}
+
+		cfg := baseCfg
+		cfg.TenantId = sa.TenantId
+		if key.auth.ClientId != "" {
+			cfg.ClientId = key.auth.ClientId
+		}
+		if key.auth.ClientSecretFilePath != "" {
+			b, err := os.ReadFile(key.auth.ClientSecretFilePath)
+			if err != nil {
+				return nil, fmt.Errorf("reading the client secret from %s: %v", key.auth.ClientSecretFilePath, err)
+			}
+			cfg.ClientSecret = strings.TrimSpace(string(b))
+		}
+		if key.auth.ClientCertificatePath != "" {
+			b, err := os.ReadFile(key.auth.ClientCertificatePath)
+			if err != nil {
+				return nil, fmt.Errorf("reading the client certificate from %s: %v", key.auth.ClientCertificatePath, err)
+			}
+			cfg.ClientCertificate = b
+		}
+		method := defaultMethod
+		if key.auth.Auth != "" {
+			method = strings.ToLower(key.auth.Auth)
+		}
+
+		cred, err := buildCredential(method, cfg, clientOpt)
+		if err != nil {
+			return nil, fmt.Errorf("building credential for tenant %s: %v", sa.TenantId, err)
+		}
+		creds[key] = cred
+		return cred, nil
+	}
+}
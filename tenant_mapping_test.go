@@ -0,0 +1,116 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTenantMapping(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		expect  *tenantMapping
+		err     bool
+	}{
+		{
+			name: "subscriptions and tenants",
+			content: `{
+  "subscriptions": {
+    "SUB1": {"tenant_id": "tenant1"},
+    "sub2": {"tenant_id": "tenant1", "auth": "SP-Secret", "client_id": "client2"}
+  },
+  "tenants": {
+    "TENANT1": {"auth": "sp-cert", "client_id": "client1"}
+  }
+}`,
+			expect: &tenantMapping{
+				Subscriptions: map[string]subscriptionAuth{
+					"sub1": {TenantId: "tenant1"},
+					"sub2": {TenantId: "tenant1", tenantAuth: tenantAuth{Auth: "SP-Secret", ClientId: "client2"}},
+				},
+				Tenants: map[string]tenantAuth{
+					"tenant1": {Auth: "sp-cert", ClientId: "client1"},
+				},
+			},
+		},
+		{
+			name:    "missing tenant id",
+			content: `{"subscriptions": {"sub1": {"auth": "cli"}}}`,
+			err:     true,
+		},
+		{
+			name:    "invalid subscription auth",
+			content: `{"subscriptions": {"sub1": {"tenant_id": "tenant1", "auth": "sp-secert"}}}`,
+			err:     true,
+		},
+		{
+			name:    "invalid tenant auth",
+			content: `{"tenants": {"tenant1": {"auth": "foo"}}}`,
+			err:     true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "mapping.json")
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0644))
+			actual, err := loadTenantMapping(path)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestCredentialResolver(t *testing.T) {
+	dir := t.TempDir()
+	secretFile := filepath.Join(dir, "secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("secret1"), 0600))
+
+	m := &tenantMapping{
+		Subscriptions: map[string]subscriptionAuth{
+			"sub1": {TenantId: "tenant1"},
+			"sub2": {TenantId: "TENANT1"},
+			"sub3": {TenantId: "tenant1", tenantAuth: tenantAuth{ClientId: "client3"}},
+			"sub4": {TenantId: "tenant2", tenantAuth: tenantAuth{Auth: authMethodSPSecret}},
+		},
+		Tenants: map[string]tenantAuth{
+			"tenant1": {Auth: authMethodSPSecret, ClientId: "client1", ClientSecretFilePath: secretFile},
+		},
+	}
+	defaultCred, err := azidentity.NewClientSecretCredential("tenant0", "client0", "secret0", nil)
+	require.NoError(t, err)
+	resolver := newCredentialResolver(m, defaultCred, authMethodAuto, authConfig{}, policy.ClientOptions{})
+
+	// Not in the mapping
+	cred, err := resolver("sub0")
+	require.NoError(t, err)
+	require.Same(t, defaultCred, cred)
+
+	// The subscriptions of the same tenant share the credential
+	cred1, err := resolver("SUB1")
+	require.NoError(t, err)
+	require.IsType(t, &azidentity.ClientSecretCredential{}, cred1)
+	require.NotSame(t, defaultCred, cred1)
+	cred2, err := resolver("sub2")
+	require.NoError(t, err)
+	require.Same(t, cred1, cred2)
+
+	// The subscription overrides the authentication of its tenant
+	cred3, err := resolver("sub3")
+	require.NoError(t, err)
+	require.IsType(t, &azidentity.ClientSecretCredential{}, cred3)
+	require.NotSame(t, cred1, cred3)
+
+	// The tenant without authentication, whose subscription lacks the client secret
+	_, err = resolver("sub4")
+	require.Error(t, err)
+}
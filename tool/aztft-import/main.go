@@ -9,6 +9,7 @@ import (
 	"log"
 	"os"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -16,7 +17,10 @@ import (
 
 	"errors"
 
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/magodo/armid"
+	"github.com/zclconf/go-cty/cty"
 )
 
 var (
@@ -49,12 +53,7 @@ var HardcodedTypes = map[string]*HardCodedTypeInfo{
 
 	// Property-like resources
 	// (not supported)
-	"azurerm_app_service_certificate_binding":                         {caughtErr: ErrSyntheticId},
-	"azurerm_app_service_source_control_token":                        {caughtErr: ErrParseIdFailed},
-	"azurerm_private_endpoint_application_security_group_association": {caughtErr: ErrSyntheticId},
-	"azurerm_virtual_machine_gallery_application_assignment":          {caughtErr: ErrSyntheticId},
-	"azurerm_virtual_desktop_scaling_plan_host_pool_association":      {caughtErr: ErrSyntheticId},
-	"azurerm_communication_service_email_domain_association":          {caughtErr: ErrSyntheticId},
+	"azurerm_app_service_source_control_token": {caughtErr: ErrParseIdFailed},
 	//"azurerm_management_group_subscription_association": {}, // Just not supported
 
 	// Data plane resources
@@ -76,11 +75,16 @@ var HardcodedTypes = map[string]*HardCodedTypeInfo{
 	"azurerm_network_interface_security_group_association":                           {caughtErr: ErrSyntheticId},
 	"azurerm_network_interface_application_gateway_backend_address_pool_association": {caughtErr: ErrSyntheticId},
 	"azurerm_virtual_desktop_workspace_application_group_association":                {caughtErr: ErrSyntheticId},
+	"azurerm_virtual_desktop_scaling_plan_host_pool_association":                     {caughtErr: ErrSyntheticId},
+	"azurerm_communication_service_email_domain_association":                         {caughtErr: ErrSyntheticId},
+	"azurerm_app_service_certificate_binding":                                        {caughtErr: ErrSyntheticId},
 	"azurerm_network_interface_application_security_group_association":               {caughtErr: ErrSyntheticId},
 	"azurerm_nat_gateway_public_ip_association":                                      {caughtErr: ErrSyntheticId},
 	"azurerm_network_interface_nat_rule_association":                                 {caughtErr: ErrSyntheticId},
 	"azurerm_network_interface_backend_address_pool_association":                     {caughtErr: ErrSyntheticId},
 	"azurerm_nat_gateway_public_ip_prefix_association":                               {caughtErr: ErrSyntheticId},
+	"azurerm_private_endpoint_application_security_group_association":                {caughtErr: ErrSyntheticId},
+	"azurerm_virtual_machine_gallery_application_assignment":                         {caughtErr: ErrSyntheticId},
 	"azurerm_chaos_studio_target": {
 		caughtErr: ErrParseIdFailed,
 		mapItem: &resmap.TF2ARMIdMapItem{
@@ -114,6 +118,28 @@ var HardcodedTypes = map[string]*HardCodedTypeInfo{
 	},
 
 	// Data plane only resources, we use pesudo resource id patterns
+	"azurerm_app_configuration_feature": {
+		mapItem: &resmap.TF2ARMIdMapItem{
+			ManagementPlane: &resmap.MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppConfiguration",
+				Types:        []string{"configurationStores", "AppConfigurationFeature", "Label"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppConfiguration/configurationStores/AppConfigurationFeature/Label"},
+			},
+		},
+		caughtErr: ErrDataPlaneId,
+	},
+	"azurerm_app_configuration_key": {
+		mapItem: &resmap.TF2ARMIdMapItem{
+			ManagementPlane: &resmap.MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.AppConfiguration",
+				Types:        []string{"configurationStores", "AppConfigurationKey", "Label"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppConfiguration/configurationStores/AppConfigurationKey/Label"},
+			},
+		},
+		caughtErr: ErrDataPlaneId,
+	},
 	"azurerm_key_vault_certificate": {
 		mapItem: &resmap.TF2ARMIdMapItem{
 			ManagementPlane: &resmap.MapManagementPlane{
@@ -309,28 +335,6 @@ var HardcodedTypes = map[string]*HardCodedTypeInfo{
 		},
 		caughtErr: ErrDuplicateImportSpec,
 	},
-	"azurerm_app_configuration_feature": {
-		mapItem: &resmap.TF2ARMIdMapItem{
-			ManagementPlane: &resmap.MapManagementPlane{
-				ParentScopes: []string{"/subscriptions/resourceGroups"},
-				Provider:     "Microsoft.AppConfiguration",
-				Types:        []string{"configurationStores", "AppConfigurationFeature", "Label"},
-				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppConfiguration/configurationStores/AppConfigurationFeature/Label"},
-			},
-		},
-		caughtErr: ErrDataPlaneId,
-	},
-	"azurerm_app_configuration_key": {
-		mapItem: &resmap.TF2ARMIdMapItem{
-			ManagementPlane: &resmap.MapManagementPlane{
-				ParentScopes: []string{"/subscriptions/resourceGroups"},
-				Provider:     "Microsoft.AppConfiguration",
-				Types:        []string{"configurationStores", "AppConfigurationKey", "Label"},
-				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.AppConfiguration/configurationStores/AppConfigurationKey/Label"},
-			},
-		},
-		caughtErr: ErrDataPlaneId,
-	},
 	"azurerm_monitor_diagnostic_setting": {
 		mapItem: &resmap.TF2ARMIdMapItem{
 			ManagementPlane: &resmap.MapManagementPlane{
@@ -489,25 +493,45 @@ func main() {
 	dir.Close()
 
 	m := map[string]armid.ResourceId{}
+	// formats records the import formats that each resource type's import specs come from
+	formats := map[string]map[string]bool{}
+	// seenIds records the raw import ids of each resource type, to skip the same id shown in different formats
+	seenIds := map[string]map[string]bool{}
+	var noImportTypes []string
 
 	for _, entry := range entries {
 		p := path.Join(rDir, entry)
-		f, err := os.Open(p)
+		stmts, err := scanImports(p)
 		if err != nil {
-			log.Fatalf("failed to open %s: %v\n", p, err)
+			log.Fatal(err)
+		}
+		if len(stmts) == 0 {
+			noImportTypes = append(noImportTypes, "azurerm_"+strings.SplitN(entry, ".", 2)[0])
+			continue
 		}
-		scanner := bufio.NewScanner(f)
-	ScanFileLoop:
-		for scanner.Scan() {
-			line := scanner.Text()
-			if !strings.HasPrefix(line, "terraform import") && !strings.HasPrefix(line, "$ terraform import") {
-				continue
+	ScanStmtLoop:
+		for _, stmt := range stmts {
+			if stmt.rtype != "" {
+				if formats[stmt.rtype] == nil {
+					formats[stmt.rtype] = map[string]bool{}
+					seenIds[stmt.rtype] = map[string]bool{}
+				}
+				formats[stmt.rtype][stmt.format] = true
+				if seenIds[stmt.rtype][stmt.idRaw] {
+					continue
+				}
+				seenIds[stmt.rtype][stmt.idRaw] = true
+			}
+
+			rtype := stmt.rtype
+			var id armid.ResourceId
+			err := stmt.err
+			if err == nil {
+				id, err = parseId(stmt.idRaw)
 			}
-			line = line[strings.Index(line, "terraform import"):]
-			rtype, id, err := parse(line)
 			if err != nil {
 				if HardcodedTypes[rtype] == nil {
-					log.Printf("%s new parse error: %v\n", rtype, err)
+					log.Printf("%s new parse error (%s:%d): %v\n", rtype, p, stmt.line, err)
 					continue
 				}
 				// Skip the error if it is already caught
@@ -515,12 +539,12 @@ func main() {
 					if errors.Is(err, kerr) {
 						if HardcodedTypes[rtype].caughtErr == kerr {
 							HardcodedTypes[rtype].caught = true
-							continue ScanFileLoop
+							continue ScanStmtLoop
 						}
 					}
 				}
 
-				log.Fatalf("%s parse error: %v\n", rtype, err)
+				log.Fatalf("%s parse error (%s:%d): %v\n", rtype, p, stmt.line, err)
 			}
 
 			if _, ok := m[rtype]; ok {
@@ -538,9 +562,28 @@ func main() {
 			}
 			m[rtype] = id
 		}
-		if err := scanner.Err(); err != nil {
-			log.Fatalf("reading %s: %v", p, err)
+	}
+
+	// Report which format(s) the import specs of each resource type come from
+	var rtypes []string
+	for rtype := range formats {
+		rtypes = append(rtypes, rtype)
+	}
+	sort.Strings(rtypes)
+	for _, rtype := range rtypes {
+		var fmts []string
+		for _, f := range []string{importFormatCLI, importFormatBlock} {
+			if formats[rtype][f] {
+				fmts = append(fmts, f)
+			}
 		}
+		log.Printf("%s: %s\n", rtype, strings.Join(fmts, ", "))
+	}
+
+	// Ensure every resource document has an import spec, in either format
+	if len(noImportTypes) != 0 {
+		sort.Strings(noImportTypes)
+		log.Fatalf("No import spec (neither %s nor %s) found for: %s", importFormatCLI, importFormatBlock, strings.Join(noImportTypes, ", "))
 	}
 
 	// Ensure all the caught errors are really caught
@@ -578,34 +621,186 @@ func main() {
 	fmt.Println(string(b))
 }
 
-func parse(line string) (string, armid.ResourceId, error) {
+const (
+	importFormatCLI   = "terraform import"
+	importFormatBlock = "import block"
+)
+
+// importStmt is an import statement found in the resource document, either as a "terraform import" command, or as an "import" block.
+type importStmt struct {
+	format string
+	// line is the (starting) line number of the statement
+	line  int
+	rtype string
+	idRaw string
+	// err is the error occurred when parsing the statement
+	err error
+}
+
+// scanImports scans the import statements in the resource document.
+func scanImports(p string) ([]importStmt, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %v", p, err)
+	}
+	defer f.Close()
+
+	var (
+		stmts []importStmt
+
+		// The state of the import block being scanned
+		blockLines []string
+		blockStart int
+		blockDepth int
+	)
+
+	scanner := bufio.NewScanner(f)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+
+		if blockLines != nil {
+			blockLines = append(blockLines, line)
+			blockDepth += braceDelta(line)
+			if blockDepth <= 0 {
+				stmt := importStmt{format: importFormatBlock, line: blockStart}
+				stmt.rtype, stmt.idRaw, stmt.err = parseBlock(strings.Join(blockLines, "\n"))
+				stmts = append(stmts, stmt)
+				blockLines = nil
+			}
+			continue
+		}
+
+		if strings.HasPrefix(line, "terraform import") || strings.HasPrefix(line, "$ terraform import") {
+			line = line[strings.Index(line, "terraform import"):]
+			stmt := importStmt{format: importFormatCLI, line: lineNum}
+			stmt.rtype, stmt.idRaw, stmt.err = parseCLI(line)
+			stmts = append(stmts, stmt)
+			continue
+		}
+
+		if fields := strings.Fields(line); len(fields) >= 2 && fields[0] == "import" && strings.HasPrefix(fields[1], "{") {
+			blockLines = []string{line}
+			blockStart = lineNum
+			blockDepth = braceDelta(line)
+			if blockDepth <= 0 {
+				stmt := importStmt{format: importFormatBlock, line: blockStart}
+				stmt.rtype, stmt.idRaw, stmt.err = parseBlock(line)
+				stmts = append(stmts, stmt)
+				blockLines = nil
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading %s: %v", p, err)
+	}
+	if blockLines != nil {
+		stmts = append(stmts, importStmt{
+			format: importFormatBlock,
+			line:   blockStart,
+			err:    fmt.Errorf("unterminated import block: %w", ErrMalformedImportSpec),
+		})
+	}
+	return stmts, nil
+}
+
+// braceDelta returns the number of the opening braces minus the closing braces in the line, ignoring the ones in the quoted strings.
+func braceDelta(line string) int {
+	var (
+		delta   int
+		inQuote bool
+		escaped bool
+	)
+	for _, c := range line {
+		switch {
+		case escaped:
+			escaped = false
+		case inQuote && c == '\\':
+			escaped = true
+		case c == '"':
+			inQuote = !inQuote
+		case inQuote:
+		case c == '{':
+			delta++
+		case c == '}':
+			delta--
+		}
+	}
+	return delta
+}
+
+// parseCLI parses the "terraform import <address> <id>" command.
+func parseCLI(line string) (string, string, error) {
 	fields := strings.Fields(line)
 	if len(fields) != 4 {
-		return "", nil, fmt.Errorf("%s: %w", line, ErrMalformedImportSpec)
+		return "", "", fmt.Errorf("%s: %w", line, ErrMalformedImportSpec)
 	}
 	addr, idRaw := fields[2], fields[3]
 	rtype, _, ok := strings.Cut(addr, ".")
 	if !ok {
-		return "", nil, fmt.Errorf("%s: malformed resource address", addr)
+		return "", "", fmt.Errorf("%s: malformed resource address", addr)
 	}
 
 	if v, err := strconv.Unquote(idRaw); err == nil {
 		idRaw = v
 	}
+	return rtype, idRaw, nil
+}
+
+// parseBlock parses the import block, e.g.
+//
+//	import {
+//	  to = azurerm_resource_group.example
+//	  id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1"
+//	}
+func parseBlock(src string) (string, string, error) {
+	f, diags := hclsyntax.ParseConfig([]byte(src), "import.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		return "", "", fmt.Errorf("%s: %w: %v", src, ErrMalformedImportSpec, diags.Error())
+	}
+	blocks := f.Body.(*hclsyntax.Body).Blocks
+	if len(blocks) != 1 || blocks[0].Type != "import" {
+		return "", "", fmt.Errorf("%s: %w", src, ErrMalformedImportSpec)
+	}
+	attrs := blocks[0].Body.Attributes
+
+	toAttr, ok := attrs["to"]
+	if !ok {
+		return "", "", fmt.Errorf("%s: %w: missing \"to\"", src, ErrMalformedImportSpec)
+	}
+	traversal, diags := hcl.AbsTraversalForExpr(toAttr.Expr)
+	if diags.HasErrors() {
+		return "", "", fmt.Errorf("%s: malformed resource address: %v", src, diags.Error())
+	}
+	rtype := traversal.RootName()
+
+	idAttr, ok := attrs["id"]
+	if !ok {
+		return rtype, "", fmt.Errorf("%s: %w: missing \"id\"", src, ErrMalformedImportSpec)
+	}
+	idVal, diags := idAttr.Expr.Value(nil)
+	if diags.HasErrors() || idVal.Type() != cty.String || !idVal.IsKnown() || idVal.IsNull() {
+		return rtype, "", fmt.Errorf("%s: %w: \"id\" is not a literal string", src, ErrMalformedImportSpec)
+	}
+	return rtype, idVal.AsString(), nil
+}
 
+// parseId parses the raw import id into a resource id.
+func parseId(idRaw string) (armid.ResourceId, error) {
 	if strings.HasPrefix(idRaw, "https://") {
-		return rtype, nil, ErrDataPlaneId
+		return nil, ErrDataPlaneId
 	}
 
 	// Return an empty TF2ARMIdMapItem for the synthetic resources, which are mostly binding/association resources.
 	if strings.ContainsAny(idRaw, ";|") {
-		return rtype, nil, ErrSyntheticId
+		return nil, ErrSyntheticId
 	}
 
 	id, err := armid.ParseResourceId(idRaw)
 	if err != nil {
-		return rtype, nil, fmt.Errorf("%w: %v", ErrParseIdFailed, err)
+		return nil, fmt.Errorf("%w: %v", ErrParseIdFailed, err)
 	}
 
-	return rtype, id, nil
+	return id, nil
 }
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanImports(t *testing.T) {
+	cases := []struct {
+		name   string
+		doc    string
+		expect []importStmt
+	}{
+		{
+			name: "legacy terraform import",
+			doc: "## Import\n" +
+				"\n" +
+				"Resource Groups can be imported using the `resource id`, e.g.\n" +
+				"\n" +
+				"```shell\n" +
+				"terraform import azurerm_resource_group.example /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1\n" +
+				"```\n",
+			expect: []importStmt{
+				{
+					format: importFormatCLI,
+					line:   6,
+					rtype:  "azurerm_resource_group",
+					idRaw:  "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+				},
+			},
+		},
+		{
+			name: "legacy terraform import with prompt and quoted id",
+			doc: "```shell\n" +
+				`$ terraform import azurerm_monitor_diagnostic_setting.example "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.KeyVault/vaults/vault1|setting1"` + "\n" +
+				"```\n",
+			expect: []importStmt{
+				{
+					format: importFormatCLI,
+					line:   2,
+					rtype:  "azurerm_monitor_diagnostic_setting",
+					idRaw:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.KeyVault/vaults/vault1|setting1",
+				},
+			},
+		},
+		{
+			name: "import block",
+			doc: "## Import\n" +
+				"\n" +
+				"```hcl\n" +
+				"import {\n" +
+				"  to = azurerm_resource_group.example\n" +
+				"  id = \"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1\"\n" +
+				"}\n" +
+				"```\n",
+			expect: []importStmt{
+				{
+					format: importFormatBlock,
+					line:   4,
+					rtype:  "azurerm_resource_group",
+					idRaw:  "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+				},
+			},
+		},
+		{
+			name: "import block with braces in the id",
+			doc: "import {\n" +
+				"  to = azurerm_foo.example\n" +
+				"  id = \"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Foo/foos/{name}\"\n" +
+				"}\n",
+			expect: []importStmt{
+				{
+					format: importFormatBlock,
+					line:   1,
+					rtype:  "azurerm_foo",
+					idRaw:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Foo/foos/{name}",
+				},
+			},
+		},
+		{
+			name: "neither",
+			doc: "## Import\n" +
+				"\n" +
+				"This resource can't be imported.\n",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			p := filepath.Join(t.TempDir(), "doc.html.markdown")
+			require.NoError(t, os.WriteFile(p, []byte(tt.doc), 0644))
+			actual, err := scanImports(p)
+			require.NoError(t, err)
+			require.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestScanImportsMalformed(t *testing.T) {
+	cases := []struct {
+		name string
+		doc  string
+	}{
+		{
+			name: "terraform import without id",
+			doc:  "terraform import azurerm_resource_group.example\n",
+		},
+		{
+			name: "import block without id",
+			doc: "import {\n" +
+				"  to = azurerm_resource_group.example\n" +
+				"}\n",
+		},
+		{
+			name: "import block with non-literal id",
+			doc: "import {\n" +
+				"  to = azurerm_resource_group.example\n" +
+				"  id = var.id\n" +
+				"}\n",
+		},
+		{
+			name: "unterminated import block",
+			doc: "import {\n" +
+				"  to = azurerm_resource_group.example\n",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			p := filepath.Join(t.TempDir(), "doc.html.markdown")
+			require.NoError(t, os.WriteFile(p, []byte(tt.doc), 0644))
+			actual, err := scanImports(p)
+			require.NoError(t, err)
+			require.Len(t, actual, 1)
+			require.True(t, errors.Is(actual[0].err, ErrMalformedImportSpec), actual[0].err)
+		})
+	}
+}
+
+func TestBraceDelta(t *testing.T) {
+	require.Equal(t, 1, braceDelta("import {"))
+	require.Equal(t, 0, braceDelta(`import { to = a.b, id = "{x}" }`))
+	require.Equal(t, -1, braceDelta(`  id = "a\"}" }`))
+}
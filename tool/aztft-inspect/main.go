@@ -1,12 +1,126 @@
 package main
 
+/// This program lints the resource mappings, together with the resolvers, populaters and id builders that depend on them.
+
 import (
+	"encoding/json"
+	"flag"
 	"fmt"
+	"os"
+	"sort"
+	"strings"
 
+	"github.com/magodo/aztft/internal/exampleid"
+	"github.com/magodo/aztft/internal/populate"
 	"github.com/magodo/aztft/internal/resmap"
 	"github.com/magodo/aztft/internal/resolve"
+	"github.com/magodo/aztft/internal/tfid"
+)
+
+const (
+	RuleAmbiguousWithoutResolver  = "ambiguous-without-resolver"
+	RuleResolverUncoveredType     = "resolver-uncovered-type"
+	RuleResolverUnknownType       = "resolver-unknown-type"
+	RuleResolverRemovedType       = "resolver-removed-type"
+	RulePopulaterUnmappedType     = "populater-unmapped-type"
+	RuleBuilderUnmappedType       = "builder-unmapped-type"
+	RuleImportSpecScopeMismatch   = "import-spec-scope-mismatch"
+	RuleAnyScopeWithoutStaticCase = "any-scope-without-static-build"
+	RuleMissingReadActions        = "missing-read-actions"
 )
 
+var rules = map[string]string{
+	RuleAmbiguousWithoutResolver:  "A routing scope maps to multiple resource types, but there is no resolver for it",
+	RuleResolverUncoveredType:     "A routing scope maps to multiple resource types, but its resolver doesn't cover all of them",
+	RuleResolverUnknownType:       "A resolver's ResourceTypes() contains a resource type that doesn't exist in the mapping",
+	RuleResolverRemovedType:       "A resolver's ResourceTypes() contains a removed resource type",
+	RulePopulaterUnmappedType:     "A populater's resource type, or the (pseudo) resource types it emits, have no mapping entry",
+	RuleBuilderUnmappedType:       "A dynamic/offline id builder's resource type doesn't exist in the mapping",
+	RuleImportSpecScopeMismatch:   "The number of import_specs doesn't match the number of scopes",
+	RuleAnyScopeWithoutStaticCase: `A resource type under scope "any" can't be built by tfid.StaticBuild, or its id loses the parent scope`,
+	RuleMissingReadActions:        "A resolver, populater or id builder doesn't declare the ARM actions it calls",
+}
+
+type Finding struct {
+	Rule         string `json:"rule"`
+	ResourceType string `json:"resource_type,omitempty"`
+	// Key is the routing scope (and the parent scope) that the finding is about, if any.
+	Key     string `json:"key,omitempty"`
+	Message string `json:"message"`
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("[%s] %s", f.Rule, f.Message)
+}
+
+const usage = `aztft-inspect [-json] [-rules]
+
+Lint the resource mappings, and exit non-zero if there is any finding.`
+
+func main() {
+	var (
+		flagJSON  = flag.Bool("json", false, "Output the findings in JSON")
+		flagRules = flag.Bool("rules", false, "List the rules")
+	)
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if *flagRules {
+		var ids []string
+		for id := range rules {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			fmt.Printf("%s: %s\n", id, rules[id])
+		}
+		return
+	}
+
+	resmap.Init()
+
+	var findings []Finding
+	findings = append(findings, lintAmbiguousBuckets()...)
+	findings = append(findings, lintResolvers()...)
+	findings = append(findings, lintPopulaters()...)
+	findings = append(findings, lintBuilders()...)
+	findings = append(findings, lintMapItems()...)
+	findings = append(findings, lintReadActions()...)
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].Rule != findings[j].Rule {
+			return findings[i].Rule < findings[j].Rule
+		}
+		if findings[i].ResourceType != findings[j].ResourceType {
+			return findings[i].ResourceType < findings[j].ResourceType
+		}
+		return findings[i].Key < findings[j].Key
+	})
+
+	if *flagJSON {
+		if findings == nil {
+			findings = []Finding{}
+		}
+		b, err := json.MarshalIndent(findings, "", "  ")
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		fmt.Println(string(b))
+	} else {
+		for _, f := range findings {
+			fmt.Println(f)
+		}
+	}
+
+	if len(findings) != 0 {
+		os.Exit(1)
+	}
+}
+
 func stringInSlice(s string, l []string) bool {
 	for _, item := range l {
 		if s == item {
@@ -16,46 +130,238 @@ func stringInSlice(s string, l []string) bool {
 	return false
 }
 
-func main() {
-	resmap.Init()
+func lintAmbiguousBuckets() []Finding {
+	var findings []Finding
+	for k1, b := range resmap.ARMId2TFMap {
+		for k2, l := range b {
+			if len(l) <= 1 {
+				continue
+			}
+			key := k1 + " in scope of " + k2
+			if m, ok := resolve.Resolvers[k1]; ok {
+				if resolver, ok := m[k2]; ok {
+					// Check whether all the TF candidates are covered by this resolver.
+					for _, item := range l {
+						if !stringInSlice(item.ResourceType, resolver.ResourceTypes()) {
+							findings = append(findings, Finding{
+								Rule:         RuleResolverUncoveredType,
+								ResourceType: item.ResourceType,
+								Key:          key,
+								Message:      fmt.Sprintf("%s has ambiguous resource type %q that isn't covered by its resolver", key, item.ResourceType),
+							})
+						}
+					}
+					continue
+				}
+			}
+			var resourceTypes []string
+			for _, item := range l {
+				resourceTypes = append(resourceTypes, item.ResourceType)
+			}
+			sort.Strings(resourceTypes)
+			findings = append(findings, Finding{
+				Rule:    RuleAmbiguousWithoutResolver,
+				Key:     key,
+				Message: fmt.Sprintf("multiple matches found for %s without a resolver: %v", key, resourceTypes),
+			})
+		}
+	}
+	return findings
+}
 
-	// Check whether this resolver's resource types contain deprecated/non-existed resource.
+func lintResolvers() []Finding {
+	var findings []Finding
 	for k1, m := range resolve.Resolvers {
 		for k2, resolver := range m {
+			key := k1 + " in scope of " + k2
 			for _, rt := range resolver.ResourceTypes() {
 				item, ok := resmap.TF2ARMIdMap[rt]
 				if !ok {
-					fmt.Printf("non-exist resource type %q for resolver %s in scope of %s\n", rt, k1, k2)
+					findings = append(findings, Finding{
+						Rule:         RuleResolverUnknownType,
+						ResourceType: rt,
+						Key:          key,
+						Message:      fmt.Sprintf("non-exist resource type %q for resolver %s", rt, key),
+					})
 					continue
 				}
 				if item.IsRemoved {
-					fmt.Printf("removed resource type %q for resolver %s in scope of %s\n", rt, k1, k2)
-					continue
+					findings = append(findings, Finding{
+						Rule:         RuleResolverRemovedType,
+						ResourceType: rt,
+						Key:          key,
+						Message:      fmt.Sprintf("removed resource type %q for resolver %s", rt, key),
+					})
 				}
 			}
 		}
 	}
+	return findings
+}
 
-	for k1, b := range resmap.ARMId2TFMap {
-		for k2, l := range b {
-			if len(l) > 1 {
-				if m, ok := resolve.Resolvers[k1]; ok {
-					if resolver, ok := m[k2]; ok {
-						// Check whether all the TF candidates are covered by this resolver.
-						for _, rt := range l {
-							if !stringInSlice(rt.ResourceType, resolver.ResourceTypes()) {
-								fmt.Printf("%s in scope of %s has ambiguous resource type %q that isn't covered by that resolver\n", k1, k2, rt)
-							}
-						}
+func lintPopulaters() []Finding {
+	var findings []Finding
+	for _, rt := range populate.ResourceTypes() {
+		item, ok := resmap.TF2ARMIdMap[rt]
+		if !ok || item.ManagementPlane == nil {
+			findings = append(findings, Finding{
+				Rule:         RulePopulaterUnmappedType,
+				ResourceType: rt,
+				Message:      fmt.Sprintf("populater's resource type %q has no mapping entry", rt),
+			})
+			continue
+		}
+		pseudoTypes, ok := populate.PopulatedTypes[rt]
+		if !ok {
+			findings = append(findings, Finding{
+				Rule:         RulePopulaterUnmappedType,
+				ResourceType: rt,
+				Message:      fmt.Sprintf("populater of %q doesn't declare the resource types it emits", rt),
+			})
+			continue
+		}
+		mm := item.ManagementPlane
+		for _, pt := range pseudoTypes {
+			types := append(append([]string{mm.Provider}, mm.Types...), strings.Split(pt, "/")...)
+			k1 := "/" + strings.ToUpper(strings.Join(types, "/"))
+			b, ok := resmap.ARMId2TFMap[k1]
+			if ok {
+				// The emitted id shares the same parent scope as the populated resource.
+				found := len(mm.ParentScopes) == 0
+				for _, scope := range mm.ParentScopes {
+					if _, ok := b[strings.ToUpper(scope)]; ok {
+						found = true
 					}
-					continue
 				}
-				resourceTypes := []string{}
-				for _, item := range l {
-					resourceTypes = append(resourceTypes, item.ResourceType)
+				if _, ok := b[strings.ToUpper(resmap.ScopeAny)]; ok {
+					found = true
 				}
-				fmt.Printf("multiple matches found for %s in scope of %s: %v\n", k1, k2, resourceTypes)
+				ok = found
+			}
+			if !ok {
+				findings = append(findings, Finding{
+					Rule:         RulePopulaterUnmappedType,
+					ResourceType: rt,
+					Key:          k1,
+					Message:      fmt.Sprintf("populater of %q emits %q that has no mapping entry", rt, pt),
+				})
 			}
 		}
 	}
+	return findings
+}
+
+func lintBuilders() []Finding {
+	var findings []Finding
+	for _, rt := range tfid.BuilderTypes() {
+		item, ok := resmap.TF2ARMIdMap[rt]
+		if !ok {
+			findings = append(findings, Finding{
+				Rule:         RuleBuilderUnmappedType,
+				ResourceType: rt,
+				Message:      fmt.Sprintf("id builder's resource type %q doesn't exist in the mapping", rt),
+			})
+			continue
+		}
+		if item.IsRemoved {
+			findings = append(findings, Finding{
+				Rule:         RuleBuilderUnmappedType,
+				ResourceType: rt,
+				Message:      fmt.Sprintf("id builder's resource type %q is removed", rt),
+			})
+		}
+	}
+	return findings
+}
+
+func lintMapItems() []Finding {
+	var findings []Finding
+	for rt, item := range resmap.TF2ARMIdMap {
+		if item.IsRemoved || item.ManagementPlane == nil {
+			continue
+		}
+		mm := item.ManagementPlane
+
+		// The root scope resource id has no parent scope, but may still have an import spec.
+		if len(mm.ImportSpecs) != 0 && len(mm.ParentScopes) != 0 && len(mm.ImportSpecs) != len(mm.ParentScopes) {
+			findings = append(findings, Finding{
+				Rule:         RuleImportSpecScopeMismatch,
+				ResourceType: rt,
+				Message:      fmt.Sprintf("%q has %d import specs, but %d scopes", rt, len(mm.ImportSpecs), len(mm.ParentScopes)),
+			})
+		}
+
+		if stringInSlice(resmap.ScopeAny, mm.ParentScopes) && !tfid.NeedsAPI(rt) {
+			findings = append(findings, lintAnyScopeStaticBuild(rt)...)
+		}
+	}
+	return findings
+}
+
+// anyScopeExampleScopes are the parent scopes that the resource types under scope "any" are built in.
+var anyScopeExampleScopes = []string{
+	"/subscriptions",
+	"/subscriptions/resourceGroups",
+	"/subscriptions/resourceGroups/Microsoft.Network/virtualNetworks",
+	"/subscriptions/resourceGroups/Microsoft.Network/virtualNetworks/subnets",
+}
+
+// lintAnyScopeStaticBuild builds the id of the resource type under different parent scopes, as there is no import spec for the scope "any" to check against.
+// The built id is expected to keep the parent scope.
+func lintAnyScopeStaticBuild(rt string) []Finding {
+	var findings []Finding
+	for _, scope := range anyScopeExampleScopes {
+		id := exampleid.Id(rt, scope)
+		tfId, err := tfid.StaticBuild(id, rt)
+		if err != nil {
+			findings = append(findings, Finding{
+				Rule:         RuleAnyScopeWithoutStaticCase,
+				ResourceType: rt,
+				Key:          scope,
+				Message:      fmt.Sprintf("%q is under scope %q, but tfid.StaticBuild fails to build %s: %v", rt, resmap.ScopeAny, id, err),
+			})
+			continue
+		}
+		if !strings.Contains(strings.ToUpper(tfId), strings.ToUpper(id.ParentScope().String())) {
+			findings = append(findings, Finding{
+				Rule:         RuleAnyScopeWithoutStaticCase,
+				ResourceType: rt,
+				Key:          scope,
+				Message:      fmt.Sprintf("%q is under scope %q, but tfid.StaticBuild builds %s from %s, which loses the parent scope", rt, resmap.ScopeAny, tfId, id),
+			})
+		}
+	}
+	return findings
+}
+
+func lintReadActions() []Finding {
+	var findings []Finding
+	for k1 := range resolve.Resolvers {
+		if _, ok := resolve.ReadActions[k1]; !ok {
+			findings = append(findings, Finding{
+				Rule:    RuleMissingReadActions,
+				Key:     k1,
+				Message: fmt.Sprintf("resolver of %s doesn't declare its read actions", k1),
+			})
+		}
+	}
+	for _, rt := range populate.ResourceTypes() {
+		if _, ok := populate.ReadActions[rt]; !ok {
+			findings = append(findings, Finding{
+				Rule:         RuleMissingReadActions,
+				ResourceType: rt,
+				Message:      fmt.Sprintf("populater of %q doesn't declare its read actions", rt),
+			})
+		}
+	}
+	for _, rt := range tfid.BuilderTypes() {
+		if _, ok := tfid.ReadActions[rt]; !ok {
+			findings = append(findings, Finding{
+				Rule:         RuleMissingReadActions,
+				ResourceType: rt,
+				Message:      fmt.Sprintf("id builder of %q doesn't declare its read actions", rt),
+			})
+		}
+	}
+	return findings
 }
@@ -0,0 +1,44 @@
+package main
+
+/// This program generates the Go literals of the resource mappings from the map.json, which is embedded in aztft instead of the JSON.
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/magodo/aztft/internal/resmap"
+)
+
+const usage = `aztft-map-codegen -in <map.json> -out <mapping_gen.go>
+
+Generate the Go source that builds the resource mappings from the map.json.`
+
+func main() {
+	var (
+		flagIn  = flag.String("in", "", "The mapping file")
+		flagOut = flag.String("out", "", "The output Go source file")
+	)
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if *flagIn == "" || *flagOut == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	b, err := os.ReadFile(*flagIn)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := resmap.GenerateSource(b)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*flagOut, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
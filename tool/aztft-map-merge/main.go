@@ -0,0 +1,97 @@
+package main
+
+/// This program merges the hand-maintained overrides into the mapping generated by aztft-import, which results in the mapping embedded in aztft.
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/magodo/aztft/internal/resmap"
+)
+
+const usage = `aztft-map-merge -base <map_gen.json> -overrides <map_override.json> [-out <map.json>] [-check]
+
+Merge the overrides into the generated base mapping, and write the result to the output file (or stdout).
+With "-check", it fails if there is any stale override, or the merged mapping doesn't match the one embedded in aztft.
+It always fails if there is any conflicting override.`
+
+func main() {
+	var (
+		flagBase      = flag.String("base", "", "The generated base mapping file")
+		flagOverrides = flag.String("overrides", "", "The overrides file")
+		flagOut       = flag.String("out", "", "The output file of the merged mapping. Defaults to stdout")
+		flagCheck     = flag.Bool("check", false, "Check the merged mapping against the embedded mapping, instead of writing it")
+	)
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if *flagBase == "" || *flagOverrides == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	var base resmap.TF2ARMIdMapType
+	if err := readJSON(*flagBase, &base); err != nil {
+		log.Fatal(err)
+	}
+	var overrides resmap.TF2ARMIdMapOverrides
+	if err := readJSON(*flagOverrides, &overrides); err != nil {
+		log.Fatal(err)
+	}
+
+	merged, issues := resmap.Merge(base, overrides)
+	var hasConflict bool
+	for _, issue := range issues {
+		if issue.Kind == resmap.MergeIssueConflict {
+			hasConflict = true
+		}
+		log.Println(issue)
+	}
+	if hasConflict {
+		log.Fatal("conflicting overrides found")
+	}
+
+	if *flagCheck {
+		resmap.Init()
+		diff := resmap.Diff(merged, resmap.TF2ARMIdMap)
+		for _, rt := range diff {
+			log.Printf("%s: the merged mapping doesn't match the embedded one\n", rt)
+		}
+		if len(diff) != 0 {
+			log.Fatal(`the embedded mapping is out of date, run "go generate ./internal/resmap" to update it`)
+		}
+		if len(issues) != 0 {
+			log.Fatal("stale overrides found")
+		}
+		return
+	}
+
+	b, err := json.MarshalIndent(merged, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	b = append(b, '\n')
+	if *flagOut == "" {
+		fmt.Print(string(b))
+		return
+	}
+	if err := os.WriteFile(*flagOut, b, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func readJSON(path string, v interface{}) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading %s: %v", path, err)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("unmarshalling %s: %v", path, err)
+	}
+	return nil
+}
@@ -3,38 +3,59 @@ package main
 import (
 	"fmt"
 	"log"
+	"net/url"
 	"sort"
 	"strings"
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/exampleid"
 	"github.com/magodo/aztft/internal/resmap"
 	"github.com/magodo/aztft/internal/tfid"
 )
 
-// The property like resources from map.json that have pesudo Azure resource ID defined
-// The list is from: tfid.go:StaticBuild()
-var propertyLikeRTs = map[string]bool{
-	"azurerm_nat_gateway_public_ip_association":                                      true,
-	"azurerm_nat_gateway_public_ip_prefix_association":                               true,
-	"azurerm_network_interface_application_gateway_backend_address_pool_association": true,
-	"azurerm_network_interface_application_security_group_association":               true,
-	"azurerm_network_interface_backend_address_pool_association":                     true,
-	"azurerm_network_interface_nat_rule_association":                                 true,
-	"azurerm_network_interface_security_group_association":                           true,
-	"azurerm_virtual_desktop_workspace_application_group_association":                true,
-	"azurerm_subnet_nat_gateway_association":                                         true,
-	"azurerm_subnet_network_security_group_association":                              true,
-	"azurerm_subnet_route_table_association":                                         true,
+// The resources whose id is built by calling Azure API (i.e. tfid.DynamicBuild), but can't be built offline.
+// The ids are synthesized from the Azure resource ID, with the API returned parts "randomly" generated.
+var dynamicRTs = map[string]func(id armid.ResourceId) string{
+	"azurerm_active_directory_domain_service": func(id armid.ResourceId) string {
+		return id.String() + "/initialReplicaSetId/initialReplicaSetId1"
+	},
+	"azurerm_storage_object_replication": func(id armid.ResourceId) string {
+		srcId := id.Clone().(*armid.ScopedResourceId)
+		srcId.AttrNames[0] = "storageAccounts2"
+		return id.String() + ";" + srcId.String()
+	},
+	"azurerm_key_vault_key": func(id armid.ResourceId) string {
+		return fmt.Sprintf("https://%s.vault.azure.net/keys/%s/%s", id.Names()[0], id.Names()[1], KeyVaultObjectVersion)
+	},
+	"azurerm_key_vault_secret": func(id armid.ResourceId) string {
+		return fmt.Sprintf("https://%s.vault.azure.net/secrets/%s/%s", id.Names()[0], id.Names()[1], KeyVaultObjectVersion)
+	},
+	"azurerm_key_vault_certificate": func(id armid.ResourceId) string {
+		return fmt.Sprintf("https://%s.vault.azure.net/certificates/%s/%s", id.Names()[0], id.Names()[1], KeyVaultObjectVersion)
+	},
+	"azurerm_api_management_api": func(id armid.ResourceId) string {
+		return id.String() + ";rev=1"
+	},
+	"azurerm_app_configuration_key": func(id armid.ResourceId) string {
+		return fmt.Sprintf("https://%s.azconfig.io/kv/%s?label=%s", id.Names()[0], url.PathEscape(id.Names()[1]), url.QueryEscape(id.Names()[2]))
+	},
+	"azurerm_app_configuration_feature": func(id armid.ResourceId) string {
+		return fmt.Sprintf("https://%s.azconfig.io/kv/%s?label=%s", id.Names()[0], url.PathEscape(tfid.AppConfigurationFeaturePrefix+id.Names()[1]), url.QueryEscape(id.Names()[2]))
+	},
+	"azurerm_automation_job_schedule": func(id armid.ResourceId) string {
+		scheduleId := id.Parent().Clone().(*armid.ScopedResourceId)
+		scheduleId.AttrTypes = append(scheduleId.AttrTypes, "schedules")
+		scheduleId.AttrNames = append(scheduleId.AttrNames, "schedules1")
+		runBookId := id.Parent().Clone().(*armid.ScopedResourceId)
+		runBookId.AttrTypes = append(runBookId.AttrTypes, "runbooks")
+		runBookId.AttrNames = append(runBookId.AttrNames, "runbooks1")
+		return scheduleId.String() + "|" + runBookId.String()
+	},
 }
 
-var (
-	SubId       = armid.SubscriptionId{Id: "sub1"}
-	MgmtGroupId = armid.ManagementGroup{Name: "grp1"}
-	RgId        = armid.ResourceGroup{SubscriptionId: "sub1", Name: "rg1"}
-	TenantId    = armid.TenantId{}
-)
+var KeyVaultObjectVersion = "00000000000000000000000000000000"
 
 func main() {
 	resmap.Init()
@@ -48,66 +69,13 @@ func main() {
 	body := f.Body()
 
 	for _, rt := range rts {
-		entry := resmap.TF2ARMIdMap[rt]
-
-		// Resources need dynamically construct its resource ID are mostly data plane resources
-		if tfid.NeedsAPI(rt) {
-			continue
-		}
-
 		if strings.HasPrefix(rt, "fake_") {
 			continue
 		}
 
-		// The property-like resources are ignored for now, but can be supported in some way in the future
-		if propertyLikeRTs[rt] {
-			if err := addExecutionBlock(body, rt, "TODO"); err != nil {
-				log.Fatal(err)
-			}
-			continue
-		}
-
-		var idstr string
-
-		switch rt {
-		case "azurerm_resource_group":
-			idstr = RgId.String()
-		case "azurerm_management_group":
-			idstr = MgmtGroupId.String()
-		case "azurerm_subscription":
-			idstr = SubId.String()
-		default:
-			mp := entry.ManagementPlane
-
-			// Construct the scope id if any
-			var (
-				scopeRaw string
-				scopeId  armid.ResourceId
-			)
-			if len(mp.ParentScopes) == 1 {
-				scopeRaw = mp.ParentScopes[0]
-				if scopeRaw == resmap.ScopeAny {
-					scopeRaw = "/subscriptions/resourceGroups"
-				}
-			} else if len(mp.ParentScopes) > 1 {
-				scopeRaw = mp.ParentScopes[0]
-			}
-			if scopeRaw != "" {
-				scopeId = routeScopeStrToId(scopeRaw)
-			}
-
-			// Construct the resource id
-			id := routeScopeStrToId("/" + strings.Join(append([]string{mp.Provider}, mp.Types...), "/"))
-			if scopeId != nil {
-				routeId := id.(*armid.ScopedResourceId)
-				routeId.AttrParentScope = scopeId
-			}
-
-			var err error
-			idstr, err = tfid.StaticBuild(id, rt)
-			if err != nil {
-				log.Fatal(err)
-			}
+		idstr, err := buildTFId(rt)
+		if err != nil {
+			log.Fatalf("building id for %s: %v", rt, err)
 		}
 		if err := addExecutionBlock(body, rt, idstr); err != nil {
 			log.Fatal(err)
@@ -116,6 +84,25 @@ func main() {
 	fmt.Printf("%s", f.Bytes())
 }
 
+// buildTFId builds an example TF resource id for the resource type.
+func buildTFId(rt string) (string, error) {
+	id := exampleid.DefaultId(rt)
+
+	switch {
+	// Data plane resources, whose ids are URLs built from the placeholder names (e.g. the storage account name) in the public cloud.
+	case tfid.CanBuildOffline(rt):
+		return tfid.OfflineBuild(id, rt, tfid.DataPlaneOption{})
+	case tfid.NeedsAPI(rt):
+		builder, ok := dynamicRTs[rt]
+		if !ok {
+			return "", fmt.Errorf("no example id defined for resource type that needs API")
+		}
+		return builder(id), nil
+	default:
+		return tfid.StaticBuild(id, rt)
+	}
+}
+
 func addExecutionBlock(mainBody *hclwrite.Body, rt string, idstr string) error {
 	execBlk := mainBody.AppendNewBlock("execution", []string{rt, "basic"})
 	execBody := execBlk.Body()
@@ -141,38 +128,6 @@ func addExecutionBlock(mainBody *hclwrite.Body, rt string, idstr string) error {
 	return nil
 }
 
-// routeScopeStrToId turns a route scope string to a resource id, with the names part "randomly" generated
-func routeScopeStrToId(input string) armid.ResourceId {
-	upperInput := strings.ToUpper(input)
-
-	var parentScope armid.ResourceId = &TenantId
-	if strings.HasPrefix(upperInput, strings.ToUpper(RgId.ScopeString())) {
-		parentScope = &RgId
-	} else if strings.HasPrefix(upperInput, strings.ToUpper(SubId.ScopeString())) {
-		parentScope = &SubId
-	} else if strings.HasPrefix(upperInput, strings.ToUpper(MgmtGroupId.ScopeString())) {
-		parentScope = &MgmtGroupId
-	}
-
-	left := input[len(parentScope.ScopeString()):]
-	if len(left) == 0 {
-		return parentScope
-	}
-
-	segs := strings.Split(strings.Trim(left, "/"), "/")
-	var names []string
-	for _, seg := range segs[1:] {
-		names = append(names, seg+"1")
-	}
-	id := armid.ScopedResourceId{
-		AttrParentScope: parentScope,
-		AttrProvider:    segs[0],
-		AttrTypes:       segs[1:],
-		AttrNames:       names,
-	}
-	return &id
-}
-
 func buildExpression(name string, value string) (*hclwrite.Expression, error) {
 	src := name + " = " + value
 
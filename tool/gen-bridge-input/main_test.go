@@ -0,0 +1,31 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/magodo/aztft/internal/resmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildTFId(t *testing.T) {
+	resmap.Init()
+	var rts []string
+	for rt := range resmap.TF2ARMIdMap {
+		if strings.HasPrefix(rt, "fake_") {
+			continue
+		}
+		rts = append(rts, rt)
+	}
+	sort.Strings(rts)
+
+	for _, rt := range rts {
+		rt := rt
+		t.Run(rt, func(t *testing.T) {
+			id, err := buildTFId(rt)
+			require.NoError(t, err)
+			require.NotEmpty(t, id)
+		})
+	}
+}